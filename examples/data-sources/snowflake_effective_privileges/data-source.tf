@@ -0,0 +1,19 @@
+# privileges of the user, inherited from all the granted roles (including PUBLIC)
+data "snowflake_effective_privileges" "user" {
+  user = "USER_NAME"
+}
+
+# privileges of the account role, without the ones inherited from PUBLIC
+data "snowflake_effective_privileges" "account_role" {
+  account_role        = "ROLE_NAME"
+  include_public_role = false
+}
+
+# privileges of the database role
+data "snowflake_effective_privileges" "database_role" {
+  database_role = "\"DATABASE_NAME\".\"DATABASE_ROLE_NAME\""
+}
+
+output "effective_privileges" {
+  value = data.snowflake_effective_privileges.user.effective_privileges
+}
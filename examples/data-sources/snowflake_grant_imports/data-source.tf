@@ -0,0 +1,22 @@
+# grants of an account role
+data "snowflake_grant_imports" "analyst" {
+  account_role_name = "ANALYST"
+}
+
+# grants of a database role
+data "snowflake_grant_imports" "db_reader" {
+  database_role_name = "\"DATABASE\".\"READER\""
+}
+
+# save with: terraform output -raw analyst_grants > analyst_grants.tf
+output "analyst_grants" {
+  value = data.snowflake_grant_imports.analyst.hcl
+}
+
+output "analyst_import_ids" {
+  value = { for imp in data.snowflake_grant_imports.analyst.imports : "${imp.resource_type}.${imp.resource_name}" => imp.import_id }
+}
+
+output "analyst_skipped_grants" {
+  value = data.snowflake_grant_imports.analyst.skipped_grants
+}
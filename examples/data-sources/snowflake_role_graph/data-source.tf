@@ -0,0 +1,14 @@
+data "snowflake_role_graph" "all" {}
+
+# render with: terraform output -raw role_graph_dot | dot -Tsvg > roles.svg
+output "role_graph_dot" {
+  value = data.snowflake_role_graph.all.dot
+}
+
+output "orphan_roles" {
+  value = data.snowflake_role_graph.all.orphan_roles
+}
+
+output "role_cycles" {
+  value = data.snowflake_role_graph.all.cycles
+}
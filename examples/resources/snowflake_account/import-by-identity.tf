@@ -0,0 +1,7 @@
+import {
+  to = snowflake_account.example
+  identity = {
+    organization_name = "<organization_name>"
+    account_name      = "<account_name>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = snowflake_account_parameter.p
+  identity = {
+    key = "<parameter_name>"
+  }
+}
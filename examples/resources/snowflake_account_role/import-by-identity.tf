@@ -0,0 +1,6 @@
+import {
+  to = snowflake_account_role.example
+  identity = {
+    name = "<account_role_name>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = snowflake_api_authentication_integration_with_authorization_code_grant.example
+  identity = {
+    name = "<integration_name>"
+  }
+}
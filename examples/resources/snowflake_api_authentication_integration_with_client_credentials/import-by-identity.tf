@@ -0,0 +1,6 @@
+import {
+  to = snowflake_api_authentication_integration_with_client_credentials.example
+  identity = {
+    name = "<integration_name>"
+  }
+}
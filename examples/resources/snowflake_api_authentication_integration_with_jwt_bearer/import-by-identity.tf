@@ -0,0 +1,6 @@
+import {
+  to = snowflake_api_authentication_integration_with_jwt_bearer.example
+  identity = {
+    name = "<integration_name>"
+  }
+}
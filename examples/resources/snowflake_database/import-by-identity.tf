@@ -0,0 +1,6 @@
+import {
+  to = snowflake_database.example
+  identity = {
+    name = "<database_name>"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = snowflake_database_role.example
+  identity = {
+    database = "<database_name>"
+    name     = "<database_role_name>"
+  }
+}
@@ -21,6 +21,14 @@ resource "snowflake_execute" "test" {
   query   = "SHOW DATABASES LIKE '%ABC%'"
 }
 
+# with multi-statement query; results of every statement are available in query_results_per_statement
+resource "snowflake_execute" "test" {
+  execute                = "CREATE DATABASE ABC"
+  revert                 = "DROP DATABASE ABC"
+  query                  = "SHOW DATABASES LIKE '%ABC%'; SELECT \"name\", \"owner\" FROM TABLE(RESULT_SCAN(LAST_QUERY_ID()))"
+  query_statements_count = 2
+}
+
 ##################################
 ### grants example
 ##################################
@@ -0,0 +1,6 @@
+import {
+  to = snowflake_external_oauth_integration.example
+  identity = {
+    name = "<integration_name>"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = snowflake_grant_account_role.example
+  identity = {
+    role_name        = "test_role"
+    parent_role_name = "test_parent_role"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = snowflake_grant_application_role.example
+  identity = {
+    application_role_name    = "\"my_application\".\"app_role_1\""
+    parent_account_role_name = "my_role"
+  }
+}
@@ -0,0 +1,6 @@
+# on object
+terraform import snowflake_grant_caller_privileges.example 'ACCOUNT_ROLE|"role_name"|INSERT,SELECT|OnObject|TABLE|"database_name"."schema_name"."table_name"'
+
+# on all
+terraform import snowflake_grant_caller_privileges.example 'DATABASE_ROLE|"database_name"."database_role_name"|SELECT|OnAll|TABLES|InSchema|"database_name"."schema_name"'
+terraform import snowflake_grant_caller_privileges.example 'ACCOUNT_ROLE|"role_name"|ALL|OnAll|WAREHOUSES|InAccount'
@@ -0,0 +1,32 @@
+## caller privileges on a single object
+resource "snowflake_grant_caller_privileges" "on_object" {
+  account_role_name = snowflake_account_role.role.fully_qualified_name
+  privileges        = ["SELECT", "INSERT"]
+
+  on_object {
+    object_type = "TABLE"
+    object_name = snowflake_table.table.fully_qualified_name
+  }
+}
+
+## inherited caller privileges on all the tables in the schema
+resource "snowflake_grant_caller_privileges" "on_all_in_schema" {
+  database_role_name = snowflake_database_role.role.fully_qualified_name
+  privileges         = ["SELECT"]
+
+  on_all {
+    object_type_plural = "TABLES"
+    in_schema          = snowflake_schema.schema.fully_qualified_name
+  }
+}
+
+## all the inherited caller privileges on all the warehouses in the account
+resource "snowflake_grant_caller_privileges" "on_all_in_account" {
+  account_role_name = snowflake_account_role.role.fully_qualified_name
+  all_privileges    = true
+
+  on_all {
+    object_type_plural = "WAREHOUSES"
+    in_account         = true
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = snowflake_grant_database_role.example
+  identity = {
+    database_role_name = "\"ABC\".\"test_db_role\""
+    parent_role_name   = "test_parent_role"
+  }
+}
@@ -0,0 +1,5 @@
+# account role
+terraform import snowflake_grant_future_privileges_in_database.example 'ACCOUNT_ROLE|"role_name"|"database_name"|TABLES|false'
+
+# database role
+terraform import snowflake_grant_future_privileges_in_database.example 'DATABASE_ROLE|"database_name"."database_role_name"|"database_name"|VIEWS|true'
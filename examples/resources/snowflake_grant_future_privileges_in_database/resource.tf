@@ -0,0 +1,16 @@
+## account role
+resource "snowflake_grant_future_privileges_in_database" "account_role" {
+  account_role_name  = snowflake_account_role.role.fully_qualified_name
+  in_database        = snowflake_database.database.fully_qualified_name
+  object_type_plural = "TABLES"
+  privileges         = ["SELECT", "INSERT"]
+}
+
+## database role
+resource "snowflake_grant_future_privileges_in_database" "database_role" {
+  database_role_name = snowflake_database_role.role.fully_qualified_name
+  in_database        = snowflake_database.database.fully_qualified_name
+  object_type_plural = "VIEWS"
+  privileges         = ["SELECT"]
+  with_grant_option  = true
+}
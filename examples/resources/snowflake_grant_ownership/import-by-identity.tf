@@ -0,0 +1,9 @@
+import {
+  to = snowflake_grant_ownership.example
+  identity = {
+    account_role_name   = "test_role"
+    outbound_privileges = "COPY"
+    on_object_type      = "TABLE"
+    on_object_name      = "\"test_db\".\"test_schema\".\"test_table\""
+  }
+}
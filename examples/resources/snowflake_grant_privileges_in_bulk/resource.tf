@@ -0,0 +1,41 @@
+resource "snowflake_grant_privileges_in_bulk" "example" {
+  batch_size = 50
+
+  grant {
+    account_role_name = snowflake_account_role.analyst.fully_qualified_name
+    object_type       = "DATABASE"
+    object_name       = snowflake_database.database.fully_qualified_name
+    privileges        = ["USAGE"]
+  }
+
+  grant {
+    account_role_name = snowflake_account_role.analyst.fully_qualified_name
+    object_type       = "WAREHOUSE"
+    object_name       = snowflake_warehouse.warehouse.fully_qualified_name
+    privileges        = ["USAGE", "MONITOR"]
+    with_grant_option = true
+  }
+
+  grant {
+    account_role_name = snowflake_account_role.engineer.fully_qualified_name
+    object_type       = "ACCOUNT"
+    privileges        = ["CREATE DATABASE"]
+  }
+}
+
+## SELECT on every table granted to every reader role
+locals {
+  reader_roles = [snowflake_account_role.analyst.fully_qualified_name, snowflake_account_role.engineer.fully_qualified_name]
+}
+
+resource "snowflake_grant_privileges_in_bulk" "tables" {
+  dynamic "grant" {
+    for_each = setproduct(local.reader_roles, [for table in snowflake_table.tables : table.fully_qualified_name])
+    content {
+      account_role_name = grant.value[0]
+      object_type       = "TABLE"
+      object_name       = grant.value[1]
+      privileges        = ["SELECT"]
+    }
+  }
+}
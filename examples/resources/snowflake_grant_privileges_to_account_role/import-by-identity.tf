@@ -0,0 +1,9 @@
+import {
+  to = snowflake_grant_privileges_to_account_role.example
+  identity = {
+    account_role_name     = "test_role"
+    privileges            = ["SELECT", "INSERT"]
+    on_schema_object_type = "TABLE"
+    on_schema_object_name = "\"test_db\".\"test_schema\".\"test_table\""
+  }
+}
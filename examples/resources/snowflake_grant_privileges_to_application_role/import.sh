@@ -0,0 +1,8 @@
+# on account
+terraform import snowflake_grant_privileges_to_application_role.example '"application_name"."application_role_name"|false|false|MONITOR USAGE|OnAccount'
+
+# on schema object
+terraform import snowflake_grant_privileges_to_application_role.example '"application_name"."application_role_name"|false|false|SELECT,INSERT|OnSchemaObject|OnObject|TABLE|"database_name"."schema_name"."table_name"'
+
+# on all schema objects
+terraform import snowflake_grant_privileges_to_application_role.example '"application_name"."application_role_name"|false|false|SELECT|OnSchemaObject|OnAll|TABLES|InSchema|"database_name"."schema_name"'
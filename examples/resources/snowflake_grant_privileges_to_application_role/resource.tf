@@ -0,0 +1,51 @@
+## on account
+resource "snowflake_grant_privileges_to_application_role" "on_account" {
+  application_role_name = "\"application_name\".\"application_role_name\""
+  privileges            = ["MONITOR USAGE"]
+  on_account            = true
+}
+
+## on account object
+resource "snowflake_grant_privileges_to_application_role" "on_database" {
+  application_role_name = "\"application_name\".\"application_role_name\""
+  privileges            = ["USAGE"]
+
+  on_account_object {
+    object_type = "DATABASE"
+    object_name = snowflake_database.database.fully_qualified_name
+  }
+}
+
+## on schema
+resource "snowflake_grant_privileges_to_application_role" "on_all_schemas" {
+  application_role_name = "\"application_name\".\"application_role_name\""
+  privileges            = ["USAGE"]
+
+  on_schema {
+    all_schemas_in_database = snowflake_database.database.fully_qualified_name
+  }
+}
+
+## on schema object
+resource "snowflake_grant_privileges_to_application_role" "on_table" {
+  application_role_name = "\"application_name\".\"application_role_name\""
+  privileges            = ["SELECT", "INSERT"]
+
+  on_schema_object {
+    object_type = "TABLE"
+    object_name = snowflake_table.table.fully_qualified_name
+  }
+}
+
+## on all schema objects
+resource "snowflake_grant_privileges_to_application_role" "on_all_tables" {
+  application_role_name = "\"application_name\".\"application_role_name\""
+  privileges            = ["SELECT"]
+
+  on_schema_object {
+    all {
+      object_type_plural = "TABLES"
+      in_schema          = snowflake_schema.schema.fully_qualified_name
+    }
+  }
+}
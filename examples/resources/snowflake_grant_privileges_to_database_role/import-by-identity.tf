@@ -0,0 +1,8 @@
+import {
+  to = snowflake_grant_privileges_to_database_role.example
+  identity = {
+    database_role_name = "\"test_db\".\"test_db_role\""
+    all_privileges     = true
+    on_database        = "test_db"
+  }
+}
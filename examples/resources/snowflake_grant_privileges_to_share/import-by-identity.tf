@@ -0,0 +1,8 @@
+import {
+  to = snowflake_grant_privileges_to_share.example
+  identity = {
+    to_share    = "test_share"
+    privileges  = ["USAGE"]
+    on_database = "\"test_db\""
+  }
+}
@@ -0,0 +1,8 @@
+import {
+  to = snowflake_masking_policy.example
+  identity = {
+    database = "<database_name>"
+    schema   = "<schema_name>"
+    name     = "<masking_policy_name>"
+  }
+}
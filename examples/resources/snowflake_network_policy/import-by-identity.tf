@@ -0,0 +1,6 @@
+import {
+  to = snowflake_network_policy.example
+  identity = {
+    name = "<network_policy_name>"
+  }
+}
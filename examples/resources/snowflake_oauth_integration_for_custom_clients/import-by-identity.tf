@@ -0,0 +1,6 @@
+import {
+  to = snowflake_oauth_integration_for_custom_clients.example
+  identity = {
+    name = "<integration_name>"
+  }
+}
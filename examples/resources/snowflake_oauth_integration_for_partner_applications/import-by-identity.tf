@@ -0,0 +1,6 @@
+import {
+  to = snowflake_oauth_integration_for_partner_applications.example
+  identity = {
+    name = "<integration_name>"
+  }
+}
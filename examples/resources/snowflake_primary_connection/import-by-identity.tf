@@ -0,0 +1,6 @@
+import {
+  to = snowflake_primary_connection.example
+  identity = {
+    name = "<primary_connection_name>"
+  }
+}
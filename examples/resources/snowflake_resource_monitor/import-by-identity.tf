@@ -0,0 +1,6 @@
+import {
+  to = snowflake_resource_monitor.example
+  identity = {
+    name = "<resource_monitor_name>"
+  }
+}
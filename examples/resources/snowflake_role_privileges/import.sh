@@ -0,0 +1,5 @@
+# account role
+terraform import snowflake_role_privileges.example 'ACCOUNT_ROLE|"role_name"'
+
+# database role
+terraform import snowflake_role_privileges.example 'DATABASE_ROLE|"database_name"."database_role_name"'
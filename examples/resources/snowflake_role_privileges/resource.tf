@@ -0,0 +1,38 @@
+## account role
+resource "snowflake_role_privileges" "account_role" {
+  account_role_name = snowflake_account_role.role.fully_qualified_name
+
+  grant {
+    object_type = "ACCOUNT"
+    privileges  = ["CREATE DATABASE", "CREATE WAREHOUSE"]
+  }
+
+  grant {
+    object_type       = "WAREHOUSE"
+    object_name       = snowflake_warehouse.warehouse.fully_qualified_name
+    privileges        = ["USAGE", "MONITOR"]
+    with_grant_option = true
+  }
+
+  grant {
+    object_type = "SCHEMA"
+    object_name = snowflake_schema.schema.fully_qualified_name
+    privileges  = ["USAGE", "CREATE TABLE"]
+  }
+}
+
+## database role
+resource "snowflake_role_privileges" "database_role" {
+  database_role_name = snowflake_database_role.role.fully_qualified_name
+
+  grant {
+    object_type = "TABLE"
+    object_name = snowflake_table.table.fully_qualified_name
+    privileges  = ["SELECT", "INSERT"]
+  }
+}
+
+## no privileges (all the privileges granted to the role are revoked)
+resource "snowflake_role_privileges" "no_privileges" {
+  account_role_name = snowflake_account_role.another_role.fully_qualified_name
+}
@@ -0,0 +1,8 @@
+import {
+  to = snowflake_row_access_policy.example
+  identity = {
+    database = "<database_name>"
+    schema   = "<schema_name>"
+    name     = "<row_access_policy_name>"
+  }
+}
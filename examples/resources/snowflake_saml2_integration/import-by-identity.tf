@@ -0,0 +1,6 @@
+import {
+  to = snowflake_saml2_integration.example
+  identity = {
+    name = "<integration_name>"
+  }
+}
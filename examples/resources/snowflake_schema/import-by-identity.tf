@@ -0,0 +1,7 @@
+import {
+  to = snowflake_schema.example
+  identity = {
+    database = "<database_name>"
+    name     = "<schema_name>"
+  }
+}
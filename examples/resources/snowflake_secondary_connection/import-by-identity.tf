@@ -0,0 +1,6 @@
+import {
+  to = snowflake_secondary_connection.example
+  identity = {
+    name = "<secondary_connection_name>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = snowflake_secondary_database.example
+  identity = {
+    name = "<secondary_database_name>"
+  }
+}
@@ -0,0 +1,8 @@
+import {
+  to = snowflake_secret_with_authorization_code_grant.example
+  identity = {
+    database = "<database_name>"
+    schema   = "<schema_name>"
+    name     = "<secret_name>"
+  }
+}
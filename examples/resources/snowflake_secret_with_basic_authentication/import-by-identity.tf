@@ -0,0 +1,8 @@
+import {
+  to = snowflake_secret_with_basic_authentication.example
+  identity = {
+    database = "<database_name>"
+    schema   = "<schema_name>"
+    name     = "<secret_name>"
+  }
+}
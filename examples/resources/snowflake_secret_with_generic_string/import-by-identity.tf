@@ -0,0 +1,8 @@
+import {
+  to = snowflake_secret_with_generic_string.example
+  identity = {
+    database = "<database_name>"
+    schema   = "<schema_name>"
+    name     = "<secret_name>"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = snowflake_shared_database.example
+  identity = {
+    name = "<shared_database_name>"
+  }
+}
@@ -0,0 +1,8 @@
+import {
+  to = snowflake_stream_on_external_table.example
+  identity = {
+    database = "<database_name>"
+    schema   = "<schema_name>"
+    name     = "<stream_name>"
+  }
+}
@@ -0,0 +1,8 @@
+import {
+  to = snowflake_streamlit.example
+  identity = {
+    database = "<database_name>"
+    schema   = "<schema_name>"
+    name     = "<streamlit_name>"
+  }
+}
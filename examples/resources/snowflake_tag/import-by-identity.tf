@@ -0,0 +1,8 @@
+import {
+  to = snowflake_tag.example
+  identity = {
+    database = "<database_name>"
+    schema   = "<schema_name>"
+    name     = "<tag_name>"
+  }
+}
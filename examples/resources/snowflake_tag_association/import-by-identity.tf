@@ -0,0 +1,8 @@
+import {
+  to = snowflake_tag_association.example
+  identity = {
+    tag_id      = "\"TAG_DATABASE\".\"TAG_SCHEMA\".\"TAG_NAME\""
+    tag_value   = "TAG_VALUE"
+    object_type = "OBJECT_TYPE"
+  }
+}
@@ -0,0 +1,8 @@
+import {
+  to = snowflake_task.example
+  identity = {
+    database = "<database_name>"
+    schema   = "<schema_name>"
+    name     = "<task_name>"
+  }
+}
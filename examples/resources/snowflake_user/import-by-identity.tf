@@ -0,0 +1,6 @@
+import {
+  to = snowflake_user.example
+  identity = {
+    name = "<user_name>"
+  }
+}
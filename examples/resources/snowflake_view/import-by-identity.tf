@@ -0,0 +1,8 @@
+import {
+  to = snowflake_view.example
+  identity = {
+    database = "<database_name>"
+    schema   = "<schema_name>"
+    name     = "<view_name>"
+  }
+}
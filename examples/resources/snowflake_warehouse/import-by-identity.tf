@@ -0,0 +1,6 @@
+import {
+  to = snowflake_warehouse.example
+  identity = {
+    name = "<warehouse_name>"
+  }
+}
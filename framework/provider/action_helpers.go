@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// accountObjectActionAttributes returns the attributes identifying the account-level object the action is invoked on.
+func accountObjectActionAttributes(objectType string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Description: "Name of the " + objectType + ".",
+			Required:    true,
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+	}
+}
+
+// schemaObjectActionAttributes returns the attributes identifying the schema-level object the action is invoked on.
+func schemaObjectActionAttributes(objectType string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"database": schema.StringAttribute{
+			Description: "The database in which the " + objectType + " is located.",
+			Required:    true,
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"schema": schema.StringAttribute{
+			Description: "The schema in which the " + objectType + " is located.",
+			Required:    true,
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the " + objectType + ".",
+			Required:    true,
+			Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+		},
+	}
+}
+
+func schemaObjectIdentifierFromAttributes(database types.String, schema types.String, name types.String) sdk.SchemaObjectIdentifier {
+	return sdk.NewSchemaObjectIdentifier(database.ValueString(), schema.ValueString(), name.ValueString())
+}
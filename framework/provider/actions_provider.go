@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ provider.Provider            = new(SnowflakeActionsProvider)
+	_ provider.ProviderWithActions = new(SnowflakeActionsProvider)
+)
+
+// SnowflakeActionsProvider exposes operational commands that have no declarative equivalent as Terraform actions.
+// Actions are not supported by the SDKv2, so they are served by this provider muxed together with the SDKv2 provider.
+// The provider configuration is owned by the SDKv2 provider and the client it creates is shared with the actions.
+type SnowflakeActionsProvider struct {
+	version string
+	client  func() *sdk.Client
+}
+
+func (p *SnowflakeActionsProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "snowflake"
+	resp.Version = p.version
+}
+
+func (p *SnowflakeActionsProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+}
+
+func (p *SnowflakeActionsProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	resp.ActionData = &ActionData{
+		client: p.client,
+	}
+}
+
+// ActionData is passed to all the actions during their configuration.
+type ActionData struct {
+	client func() *sdk.Client
+}
+
+func (p *SnowflakeActionsProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewExecuteTaskAction,
+		NewForceResumePipeAction,
+		NewRefreshDynamicTableAction,
+		NewRefreshStageDirectoryAction,
+		NewSuspendWarehouseAction,
+		NewResumeWarehouseAction,
+	}
+}
+
+func (p *SnowflakeActionsProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{}
+}
+
+func (p *SnowflakeActionsProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
+// NewActionsProviderServer returns the protocol server of SnowflakeActionsProvider that can be muxed with the SDKv2 provider.
+// The client function should return the client configured by the SDKv2 provider (or nil if it is not configured yet).
+func NewActionsProviderServer(version string, client func() *sdk.Client) func() tfprotov6.ProviderServer {
+	return func() tfprotov6.ProviderServer {
+		return &actionsProviderServer{
+			ProviderServer: providerserver.NewProtocol6(&SnowflakeActionsProvider{
+				version: version,
+				client:  client,
+			})(),
+		}
+	}
+}
+
+// actionsProviderServer hides the provider schema from the mux server, because the mux server requires all the
+// providers to have the same schema. The provider configuration is validated and used only by the SDKv2 provider,
+// so this server gets an empty configuration instead.
+type actionsProviderServer struct {
+	tfprotov6.ProviderServer
+}
+
+func (s *actionsProviderServer) GetProviderSchema(ctx context.Context, req *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	resp, err := s.ProviderServer.GetProviderSchema(ctx, req)
+	if resp != nil {
+		resp.Provider = nil
+	}
+	return resp, err
+}
+
+func (s *actionsProviderServer) ValidateProviderConfig(_ context.Context, _ *tfprotov6.ValidateProviderConfigRequest) (*tfprotov6.ValidateProviderConfigResponse, error) {
+	return &tfprotov6.ValidateProviderConfigResponse{}, nil
+}
+
+func (s *actionsProviderServer) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	emptyConfig, err := tfprotov6.NewDynamicValue(tftypes.Object{}, tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}))
+	if err != nil {
+		return nil, err
+	}
+	return s.ProviderServer.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		TerraformVersion:   req.TerraformVersion,
+		Config:             &emptyConfig,
+		ClientCapabilities: req.ClientCapabilities,
+	})
+}
+
+// actionClient is embedded in all the actions to give them access to the client configured by the SDKv2 provider.
+type actionClient struct {
+	data *ActionData
+}
+
+func (a *actionClient) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	actionData, ok := req.ProviderData.(*ActionData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *ActionData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.data = actionData
+}
+
+func (a *actionClient) client() (*sdk.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if a.data == nil || a.data.client == nil || a.data.client() == nil {
+		diags.AddError(
+			"Unconfigured Snowflake Client",
+			"The provider has not been configured before invoking the action. Please report this issue to the provider developers.",
+		)
+		return nil, diags
+	}
+	return a.data.client(), diags
+}
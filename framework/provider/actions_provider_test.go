@@ -0,0 +1,66 @@
+package provider_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/framework/provider"
+	oldprovider "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActionsProvider_MuxedWithSdkProvider(t *testing.T) {
+	ctx := context.Background()
+	sdkProvider := oldprovider.Provider()
+	upgradedSdkServer, err := tf5to6server.UpgradeServer(ctx, sdkProvider.GRPCProvider)
+	require.NoError(t, err)
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx,
+		func() tfprotov6.ProviderServer { return upgradedSdkServer },
+		provider.NewActionsProviderServer("test", func() *sdk.Client { return nil }),
+	)
+	require.NoError(t, err)
+
+	resp, err := muxServer.ProviderServer().GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Diagnostics)
+
+	assert.NotEmpty(t, resp.Provider.Block.Attributes)
+	assert.Contains(t, resp.ResourceSchemas, "snowflake_task")
+	assert.Len(t, resp.ActionSchemas, 6)
+	for _, actionType := range []string{
+		"snowflake_execute_task",
+		"snowflake_force_resume_pipe",
+		"snowflake_refresh_dynamic_table",
+		"snowflake_refresh_stage_directory",
+		"snowflake_suspend_warehouse",
+		"snowflake_resume_warehouse",
+	} {
+		assert.Contains(t, resp.ActionSchemas, actionType)
+	}
+}
+
+func TestActionsProvider_ConfigureIgnoresSdkProviderConfig(t *testing.T) {
+	ctx := context.Background()
+	server := provider.NewActionsProviderServer("test", func() *sdk.Client { return nil })()
+
+	configType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"account_name": tftypes.String}}
+	config, err := tfprotov6.NewDynamicValue(configType, tftypes.NewValue(configType, map[string]tftypes.Value{
+		"account_name": tftypes.NewValue(tftypes.String, "account"),
+	}))
+	require.NoError(t, err)
+
+	validateResp, err := server.ValidateProviderConfig(ctx, &tfprotov6.ValidateProviderConfigRequest{Config: &config})
+	require.NoError(t, err)
+	assert.Empty(t, validateResp.Diagnostics)
+
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &config})
+	require.NoError(t, err)
+	assert.Empty(t, configureResp.Diagnostics)
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.ActionWithConfigure = &ExecuteTaskAction{}
+
+func NewExecuteTaskAction() action.Action {
+	return &ExecuteTaskAction{}
+}
+
+type ExecuteTaskAction struct {
+	actionClient
+}
+
+type executeTaskActionModel struct {
+	Database  types.String `tfsdk:"database"`
+	Schema    types.String `tfsdk:"schema"`
+	Name      types.String `tfsdk:"name"`
+	RetryLast types.Bool   `tfsdk:"retry_last"`
+}
+
+func (a *ExecuteTaskAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_execute_task"
+}
+
+func (a *ExecuteTaskAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	attributes := schemaObjectActionAttributes("task")
+	attributes["retry_last"] = schema.BoolAttribute{
+		Description: "Retries the last failed run of the DAG, starting from the failed tasks. For more information, check [EXECUTE TASK docs](https://docs.snowflake.com/en/sql-reference/sql/execute-task).",
+		Optional:    true,
+	}
+	resp.Schema = schema.Schema{
+		Description: "Manually triggers an asynchronous single run of a task (or a task graph) using the EXECUTE TASK command.",
+		Attributes:  attributes,
+	}
+}
+
+func (a *ExecuteTaskAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data executeTaskActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	client, diags := a.client()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := schemaObjectIdentifierFromAttributes(data.Database, data.Schema, data.Name)
+	request := sdk.NewExecuteTaskRequest(id)
+	if data.RetryLast.ValueBool() {
+		request.WithRetryLast(true)
+	}
+	if err := client.Tasks.Execute(ctx, request); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to execute task %s, got error: %s", id.FullyQualifiedName(), err))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Task %s executed", id.FullyQualifiedName())})
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.ActionWithConfigure = &ForceResumePipeAction{}
+
+func NewForceResumePipeAction() action.Action {
+	return &ForceResumePipeAction{}
+}
+
+type ForceResumePipeAction struct {
+	actionClient
+}
+
+type forceResumePipeActionModel struct {
+	Database                       types.String `tfsdk:"database"`
+	Schema                         types.String `tfsdk:"schema"`
+	Name                           types.String `tfsdk:"name"`
+	StalenessCheckOverride         types.Bool   `tfsdk:"staleness_check_override"`
+	OwnershipTransferCheckOverride types.Bool   `tfsdk:"ownership_transfer_check_override"`
+}
+
+func (a *ForceResumePipeAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_force_resume_pipe"
+}
+
+func (a *ForceResumePipeAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	attributes := schemaObjectActionAttributes("pipe")
+	attributes["staleness_check_override"] = schema.BoolAttribute{
+		Description: "Allows resuming a stale pipe (paused for longer than the message retention period).",
+		Optional:    true,
+	}
+	attributes["ownership_transfer_check_override"] = schema.BoolAttribute{
+		Description: "Allows resuming a pipe that was paused as a result of the ownership transfer.",
+		Optional:    true,
+	}
+	resp.Schema = schema.Schema{
+		Description: "Resumes a paused pipe using the [SYSTEM$PIPE_FORCE_RESUME](https://docs.snowflake.com/en/sql-reference/functions/system_pipe_force_resume) function.",
+		Attributes:  attributes,
+	}
+}
+
+func (a *ForceResumePipeAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data forceResumePipeActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	client, diags := a.client()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := schemaObjectIdentifierFromAttributes(data.Database, data.Schema, data.Name)
+	options := make([]sdk.ForceResumePipeOption, 0)
+	if data.StalenessCheckOverride.ValueBool() {
+		options = append(options, sdk.StalenessCheckOverrideForceResumePipeOption)
+	}
+	if data.OwnershipTransferCheckOverride.ValueBool() {
+		options = append(options, sdk.OwnershipTransferCheckOverrideForceResumePipeOption)
+	}
+	if err := client.SystemFunctions.PipeForceResume(id, options); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resume pipe %s, got error: %s", id.FullyQualifiedName(), err))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Pipe %s resumed", id.FullyQualifiedName())})
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.ActionWithConfigure = &RefreshDynamicTableAction{}
+
+func NewRefreshDynamicTableAction() action.Action {
+	return &RefreshDynamicTableAction{}
+}
+
+type RefreshDynamicTableAction struct {
+	actionClient
+}
+
+type refreshDynamicTableActionModel struct {
+	Database types.String `tfsdk:"database"`
+	Schema   types.String `tfsdk:"schema"`
+	Name     types.String `tfsdk:"name"`
+}
+
+func (a *RefreshDynamicTableAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_refresh_dynamic_table"
+}
+
+func (a *RefreshDynamicTableAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manually refreshes a dynamic table using the [ALTER DYNAMIC TABLE ... REFRESH](https://docs.snowflake.com/en/sql-reference/sql/alter-dynamic-table) command.",
+		Attributes:  schemaObjectActionAttributes("dynamic table"),
+	}
+}
+
+func (a *RefreshDynamicTableAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data refreshDynamicTableActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	client, diags := a.client()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := schemaObjectIdentifierFromAttributes(data.Database, data.Schema, data.Name)
+	if err := client.DynamicTables.Alter(ctx, sdk.NewAlterDynamicTableRequest(id).WithRefresh(sdk.Bool(true))); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to refresh dynamic table %s, got error: %s", id.FullyQualifiedName(), err))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Dynamic table %s refreshed", id.FullyQualifiedName())})
+}
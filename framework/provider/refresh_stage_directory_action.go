@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ action.ActionWithConfigure = &RefreshStageDirectoryAction{}
+
+func NewRefreshStageDirectoryAction() action.Action {
+	return &RefreshStageDirectoryAction{}
+}
+
+type RefreshStageDirectoryAction struct {
+	actionClient
+}
+
+type refreshStageDirectoryActionModel struct {
+	Database types.String `tfsdk:"database"`
+	Schema   types.String `tfsdk:"schema"`
+	Name     types.String `tfsdk:"name"`
+	Subpath  types.String `tfsdk:"subpath"`
+}
+
+func (a *RefreshStageDirectoryAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_refresh_stage_directory"
+}
+
+func (a *RefreshStageDirectoryAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	attributes := schemaObjectActionAttributes("stage")
+	attributes["subpath"] = schema.StringAttribute{
+		Description: "Relative path to refresh the metadata for a specific subset of the data files in the stage.",
+		Optional:    true,
+	}
+	resp.Schema = schema.Schema{
+		Description: "Refreshes the directory table of a stage using the [ALTER STAGE ... REFRESH](https://docs.snowflake.com/en/sql-reference/sql/alter-stage) command.",
+		Attributes:  attributes,
+	}
+}
+
+func (a *RefreshStageDirectoryAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data refreshStageDirectoryActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	client, diags := a.client()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := schemaObjectIdentifierFromAttributes(data.Database, data.Schema, data.Name)
+	refresh := sdk.NewDirectoryTableRefreshRequest()
+	if !data.Subpath.IsNull() {
+		refresh.WithSubpath(data.Subpath.ValueStringPointer())
+	}
+	if err := client.Stages.AlterDirectoryTable(ctx, sdk.NewAlterDirectoryTableStageRequest(id).WithRefresh(refresh)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to refresh directory of stage %s, got error: %s", id.FullyQualifiedName(), err))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Directory of stage %s refreshed", id.FullyQualifiedName())})
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.ActionWithConfigure = &SuspendWarehouseAction{}
+	_ action.ActionWithConfigure = &ResumeWarehouseAction{}
+)
+
+type warehouseStateActionModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func NewSuspendWarehouseAction() action.Action {
+	return &SuspendWarehouseAction{}
+}
+
+type SuspendWarehouseAction struct {
+	actionClient
+}
+
+func (a *SuspendWarehouseAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_suspend_warehouse"
+}
+
+func (a *SuspendWarehouseAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Suspends a warehouse using the [ALTER WAREHOUSE ... SUSPEND](https://docs.snowflake.com/en/sql-reference/sql/alter-warehouse) command.",
+		Attributes:  accountObjectActionAttributes("warehouse"),
+	}
+}
+
+func (a *SuspendWarehouseAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data warehouseStateActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	client, diags := a.client()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := sdk.NewAccountObjectIdentifier(data.Name.ValueString())
+	if err := client.Warehouses.Alter(ctx, id, &sdk.AlterWarehouseOptions{Suspend: sdk.Bool(true)}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to suspend warehouse %s, got error: %s", id.FullyQualifiedName(), err))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Warehouse %s suspended", id.FullyQualifiedName())})
+}
+
+func NewResumeWarehouseAction() action.Action {
+	return &ResumeWarehouseAction{}
+}
+
+type ResumeWarehouseAction struct {
+	actionClient
+}
+
+func (a *ResumeWarehouseAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resume_warehouse"
+}
+
+func (a *ResumeWarehouseAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resumes a warehouse using the [ALTER WAREHOUSE ... RESUME IF SUSPENDED](https://docs.snowflake.com/en/sql-reference/sql/alter-warehouse) command.",
+		Attributes:  accountObjectActionAttributes("warehouse"),
+	}
+}
+
+func (a *ResumeWarehouseAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data warehouseStateActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	client, diags := a.client()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := sdk.NewAccountObjectIdentifier(data.Name.ValueString())
+	if err := client.Warehouses.Alter(ctx, id, &sdk.AlterWarehouseOptions{Resume: sdk.Bool(true), IfSuspended: sdk.Bool(true)}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resume warehouse %s, got error: %s", id.FullyQualifiedName(), err))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Warehouse %s resumed", id.FullyQualifiedName())})
+}
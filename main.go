@@ -4,8 +4,11 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/framework/provider"
 	oldprovider "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
@@ -13,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 )
 
+const tracingShutdownTimeout = 5 * time.Second
+
 var version string = "dev" // goreleaser can pass other information to the main package, such as the specific commit
 // https://goreleaser.com/cookbooks/using-main.version/
 
@@ -24,9 +29,10 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
+	sdkProvider := oldprovider.Provider()
 	upgradedSdkServer, err := tf5to6server.UpgradeServer(
 		ctx,
-		oldprovider.Provider().GRPCProvider,
+		sdkProvider.GRPCProvider,
 	)
 	if err != nil {
 		log.Fatal(err)
@@ -38,6 +44,10 @@ func main() {
 		func() tfprotov6.ProviderServer {
 			return upgradedSdkServer
 		},
+		// actions are not supported by the SDKv2, so they are served by the plugin framework using the client configured by the SDKv2 provider
+		provider.NewActionsProviderServer(version, func() *sdk.Client {
+			return oldprovider.ConfiguredClient(sdkProvider)
+		}),
 	}
 
 	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
@@ -56,6 +66,14 @@ func main() {
 		muxServer.ProviderServer,
 		serveOpts...,
 	)
+
+	// flush the spans of the statements run by the provider before exiting
+	shutdownCtx, cancel := context.WithTimeout(ctx, tracingShutdownTimeout)
+	if shutdownErr := oldprovider.ShutdownTracing(shutdownCtx); shutdownErr != nil {
+		log.Printf("[WARN] failed to flush the traces: %v", shutdownErr)
+	}
+	cancel()
+
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -19,6 +19,9 @@ type SchemaModel struct {
 	DefaultDdlCollation                     tfconfig.Variable `json:"default_ddl_collation,omitempty"`
 	EnableConsoleOutput                     tfconfig.Variable `json:"enable_console_output,omitempty"`
 	ExternalVolume                          tfconfig.Variable `json:"external_volume,omitempty"`
+	ExecutionRole                           tfconfig.Variable `json:"execution_role,omitempty"`
+	ExecutionSecondaryRoles                 tfconfig.Variable `json:"execution_secondary_roles,omitempty"`
+	ExecutionWarehouse                      tfconfig.Variable `json:"execution_warehouse,omitempty"`
 	FullyQualifiedName                      tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	IsTransient                             tfconfig.Variable `json:"is_transient,omitempty"`
 	LogLevel                                tfconfig.Variable `json:"log_level,omitempty"`
@@ -123,6 +126,21 @@ func (s *SchemaModel) WithExternalVolume(externalVolume string) *SchemaModel {
 	return s
 }
 
+func (s *SchemaModel) WithExecutionRole(executionRole string) *SchemaModel {
+	s.ExecutionRole = tfconfig.StringVariable(executionRole)
+	return s
+}
+
+func (s *SchemaModel) WithExecutionSecondaryRoles(executionSecondaryRoles string) *SchemaModel {
+	s.ExecutionSecondaryRoles = tfconfig.StringVariable(executionSecondaryRoles)
+	return s
+}
+
+func (s *SchemaModel) WithExecutionWarehouse(executionWarehouse string) *SchemaModel {
+	s.ExecutionWarehouse = tfconfig.StringVariable(executionWarehouse)
+	return s
+}
+
 func (s *SchemaModel) WithFullyQualifiedName(fullyQualifiedName string) *SchemaModel {
 	s.FullyQualifiedName = tfconfig.StringVariable(fullyQualifiedName)
 	return s
@@ -242,6 +260,21 @@ func (s *SchemaModel) WithExternalVolumeValue(value tfconfig.Variable) *SchemaMo
 	return s
 }
 
+func (s *SchemaModel) WithExecutionRoleValue(value tfconfig.Variable) *SchemaModel {
+	s.ExecutionRole = value
+	return s
+}
+
+func (s *SchemaModel) WithExecutionSecondaryRolesValue(value tfconfig.Variable) *SchemaModel {
+	s.ExecutionSecondaryRoles = value
+	return s
+}
+
+func (s *SchemaModel) WithExecutionWarehouseValue(value tfconfig.Variable) *SchemaModel {
+	s.ExecutionWarehouse = value
+	return s
+}
+
 func (s *SchemaModel) WithFullyQualifiedNameValue(value tfconfig.Variable) *SchemaModel {
 	s.FullyQualifiedName = value
 	return s
@@ -12,22 +12,25 @@ import (
 )
 
 type ViewModel struct {
-	AggregationPolicy  tfconfig.Variable `json:"aggregation_policy,omitempty"`
-	ChangeTracking     tfconfig.Variable `json:"change_tracking,omitempty"`
-	Column             tfconfig.Variable `json:"column,omitempty"`
-	Comment            tfconfig.Variable `json:"comment,omitempty"`
-	CopyGrants         tfconfig.Variable `json:"copy_grants,omitempty"`
-	DataMetricFunction tfconfig.Variable `json:"data_metric_function,omitempty"`
-	DataMetricSchedule tfconfig.Variable `json:"data_metric_schedule,omitempty"`
-	Database           tfconfig.Variable `json:"database,omitempty"`
-	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
-	IsRecursive        tfconfig.Variable `json:"is_recursive,omitempty"`
-	IsSecure           tfconfig.Variable `json:"is_secure,omitempty"`
-	IsTemporary        tfconfig.Variable `json:"is_temporary,omitempty"`
-	Name               tfconfig.Variable `json:"name,omitempty"`
-	RowAccessPolicy    tfconfig.Variable `json:"row_access_policy,omitempty"`
-	Schema             tfconfig.Variable `json:"schema,omitempty"`
-	Statement          tfconfig.Variable `json:"statement,omitempty"`
+	AggregationPolicy       tfconfig.Variable `json:"aggregation_policy,omitempty"`
+	ChangeTracking          tfconfig.Variable `json:"change_tracking,omitempty"`
+	Column                  tfconfig.Variable `json:"column,omitempty"`
+	Comment                 tfconfig.Variable `json:"comment,omitempty"`
+	CopyGrants              tfconfig.Variable `json:"copy_grants,omitempty"`
+	DataMetricFunction      tfconfig.Variable `json:"data_metric_function,omitempty"`
+	DataMetricSchedule      tfconfig.Variable `json:"data_metric_schedule,omitempty"`
+	ExecutionRole           tfconfig.Variable `json:"execution_role,omitempty"`
+	ExecutionSecondaryRoles tfconfig.Variable `json:"execution_secondary_roles,omitempty"`
+	ExecutionWarehouse      tfconfig.Variable `json:"execution_warehouse,omitempty"`
+	Database                tfconfig.Variable `json:"database,omitempty"`
+	FullyQualifiedName      tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	IsRecursive             tfconfig.Variable `json:"is_recursive,omitempty"`
+	IsSecure                tfconfig.Variable `json:"is_secure,omitempty"`
+	IsTemporary             tfconfig.Variable `json:"is_temporary,omitempty"`
+	Name                    tfconfig.Variable `json:"name,omitempty"`
+	RowAccessPolicy         tfconfig.Variable `json:"row_access_policy,omitempty"`
+	Schema                  tfconfig.Variable `json:"schema,omitempty"`
+	Statement               tfconfig.Variable `json:"statement,omitempty"`
 
 	*config.ResourceModelMeta
 }
@@ -117,6 +120,21 @@ func (v *ViewModel) WithDatabase(database string) *ViewModel {
 	return v
 }
 
+func (v *ViewModel) WithExecutionRole(executionRole string) *ViewModel {
+	v.ExecutionRole = tfconfig.StringVariable(executionRole)
+	return v
+}
+
+func (v *ViewModel) WithExecutionSecondaryRoles(executionSecondaryRoles string) *ViewModel {
+	v.ExecutionSecondaryRoles = tfconfig.StringVariable(executionSecondaryRoles)
+	return v
+}
+
+func (v *ViewModel) WithExecutionWarehouse(executionWarehouse string) *ViewModel {
+	v.ExecutionWarehouse = tfconfig.StringVariable(executionWarehouse)
+	return v
+}
+
 func (v *ViewModel) WithFullyQualifiedName(fullyQualifiedName string) *ViewModel {
 	v.FullyQualifiedName = tfconfig.StringVariable(fullyQualifiedName)
 	return v
@@ -198,6 +216,21 @@ func (v *ViewModel) WithDatabaseValue(value tfconfig.Variable) *ViewModel {
 	return v
 }
 
+func (v *ViewModel) WithExecutionRoleValue(value tfconfig.Variable) *ViewModel {
+	v.ExecutionRole = value
+	return v
+}
+
+func (v *ViewModel) WithExecutionSecondaryRolesValue(value tfconfig.Variable) *ViewModel {
+	v.ExecutionSecondaryRoles = value
+	return v
+}
+
+func (v *ViewModel) WithExecutionWarehouseValue(value tfconfig.Variable) *ViewModel {
+	v.ExecutionWarehouse = value
+	return v
+}
+
 func (v *ViewModel) WithFullyQualifiedNameValue(value tfconfig.Variable) *ViewModel {
 	v.FullyQualifiedName = value
 	return v
@@ -11,6 +11,7 @@ import (
 type SnowflakeModel struct {
 	AccountName                        tfconfig.Variable `json:"account_name,omitempty"`
 	Authenticator                      tfconfig.Variable `json:"authenticator,omitempty"`
+	CacheShowResults                   tfconfig.Variable `json:"cache_show_results,omitempty"`
 	ClientIp                           tfconfig.Variable `json:"client_ip,omitempty"`
 	ClientRequestMfaToken              tfconfig.Variable `json:"client_request_mfa_token,omitempty"`
 	ClientStoreTemporaryCredential     tfconfig.Variable `json:"client_store_temporary_credential,omitempty"`
@@ -44,9 +45,17 @@ type SnowflakeModel struct {
 	RequestTimeout                     tfconfig.Variable `json:"request_timeout,omitempty"`
 	Role                               tfconfig.Variable `json:"role,omitempty"`
 	SkipTomlFilePermissionVerification tfconfig.Variable `json:"skip_toml_file_permission_verification,omitempty"`
+	SqlPreview                         tfconfig.Variable `json:"sql_preview,omitempty"`
 	TmpDirectoryPath                   tfconfig.Variable `json:"tmp_directory_path,omitempty"`
 	Token                              tfconfig.Variable `json:"token,omitempty"`
 	TokenAccessor                      tfconfig.Variable `json:"token_accessor,omitempty"`
+	TracingExporter                    tfconfig.Variable `json:"tracing_exporter,omitempty"`
+	TracingFilePath                    tfconfig.Variable `json:"tracing_file_path,omitempty"`
+	TracingOtlpEndpoint                tfconfig.Variable `json:"tracing_otlp_endpoint,omitempty"`
+	TransientErrorsMaxRetries          tfconfig.Variable `json:"transient_errors_max_retries,omitempty"`
+	UsageTrackingAsQueryTag            tfconfig.Variable `json:"usage_tracking_as_query_tag,omitempty"`
+	UsageTrackingModuleAddress         tfconfig.Variable `json:"usage_tracking_module_address,omitempty"`
+	UsageTrackingWorkspace             tfconfig.Variable `json:"usage_tracking_workspace,omitempty"`
 	UseLegacyTomlFile                  tfconfig.Variable `json:"use_legacy_toml_file,omitempty"`
 	User                               tfconfig.Variable `json:"user,omitempty"`
 	ValidateDefaultParameters          tfconfig.Variable `json:"validate_default_parameters,omitempty"`
@@ -85,6 +94,11 @@ func (s *SnowflakeModel) WithAuthenticator(authenticator string) *SnowflakeModel
 	return s
 }
 
+func (s *SnowflakeModel) WithCacheShowResults(cacheShowResults bool) *SnowflakeModel {
+	s.CacheShowResults = tfconfig.BoolVariable(cacheShowResults)
+	return s
+}
+
 func (s *SnowflakeModel) WithClientIp(clientIp string) *SnowflakeModel {
 	s.ClientIp = tfconfig.StringVariable(clientIp)
 	return s
@@ -244,6 +258,11 @@ func (s *SnowflakeModel) WithSkipTomlFilePermissionVerification(skipTomlFilePerm
 	return s
 }
 
+func (s *SnowflakeModel) WithSqlPreview(sqlPreview bool) *SnowflakeModel {
+	s.SqlPreview = tfconfig.BoolVariable(sqlPreview)
+	return s
+}
+
 func (s *SnowflakeModel) WithTmpDirectoryPath(tmpDirectoryPath string) *SnowflakeModel {
 	s.TmpDirectoryPath = tfconfig.StringVariable(tmpDirectoryPath)
 	return s
@@ -256,6 +275,41 @@ func (s *SnowflakeModel) WithToken(token string) *SnowflakeModel {
 
 // token_accessor attribute type is not yet supported, so WithTokenAccessor can't be generated
 
+func (s *SnowflakeModel) WithTracingExporter(tracingExporter string) *SnowflakeModel {
+	s.TracingExporter = tfconfig.StringVariable(tracingExporter)
+	return s
+}
+
+func (s *SnowflakeModel) WithTracingFilePath(tracingFilePath string) *SnowflakeModel {
+	s.TracingFilePath = tfconfig.StringVariable(tracingFilePath)
+	return s
+}
+
+func (s *SnowflakeModel) WithTracingOtlpEndpoint(tracingOtlpEndpoint string) *SnowflakeModel {
+	s.TracingOtlpEndpoint = tfconfig.StringVariable(tracingOtlpEndpoint)
+	return s
+}
+
+func (s *SnowflakeModel) WithTransientErrorsMaxRetries(transientErrorsMaxRetries int) *SnowflakeModel {
+	s.TransientErrorsMaxRetries = tfconfig.IntegerVariable(transientErrorsMaxRetries)
+	return s
+}
+
+func (s *SnowflakeModel) WithUsageTrackingAsQueryTag(usageTrackingAsQueryTag bool) *SnowflakeModel {
+	s.UsageTrackingAsQueryTag = tfconfig.BoolVariable(usageTrackingAsQueryTag)
+	return s
+}
+
+func (s *SnowflakeModel) WithUsageTrackingModuleAddress(usageTrackingModuleAddress string) *SnowflakeModel {
+	s.UsageTrackingModuleAddress = tfconfig.StringVariable(usageTrackingModuleAddress)
+	return s
+}
+
+func (s *SnowflakeModel) WithUsageTrackingWorkspace(usageTrackingWorkspace string) *SnowflakeModel {
+	s.UsageTrackingWorkspace = tfconfig.StringVariable(usageTrackingWorkspace)
+	return s
+}
+
 func (s *SnowflakeModel) WithUseLegacyTomlFile(useLegacyTomlFile bool) *SnowflakeModel {
 	s.UseLegacyTomlFile = tfconfig.BoolVariable(useLegacyTomlFile)
 	return s
@@ -290,6 +344,11 @@ func (s *SnowflakeModel) WithAuthenticatorValue(value tfconfig.Variable) *Snowfl
 	return s
 }
 
+func (s *SnowflakeModel) WithCacheShowResultsValue(value tfconfig.Variable) *SnowflakeModel {
+	s.CacheShowResults = value
+	return s
+}
+
 func (s *SnowflakeModel) WithClientIpValue(value tfconfig.Variable) *SnowflakeModel {
 	s.ClientIp = value
 	return s
@@ -455,6 +514,11 @@ func (s *SnowflakeModel) WithSkipTomlFilePermissionVerificationValue(value tfcon
 	return s
 }
 
+func (s *SnowflakeModel) WithSqlPreviewValue(value tfconfig.Variable) *SnowflakeModel {
+	s.SqlPreview = value
+	return s
+}
+
 func (s *SnowflakeModel) WithTmpDirectoryPathValue(value tfconfig.Variable) *SnowflakeModel {
 	s.TmpDirectoryPath = value
 	return s
@@ -470,6 +534,41 @@ func (s *SnowflakeModel) WithTokenAccessorValue(value tfconfig.Variable) *Snowfl
 	return s
 }
 
+func (s *SnowflakeModel) WithTracingExporterValue(value tfconfig.Variable) *SnowflakeModel {
+	s.TracingExporter = value
+	return s
+}
+
+func (s *SnowflakeModel) WithTracingFilePathValue(value tfconfig.Variable) *SnowflakeModel {
+	s.TracingFilePath = value
+	return s
+}
+
+func (s *SnowflakeModel) WithTracingOtlpEndpointValue(value tfconfig.Variable) *SnowflakeModel {
+	s.TracingOtlpEndpoint = value
+	return s
+}
+
+func (s *SnowflakeModel) WithTransientErrorsMaxRetriesValue(value tfconfig.Variable) *SnowflakeModel {
+	s.TransientErrorsMaxRetries = value
+	return s
+}
+
+func (s *SnowflakeModel) WithUsageTrackingAsQueryTagValue(value tfconfig.Variable) *SnowflakeModel {
+	s.UsageTrackingAsQueryTag = value
+	return s
+}
+
+func (s *SnowflakeModel) WithUsageTrackingModuleAddressValue(value tfconfig.Variable) *SnowflakeModel {
+	s.UsageTrackingModuleAddress = value
+	return s
+}
+
+func (s *SnowflakeModel) WithUsageTrackingWorkspaceValue(value tfconfig.Variable) *SnowflakeModel {
+	s.UsageTrackingWorkspace = value
+	return s
+}
+
 func (s *SnowflakeModel) WithUseLegacyTomlFileValue(value tfconfig.Variable) *SnowflakeModel {
 	s.UseLegacyTomlFile = value
 	return s
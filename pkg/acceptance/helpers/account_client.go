@@ -138,7 +138,7 @@ func (c *AccountClient) CreateAndLogIn(t *testing.T) (*sdk.Account, *sdk.Client,
 
 	var client *sdk.Client
 	require.Eventually(t, func() bool {
-		newClient, err := sdk.NewClient(&gosnowflake.Config{
+		newClient, err := NewClient(&gosnowflake.Config{
 			Account:       fmt.Sprintf("%s-%s", account.OrganizationName, account.AccountName),
 			User:          name,
 			Host:          strings.TrimPrefix(*account.AccountLocatorUrl, `https://`),
@@ -14,6 +14,9 @@ import (
 // It always starts with a letter and contains only letters.
 var generatedRandomValue string
 
+// deterministic is set when the statements are recorded or replayed with the sql cassette.
+var deterministic bool
+
 func init() {
 	generatedRandomValue = os.Getenv(string(testenvs.GeneratedRandomValue))
 	requireGeneratedRandomValue := os.Getenv(string(testenvs.RequireGeneratedRandomValue))
@@ -21,9 +24,17 @@ func init() {
 		log.Printf("Generated random value is required for tests to run. Set %s env.", testenvs.GeneratedRandomValue)
 		os.Exit(1)
 	}
+	// the recorded statements contain the random values, so they have to be the same in the replayed run
+	deterministic = os.Getenv(string(testenvs.SqlCassetteMode)) != ""
+	if deterministic {
+		gofakeit.Seed(1)
+	}
 }
 
 func UUID() string {
+	if deterministic {
+		return gofakeit.UUID()
+	}
 	v, _ := uuid.GenerateUUID()
 	return v
 }
@@ -94,6 +94,16 @@ func (c *RoleClient) GrantRoleToUser(t *testing.T, id sdk.AccountObjectIdentifie
 	require.NoError(t, err)
 }
 
+func (c *RoleClient) GrantRoleToRole(t *testing.T, id sdk.AccountObjectIdentifier, parentRoleId sdk.AccountObjectIdentifier) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Grant(ctx, sdk.NewGrantRoleRequest(id, sdk.GrantRole{
+		Role: sdk.Pointer(parentRoleId),
+	}))
+	require.NoError(t, err)
+}
+
 func (c *RoleClient) GrantRoleToCurrentRole(t *testing.T, id sdk.AccountObjectIdentifier) {
 	t.Helper()
 	ctx := context.Background()
@@ -0,0 +1,66 @@
+package helpers
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/sqlcassette"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/tracking"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// sqlCassetteConnector is the connector factory of the cassette opened by UseSqlCassetteFromEnv; it is nil when the cassette is not used.
+var sqlCassetteConnector sdk.ConnectorFactory
+
+// UseSqlCassetteFromEnv opens the cassette when the cassette mode env is set. The clients created afterwards with NewClient,
+// and the provider configured with SqlCassetteConnector, record the statements with their results to the cassette, or replay
+// them from it without connecting to Snowflake. It should be called once, before the tests. The returned function closes the cassette.
+func UseSqlCassetteFromEnv() (func() error, error) {
+	modeValue := os.Getenv(string(testenvs.SqlCassetteMode))
+	if modeValue == "" {
+		return func() error { return nil }, nil
+	}
+	mode, err := sqlcassette.ToMode(modeValue)
+	if err != nil {
+		return nil, err
+	}
+	path := os.Getenv(string(testenvs.SqlCassettePath))
+	if path == "" {
+		return nil, fmt.Errorf("%s env is required to use the sql cassette", testenvs.SqlCassettePath)
+	}
+	// the usage tracking comments are not recorded, because they change with every provider version
+	cassette, err := sqlcassette.New(mode, path, tracking.TrimMetadata)
+	if err != nil {
+		return nil, err
+	}
+	sqlCassetteConnector = func(cfg *gosnowflake.Config) (driver.Connector, error) {
+		var live driver.Connector
+		if cassette.Mode() == sqlcassette.RecordMode {
+			if _, err := gosnowflake.DSN(cfg); err != nil {
+				return nil, err
+			}
+			live = gosnowflake.NewConnector(gosnowflake.SnowflakeDriver{}, *cfg)
+		}
+		// the statements of the clients for different accounts and users are recorded separately
+		connection := strings.ToUpper(fmt.Sprintf("%s.%s", cfg.Account, cfg.User))
+		return cassette.Connector(connection, live), nil
+	}
+	return func() error {
+		sqlCassetteConnector = nil
+		return cassette.Close()
+	}, nil
+}
+
+// SqlCassetteConnector returns the connector factory of the cassette opened by UseSqlCassetteFromEnv, or nil when the cassette is not used.
+func SqlCassetteConnector() sdk.ConnectorFactory {
+	return sqlCassetteConnector
+}
+
+// NewClient creates the client for the given config, using the cassette opened by UseSqlCassetteFromEnv (if any).
+func NewClient(cfg *gosnowflake.Config) (*sdk.Client, error) {
+	return sdk.NewClientWithConnector(cfg, sqlCassetteConnector)
+}
@@ -41,6 +41,9 @@ const (
 
 	SimplifiedIntegrationTestsSetup env = "TEST_SF_TF_SIMPLIFIED_INTEGRATION_TESTS_SETUP"
 
+	SqlCassetteMode env = "TEST_SF_TF_SQL_CASSETTE_MODE"
+	SqlCassettePath env = "TEST_SF_TF_SQL_CASSETTE_PATH"
+
 	TestResourceDataTypeDiffHandlingEnv env = "TEST_SF_TF_TEST_RESOURCE_DATA_DIFF_HANDLING_ENV"
 )
 
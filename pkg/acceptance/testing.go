@@ -83,6 +83,11 @@ func init() {
 	}
 	_ = testAccProtoV6ProviderFactoriesNew
 
+	// the cassette is not closed explicitly, because there is no common teardown of the acceptance tests; every statement is written to it right away
+	if _, err := helpers.UseSqlCassetteFromEnv(); err != nil {
+		log.Panicf("Cannot set up the sql cassette, err: %v", err)
+	}
+
 	// TODO [SNOW-2054383]: Use the new TOML format.
 	defaultConfig, err := sdk.ProfileConfig(testprofiles.Default, sdk.WithUseLegacyTomlFormat(true))
 	if err != nil {
@@ -93,7 +98,7 @@ func init() {
 	}
 	atc.config = defaultConfig
 
-	client, err := sdk.NewClient(defaultConfig)
+	client, err := helpers.NewClient(defaultConfig)
 	if err != nil {
 		log.Panicf("Cannot instantiate new client, err: %v", err)
 	}
@@ -104,7 +109,7 @@ func init() {
 	if err != nil {
 		log.Panicf("Config for the secondary client is needed to run acceptance tests, err: %v", err)
 	}
-	secondaryClient, err := sdk.NewClient(cfg)
+	secondaryClient, err := helpers.NewClient(cfg)
 	if err != nil {
 		log.Panicf("Cannot instantiate new secondary client, err: %v", err)
 	}
@@ -164,7 +169,7 @@ func ConfigureProviderWithConfigCache(ctx context.Context, d *schema.ResourceDat
 	}
 	log.Printf("[DEBUG] No cached provider configuration found or caching is not enabled; configuring a new provider")
 
-	providerCtx, clientErrorDiag := provider.ConfigureProvider(ctx, d)
+	providerCtx, clientErrorDiag := provider.ConfigureProviderWithConnector(helpers.SqlCassetteConnector())(ctx, d)
 
 	if providerCtx != nil && accTestEnabled && oswrapper.Getenv("SF_TF_ACC_TEST_ENABLE_ALL_PREVIEW_FEATURES") == "true" {
 		providerCtx.(*internalprovider.Context).EnabledFeatures = previewfeatures.AllPreviewFeatures
@@ -215,7 +215,11 @@ func handleExtendedIn(d *schema.ResourceData, setField **sdk.ExtendedIn) error {
 
 func TrackingReadWrapper(datasourceName datasources.Datasource, readImplementation schema.ReadContextFunc) schema.ReadContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		ctx = tracking.NewContext(ctx, tracking.NewVersionedDatasourceMetadata(datasourceName))
+		metadata := tracking.NewVersionedDatasourceMetadata(datasourceName)
+		if providerCtx, ok := meta.(*provider.Context); ok && providerCtx != nil {
+			metadata = metadata.WithTerraformContext(providerCtx.TerraformContext, "")
+		}
+		ctx = tracking.NewContext(ctx, metadata)
 		return readImplementation(ctx, d, meta)
 	}
 }
@@ -0,0 +1,213 @@
+package datasources
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var effectivePrivilegesSchema = map[string]*schema.Schema{
+	"user": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Lists the effective privileges of the user, inherited from the roles granted to the user.",
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		ExactlyOneOf:     []string{"user", "account_role", "database_role"},
+	},
+	"account_role": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Lists the effective privileges of the account role, including the ones inherited from the granted account and database roles.",
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		ExactlyOneOf:     []string{"user", "account_role", "database_role"},
+	},
+	"database_role": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Lists the effective privileges of the database role, including the ones inherited from the granted database roles. Must be a fully qualified name (\"&lt;db_name&gt;\".\"&lt;database_role_name&gt;\").",
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+		ExactlyOneOf:     []string{"user", "account_role", "database_role"},
+	},
+	"include_public_role": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Includes the privileges of the PUBLIC role, which is implicitly granted to every user and account role. Not applicable to database roles.",
+	},
+	"effective_privileges": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The privileges granted to the user or role, directly or through the role hierarchy. The role grants themselves are not listed; they are visible in the inheritance paths.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"privilege": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The privilege granted.",
+				},
+				"granted_on": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The object type on which the privilege was granted.",
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The fully qualified name of the object on which the privilege was granted.",
+				},
+				"grant_option": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "If true, the grantee can pass the privilege to other roles.",
+				},
+				"granted_to": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type of the role to which the privilege was granted directly.",
+				},
+				"grantee_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The fully qualified name of the role to which the privilege was granted directly.",
+				},
+				"inheritance_path": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "The fully qualified names of the user or roles through which the privilege is inherited, starting with the queried user or role and ending with the grantee.",
+				},
+			},
+		},
+	},
+}
+
+func EffectivePrivileges() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.EffectivePrivilegesDatasource), TrackingReadWrapper(datasources.EffectivePrivileges, ReadEffectivePrivileges)),
+		Schema:      effectivePrivilegesSchema,
+		Description: "Data source used to get the effective privileges of a user or a role. It walks the role hierarchy (SHOW GRANTS TO) recursively and returns every inherited privilege with the path of roles it is inherited through.",
+	}
+}
+
+// effectivePrivilegesRole is the role visited in the role hierarchy together with the path it was reached through.
+type effectivePrivilegesRole struct {
+	objectType sdk.ObjectType
+	id         sdk.ObjectIdentifier
+	path       []string
+}
+
+func ReadEffectivePrivileges(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	var queue []effectivePrivilegesRole
+	var principal string
+	inheritsPublicRole := false
+
+	if user, ok := d.GetOk("user"); ok {
+		userId, err := sdk.ParseAccountObjectIdentifier(user.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		principal = userId.FullyQualifiedName()
+		inheritsPublicRole = true
+
+		grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+			To: &sdk.ShowGrantsTo{
+				User: userId,
+			},
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, grant := range grants {
+			roleId := sdk.NewAccountObjectIdentifier(grant.Name.Name())
+			queue = append(queue, effectivePrivilegesRole{objectType: sdk.ObjectTypeRole, id: roleId, path: []string{principal, roleId.FullyQualifiedName()}})
+		}
+	}
+	if accountRole, ok := d.GetOk("account_role"); ok {
+		roleId, err := sdk.ParseAccountObjectIdentifier(accountRole.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		principal = roleId.FullyQualifiedName()
+		inheritsPublicRole = true
+		queue = append(queue, effectivePrivilegesRole{objectType: sdk.ObjectTypeRole, id: roleId, path: []string{principal}})
+	}
+	if databaseRole, ok := d.GetOk("database_role"); ok {
+		databaseRoleId, err := sdk.ParseDatabaseObjectIdentifier(databaseRole.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		principal = databaseRoleId.FullyQualifiedName()
+		queue = append(queue, effectivePrivilegesRole{objectType: sdk.ObjectTypeDatabaseRole, id: databaseRoleId, path: []string{principal}})
+	}
+	if inheritsPublicRole && d.Get("include_public_role").(bool) {
+		queue = append(queue, effectivePrivilegesRole{objectType: sdk.ObjectTypeRole, id: snowflakeroles.Public, path: []string{principal, snowflakeroles.Public.FullyQualifiedName()}})
+	}
+
+	effectivePrivileges := make([]map[string]any, 0)
+	visited := make(map[string]bool)
+	// the roles are visited breadth-first, so every privilege is reported with the shortest inheritance path
+	for len(queue) > 0 {
+		role := queue[0]
+		queue = queue[1:]
+
+		key := string(role.objectType) + role.id.FullyQualifiedName()
+		if visited[key] {
+			continue
+		}
+		visited[key] = true
+
+		opts := &sdk.ShowGrantOptions{To: new(sdk.ShowGrantsTo)}
+		switch id := role.id.(type) {
+		case sdk.AccountObjectIdentifier:
+			opts.To.Role = id
+		case sdk.DatabaseObjectIdentifier:
+			opts.To.DatabaseRole = id
+		}
+		grants, err := client.Grants.Show(ctx, opts)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, grant := range grants {
+			if grant.Privilege == sdk.AccountObjectPrivilegeUsage.String() && slices.Contains([]sdk.ObjectType{sdk.ObjectTypeRole, sdk.ObjectTypeDatabaseRole}, grant.GrantedOn) {
+				// the database roles are returned with the database name, so they are already parsed as sdk.DatabaseObjectIdentifier
+				grantedRoleId := grant.Name
+				if grant.GrantedOn == sdk.ObjectTypeRole {
+					grantedRoleId = sdk.NewAccountObjectIdentifier(grant.Name.Name())
+				}
+				queue = append(queue, effectivePrivilegesRole{
+					objectType: grant.GrantedOn,
+					id:         grantedRoleId,
+					path:       append(slices.Clone(role.path), grantedRoleId.FullyQualifiedName()),
+				})
+				continue
+			}
+			effectivePrivileges = append(effectivePrivileges, map[string]any{
+				"privilege":        grant.Privilege,
+				"granted_on":       grant.GrantedOn.String(),
+				"name":             grant.Name.FullyQualifiedName(),
+				"grant_option":     grant.GrantOption,
+				"granted_to":       role.objectType.String(),
+				"grantee_name":     role.id.FullyQualifiedName(),
+				"inheritance_path": role.path,
+			})
+		}
+	}
+
+	if err := d.Set("effective_privileges", effectivePrivileges); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("effective_privileges")
+	return nil
+}
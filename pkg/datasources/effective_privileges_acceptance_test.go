@@ -0,0 +1,67 @@
+//go:build !account_level_tests
+
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_EffectivePrivileges_AccountRole(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	database, databaseCleanup := acc.TestClient().Database.CreateDatabase(t)
+	t.Cleanup(databaseCleanup)
+
+	parentRole, parentRoleCleanup := acc.TestClient().Role.CreateRole(t)
+	t.Cleanup(parentRoleCleanup)
+
+	childRole, childRoleCleanup := acc.TestClient().Role.CreateRole(t)
+	t.Cleanup(childRoleCleanup)
+
+	acc.TestClient().Role.GrantRoleToRole(t, childRole.ID(), parentRole.ID())
+	acc.TestClient().Grant.GrantPrivilegesOnDatabaseToAccountRole(t, childRole.ID(), database.ID(), []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeMonitor}, false)
+
+	datasourceName := "data.snowflake_effective_privileges.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: effectivePrivilegesOfAccountRole(parentRole.ID()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "effective_privileges.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "effective_privileges.0.privilege", "MONITOR"),
+					resource.TestCheckResourceAttr(datasourceName, "effective_privileges.0.granted_on", "DATABASE"),
+					resource.TestCheckResourceAttr(datasourceName, "effective_privileges.0.name", database.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(datasourceName, "effective_privileges.0.granted_to", "ROLE"),
+					resource.TestCheckResourceAttr(datasourceName, "effective_privileges.0.grantee_name", childRole.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(datasourceName, "effective_privileges.0.inheritance_path.#", "2"),
+					resource.TestCheckResourceAttr(datasourceName, "effective_privileges.0.inheritance_path.0", parentRole.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(datasourceName, "effective_privileges.0.inheritance_path.1", childRole.ID().FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
+
+func effectivePrivilegesOfAccountRole(roleId sdk.AccountObjectIdentifier) string {
+	return fmt.Sprintf(`
+data "snowflake_effective_privileges" "test" {
+  account_role        = %[1]q
+  include_public_role = false
+}
+`, roleId.FullyQualifiedName())
+}
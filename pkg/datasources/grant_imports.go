@@ -0,0 +1,130 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/grantimport"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var grantImportsSchema = map[string]*schema.Schema{
+	"account_role_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "The fully qualified name of the account role whose grants are imported.",
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		ExactlyOneOf:     []string{"account_role_name", "database_role_name"},
+	},
+	"database_role_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "The fully qualified name of the database role whose grants are imported.",
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+		ExactlyOneOf:     []string{"account_role_name", "database_role_name"},
+	},
+	"imports": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The grant resources managing the existing grants. The privileges granted on the same object with the same grant option are grouped into a single resource.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"resource_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The type of the grant resource (e.g. snowflake_grant_privileges_to_account_role).",
+				},
+				"resource_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The generated name of the resource.",
+				},
+				"import_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The identifier accepted by the import of the resource.",
+				},
+				"hcl": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The import block and the resource block for the grant.",
+				},
+			},
+		},
+	},
+	"skipped_grants": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The grants that can not be managed by any of the grant resources (e.g. application role grants), with the reason.",
+	},
+	"hcl": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The import blocks and the resource blocks for all the grants.",
+	},
+}
+
+func GrantImports() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.GrantImportsDatasource), TrackingReadWrapper(datasources.GrantImports, ReadGrantImports)),
+		Schema:      grantImportsSchema,
+		Description: "Data source used to generate the import identifiers and the configuration of the grant resources from the existing grants. It reads SHOW GRANTS TO ROLE (or TO DATABASE ROLE), so only the current grants are covered; future grants are not returned by this command.",
+	}
+}
+
+func ReadGrantImports(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	var grantee grantimport.Grantee
+	if v, ok := d.GetOk("account_role_name"); ok {
+		id, err := sdk.ParseAccountObjectIdentifier(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		grantee.AccountRole = &id
+	}
+	if v, ok := d.GetOk("database_role_name"); ok {
+		id, err := sdk.ParseDatabaseObjectIdentifier(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		grantee.DatabaseRole = &id
+	}
+
+	result, err := grantimport.Load(ctx, client, grantee)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	imports := collections.Map(result.Imports, func(imp grantimport.Import) map[string]any {
+		return map[string]any{
+			"resource_type": imp.ResourceType,
+			"resource_name": imp.ResourceName,
+			"import_id":     imp.ImportId,
+			"hcl":           imp.HCL(),
+		}
+	})
+	if err := d.Set("imports", imports); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("skipped_grants", result.Skipped); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("hcl", result.HCL()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if grantee.AccountRole != nil {
+		d.SetId(grantee.AccountRole.FullyQualifiedName())
+	} else {
+		d.SetId(grantee.DatabaseRole.FullyQualifiedName())
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+//go:build !account_level_tests
+
+package datasources_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_GrantImports(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	role, roleCleanup := acc.TestClient().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+
+	acc.TestClient().Grant.GrantPrivilegesOnDatabaseToAccountRole(t, role.ID(), acc.TestClient().Ids.DatabaseId(), []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeUsage, sdk.AccountObjectPrivilegeMonitor}, false)
+
+	datasourceName := "data.snowflake_grant_imports.test"
+	expectedId := fmt.Sprintf(`%s|false|false|MONITOR,USAGE|OnAccountObject|DATABASE|%s`, role.ID().FullyQualifiedName(), acc.TestClient().Ids.DatabaseId().FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: grantImports(role.ID()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "imports.#", "1"),
+					resource.TestCheckResourceAttr(datasourceName, "imports.0.resource_type", "snowflake_grant_privileges_to_account_role"),
+					resource.TestCheckResourceAttr(datasourceName, "imports.0.import_id", expectedId),
+					resource.TestCheckResourceAttrSet(datasourceName, "imports.0.hcl"),
+					resource.TestCheckResourceAttr(datasourceName, "skipped_grants.#", "0"),
+					resource.TestCheckResourceAttrSet(datasourceName, "hcl"),
+				),
+			},
+		},
+	})
+}
+
+func grantImports(roleId sdk.AccountObjectIdentifier) string {
+	return fmt.Sprintf(`
+data "snowflake_grant_imports" "test" {
+  account_role_name = %q
+}
+`, roleId.Name())
+}
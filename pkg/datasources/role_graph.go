@@ -0,0 +1,151 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/rolegraph"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var roleGraphNodeSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The object type of the node (ROLE, DATABASE ROLE, APPLICATION ROLE, USER, or other grantee type).",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The fully qualified name of the node.",
+		},
+	},
+}
+
+var roleGraphSchema = map[string]*schema.Schema{
+	"nodes": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The account roles, database roles, application roles, and the grantees of these roles (e.g. users).",
+		Elem:        roleGraphNodeSchema,
+	},
+	"edges": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The role grants; every edge points from the granted role to the grantee.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"granted_role": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "The granted role.",
+					Elem:        roleGraphNodeSchema,
+				},
+				"grantee": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "The role or user to which the role is granted.",
+					Elem:        roleGraphNodeSchema,
+				},
+			},
+		},
+	},
+	"cycles": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The groups of roles granted to each other in a cycle.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"nodes": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "The roles in the cycle.",
+					Elem:        roleGraphNodeSchema,
+				},
+			},
+		},
+	},
+	"orphan_roles": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The roles that are not granted to any role or user. The PUBLIC role is never reported, because it is implicitly granted to every user and role.",
+		Elem:        roleGraphNodeSchema,
+	},
+	"dot": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The role graph in the Graphviz DOT format. The orphan roles are dashed, and the grants in cycles are red.",
+	},
+	"json": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The role graph (nodes, edges, cycles, and orphan roles) as a JSON document.",
+	},
+}
+
+func RoleGraph() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.RoleGraphDatasource), TrackingReadWrapper(datasources.RoleGraph, ReadRoleGraph)),
+		Schema:      roleGraphSchema,
+		Description: "Data source used to export the role hierarchy of the account. It walks SHOW GRANTS OF for every account role, database role, and application role, and reports the cycles and the orphan roles. Listing all the roles can take a while in accounts with many databases and applications.",
+	}
+}
+
+func ReadRoleGraph(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	graph, err := rolegraph.Load(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	graphJson, err := graph.JSON()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	edges := collections.Map(graph.Edges, func(edge rolegraph.Edge) map[string]any {
+		return map[string]any{
+			"granted_role": []any{roleGraphNodeToSchema(edge.GrantedRole)},
+			"grantee":      []any{roleGraphNodeToSchema(edge.Grantee)},
+		}
+	})
+	cycles := collections.Map(graph.Cycles(), func(cycle []rolegraph.Node) map[string]any {
+		return map[string]any{
+			"nodes": collections.Map(cycle, roleGraphNodeToSchema),
+		}
+	})
+
+	if err := d.Set("nodes", collections.Map(graph.Nodes, roleGraphNodeToSchema)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("edges", edges); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("cycles", cycles); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("orphan_roles", collections.Map(graph.OrphanRoles(), roleGraphNodeToSchema)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("dot", graph.DOT()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("json", graphJson); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("role_graph")
+	return nil
+}
+
+func roleGraphNodeToSchema(node rolegraph.Node) map[string]any {
+	return map[string]any{
+		"type": node.Type.String(),
+		"name": node.Name,
+	}
+}
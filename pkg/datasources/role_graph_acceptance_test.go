@@ -0,0 +1,64 @@
+//go:build !account_level_tests
+
+package datasources_test
+
+import (
+	"testing"
+
+	acc "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_RoleGraph(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	parentRole, parentRoleCleanup := acc.TestClient().Role.CreateRole(t)
+	t.Cleanup(parentRoleCleanup)
+
+	childRole, childRoleCleanup := acc.TestClient().Role.CreateRole(t)
+	t.Cleanup(childRoleCleanup)
+
+	orphanRole, orphanRoleCleanup := acc.TestClient().Role.CreateRole(t)
+	t.Cleanup(orphanRoleCleanup)
+
+	acc.TestClient().Role.GrantRoleToRole(t, childRole.ID(), parentRole.ID())
+
+	datasourceName := "data.snowflake_role_graph.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: roleGraph(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(datasourceName, "edges.*", map[string]string{
+						"granted_role.0.type": "ROLE",
+						"granted_role.0.name": childRole.ID().FullyQualifiedName(),
+						"grantee.0.type":      "ROLE",
+						"grantee.0.name":      parentRole.ID().FullyQualifiedName(),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(datasourceName, "orphan_roles.*", map[string]string{
+						"type": "ROLE",
+						"name": orphanRole.ID().FullyQualifiedName(),
+					}),
+					resource.TestCheckResourceAttrSet(datasourceName, "dot"),
+					resource.TestCheckResourceAttrSet(datasourceName, "json"),
+				),
+			},
+		},
+	})
+}
+
+func roleGraph() string {
+	return `
+data "snowflake_role_graph" "test" {}
+`
+}
@@ -0,0 +1,358 @@
+package grantimport
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/hclgen"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+const (
+	grantPrivilegesToAccountRole  = "snowflake_grant_privileges_to_account_role"
+	grantPrivilegesToDatabaseRole = "snowflake_grant_privileges_to_database_role"
+	grantAccountRole              = "snowflake_grant_account_role"
+	grantDatabaseRole             = "snowflake_grant_database_role"
+	grantOwnership                = "snowflake_grant_ownership"
+)
+
+// Grantee is the role the grants are read for; exactly one of the fields should be set.
+type Grantee struct {
+	AccountRole  *sdk.AccountObjectIdentifier
+	DatabaseRole *sdk.DatabaseObjectIdentifier
+}
+
+func (g Grantee) validate() error {
+	if (g.AccountRole == nil) == (g.DatabaseRole == nil) {
+		return errors.New("exactly one of account role or database role has to be set")
+	}
+	return nil
+}
+
+func (g Grantee) name() string {
+	if g.AccountRole != nil {
+		return g.AccountRole.Name()
+	}
+	return g.DatabaseRole.DatabaseName() + "_" + g.DatabaseRole.Name()
+}
+
+// Import is a single Terraform resource that manages the existing grants, together with its import identifier.
+type Import struct {
+	ResourceType string
+	ResourceName string
+	ImportId     string
+	attributes   []hclgen.Attribute
+}
+
+// HCL returns the import block and the resource block for the grant.
+func (i Import) HCL() string {
+	resource := hclgen.Resource{Type: i.ResourceType, Name: i.ResourceName, Attributes: i.attributes}
+	return hclgen.ImportBlock(resource.Address(), i.ImportId) + "\n" + resource.HCL()
+}
+
+// Result holds the generated imports and the grants that can not be managed by any of the grant resources.
+type Result struct {
+	Imports []Import
+	Skipped []string
+}
+
+// HCL returns the import and resource blocks for all the generated imports.
+func (r *Result) HCL() string {
+	blocks := make([]string, len(r.Imports))
+	for i, imp := range r.Imports {
+		blocks[i] = imp.HCL()
+	}
+	return strings.Join(blocks, "\n")
+}
+
+// Load reads the grants with SHOW GRANTS TO ROLE (or TO DATABASE ROLE) and generates the imports for them.
+func Load(ctx context.Context, client *sdk.Client, grantee Grantee) (*Result, error) {
+	if err := grantee.validate(); err != nil {
+		return nil, err
+	}
+	to := new(sdk.ShowGrantsTo)
+	if grantee.AccountRole != nil {
+		to.Role = *grantee.AccountRole
+	} else {
+		to.DatabaseRole = *grantee.DatabaseRole
+	}
+	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: to})
+	if err != nil {
+		return nil, err
+	}
+	return Generate(grantee, grants)
+}
+
+type grantGroup struct {
+	ObjectType      sdk.ObjectType
+	ObjectName      sdk.ObjectIdentifier
+	WithGrantOption bool
+	Privileges      []string
+}
+
+// Generate groups the privileges granted on the same object (with the same grant option) into a single resource,
+// the same way snowflake_grant_privileges_to_account_role and snowflake_grant_privileges_to_database_role read them.
+// Role grants are mapped to snowflake_grant_account_role and snowflake_grant_database_role, and OWNERSHIP to snowflake_grant_ownership.
+func Generate(grantee Grantee, grants []sdk.Grant) (*Result, error) {
+	if err := grantee.validate(); err != nil {
+		return nil, err
+	}
+
+	groups := make(map[string]*grantGroup)
+	var keys []string
+	for _, grant := range grants {
+		if grant.Name == nil {
+			continue
+		}
+		key := fmt.Sprintf("%s|%s|%t", grant.GrantedOn, grant.Name.FullyQualifiedName(), grant.GrantOption)
+		if grant.Privilege == "OWNERSHIP" || isRoleGrant(grant) {
+			// ownership and role grants are managed by separate resources, one for every grant
+			key = grant.Privilege + "|" + key
+		}
+		group, ok := groups[key]
+		if !ok {
+			group = &grantGroup{ObjectType: grant.GrantedOn, ObjectName: grant.Name, WithGrantOption: grant.GrantOption}
+			groups[key] = group
+			keys = append(keys, key)
+		}
+		group.Privileges = append(group.Privileges, grant.Privilege)
+	}
+
+	result := new(Result)
+	for _, key := range keys {
+		group := groups[key]
+		slices.Sort(group.Privileges)
+		imp, err := generateImport(grantee, group)
+		if err != nil {
+			result.Skipped = append(result.Skipped, fmt.Sprintf("%s on %s %s: %s", strings.Join(group.Privileges, ","), group.ObjectType, group.ObjectName.FullyQualifiedName(), err))
+			continue
+		}
+		result.Imports = append(result.Imports, imp)
+	}
+
+	slices.SortFunc(result.Imports, func(a, b Import) int {
+		return cmp.Or(strings.Compare(a.ResourceType, b.ResourceType), strings.Compare(a.ResourceName, b.ResourceName))
+	})
+	// the names that would clash after the sanitization get a numeric suffix in the order of the imports
+	names := hclgen.NewResourceNames()
+	for i := range result.Imports {
+		result.Imports[i].ResourceName = names.Next(result.Imports[i].ResourceType, result.Imports[i].ResourceName)
+	}
+	return result, nil
+}
+
+func isRoleGrant(grant sdk.Grant) bool {
+	return grant.Privilege == "USAGE" && (grant.GrantedOn == sdk.ObjectTypeRole || grant.GrantedOn == sdk.ObjectTypeDatabaseRole)
+}
+
+func generateImport(grantee Grantee, group *grantGroup) (Import, error) {
+	switch {
+	case group.Privileges[0] == "OWNERSHIP":
+		return ownershipImport(grantee, group)
+	case group.Privileges[0] == "USAGE" && group.ObjectType == sdk.ObjectTypeRole:
+		return accountRoleImport(grantee, group)
+	case group.Privileges[0] == "USAGE" && group.ObjectType == sdk.ObjectTypeDatabaseRole:
+		return databaseRoleImport(grantee, group)
+	case grantee.AccountRole != nil:
+		return privilegesToAccountRoleImport(*grantee.AccountRole, group)
+	default:
+		return privilegesToDatabaseRoleImport(*grantee.DatabaseRole, group)
+	}
+}
+
+func accountRoleImport(grantee Grantee, group *grantGroup) (Import, error) {
+	if grantee.AccountRole == nil {
+		return Import{}, errors.New("account roles can not be granted to database roles")
+	}
+	role, err := sdk.ParseAccountObjectIdentifier(group.ObjectName.FullyQualifiedName())
+	if err != nil {
+		return Import{}, err
+	}
+	return Import{
+		ResourceType: grantAccountRole,
+		ResourceName: hclgen.ResourceName(role.Name(), "to", grantee.name()),
+		ImportId:     helpers.EncodeResourceIdentifier(role.FullyQualifiedName(), sdk.ObjectTypeRole.String(), grantee.AccountRole.FullyQualifiedName()),
+		attributes: []hclgen.Attribute{
+			hclgen.String("role_name", role.FullyQualifiedName()),
+			hclgen.String("parent_role_name", grantee.AccountRole.FullyQualifiedName()),
+		},
+	}, nil
+}
+
+func databaseRoleImport(grantee Grantee, group *grantGroup) (Import, error) {
+	role, err := sdk.ParseDatabaseObjectIdentifier(group.ObjectName.FullyQualifiedName())
+	if err != nil {
+		return Import{}, err
+	}
+	imp := Import{
+		ResourceType: grantDatabaseRole,
+		ResourceName: hclgen.ResourceName(role.DatabaseName(), role.Name(), "to", grantee.name()),
+		attributes:   []hclgen.Attribute{hclgen.String("database_role_name", role.FullyQualifiedName())},
+	}
+	if grantee.AccountRole != nil {
+		imp.ImportId = helpers.EncodeResourceIdentifier(role.FullyQualifiedName(), sdk.ObjectTypeRole.String(), grantee.AccountRole.FullyQualifiedName())
+		imp.attributes = append(imp.attributes, hclgen.String("parent_role_name", grantee.AccountRole.FullyQualifiedName()))
+	} else {
+		imp.ImportId = helpers.EncodeResourceIdentifier(role.FullyQualifiedName(), sdk.ObjectTypeDatabaseRole.String(), grantee.DatabaseRole.FullyQualifiedName())
+		imp.attributes = append(imp.attributes, hclgen.String("parent_database_role_name", grantee.DatabaseRole.FullyQualifiedName()))
+	}
+	return imp, nil
+}
+
+func ownershipImport(grantee Grantee, group *grantGroup) (Import, error) {
+	if !slices.Contains(sdk.ValidGrantOwnershipObjectTypesString, group.ObjectType.String()) {
+		return Import{}, fmt.Errorf("ownership on %s is not supported by %s", group.ObjectType, grantOwnership)
+	}
+	id := resources.GrantOwnershipId{
+		Kind: resources.OnObjectGrantOwnershipKind,
+		Data: &resources.OnObjectGrantOwnershipData{ObjectType: group.ObjectType, ObjectName: group.ObjectName},
+	}
+	var roleAttribute hclgen.Attribute
+	if grantee.AccountRole != nil {
+		id.GrantOwnershipTargetRoleKind = resources.ToAccountGrantOwnershipTargetRoleKind
+		id.AccountRoleName = *grantee.AccountRole
+		roleAttribute = hclgen.String("account_role_name", grantee.AccountRole.FullyQualifiedName())
+	} else {
+		id.GrantOwnershipTargetRoleKind = resources.ToDatabaseGrantOwnershipTargetRoleKind
+		id.DatabaseRoleName = *grantee.DatabaseRole
+		roleAttribute = hclgen.String("database_role_name", grantee.DatabaseRole.FullyQualifiedName())
+	}
+	return Import{
+		ResourceType: grantOwnership,
+		ResourceName: hclgen.ResourceName(grantee.name(), "owns", group.ObjectType.String(), group.ObjectName.FullyQualifiedName()),
+		ImportId:     id.String(),
+		attributes: []hclgen.Attribute{
+			roleAttribute,
+			hclgen.Block("on",
+				hclgen.String("object_type", group.ObjectType.String()),
+				hclgen.String("object_name", group.ObjectName.FullyQualifiedName()),
+			),
+		},
+	}, nil
+}
+
+func privilegesToAccountRoleImport(role sdk.AccountObjectIdentifier, group *grantGroup) (Import, error) {
+	id := resources.GrantPrivilegesToAccountRoleId{
+		RoleName:        role,
+		WithGrantOption: group.WithGrantOption,
+		Privileges:      group.Privileges,
+	}
+	var on hclgen.Attribute
+	switch {
+	case group.ObjectType == sdk.ObjectTypeAccount:
+		id.Kind = resources.OnAccountAccountRoleGrantKind
+		id.Data = new(resources.OnAccountGrantData)
+		on = hclgen.Bool("on_account", true)
+	case slices.Contains(sdk.ValidGrantToAccountObjectTypes, group.ObjectType):
+		objectName, err := sdk.ParseAccountObjectIdentifier(group.ObjectName.FullyQualifiedName())
+		if err != nil {
+			return Import{}, err
+		}
+		id.Kind = resources.OnAccountObjectAccountRoleGrantKind
+		id.Data = &resources.OnAccountObjectGrantData{ObjectType: group.ObjectType, ObjectName: objectName}
+		on = hclgen.Block("on_account_object",
+			hclgen.String("object_type", group.ObjectType.String()),
+			hclgen.String("object_name", objectName.FullyQualifiedName()),
+		)
+	case group.ObjectType == sdk.ObjectTypeSchema:
+		schemaName, err := sdk.ParseDatabaseObjectIdentifier(group.ObjectName.FullyQualifiedName())
+		if err != nil {
+			return Import{}, err
+		}
+		id.Kind = resources.OnSchemaAccountRoleGrantKind
+		id.Data = &resources.OnSchemaGrantData{Kind: resources.OnSchemaSchemaGrantKind, SchemaName: &schemaName}
+		on = hclgen.Block("on_schema", hclgen.String("schema_name", schemaName.FullyQualifiedName()))
+	case slices.Contains(sdk.ValidGrantToObjectTypesString, group.ObjectType.String()):
+		id.Kind = resources.OnSchemaObjectAccountRoleGrantKind
+		id.Data = onSchemaObjectGrantData(group)
+		on = onSchemaObjectAttribute(group)
+	default:
+		return Import{}, fmt.Errorf("privileges on %s are not supported by %s", group.ObjectType, grantPrivilegesToAccountRole)
+	}
+
+	return Import{
+		ResourceType: grantPrivilegesToAccountRole,
+		ResourceName: privilegesResourceName(role.Name(), group),
+		ImportId:     id.String(),
+		attributes:   privilegesAttributes(hclgen.String("account_role_name", role.FullyQualifiedName()), group, on),
+	}, nil
+}
+
+func privilegesToDatabaseRoleImport(role sdk.DatabaseObjectIdentifier, group *grantGroup) (Import, error) {
+	id := resources.GrantPrivilegesToDatabaseRoleId{
+		DatabaseRoleName: role,
+		WithGrantOption:  group.WithGrantOption,
+		Privileges:       group.Privileges,
+	}
+	var on hclgen.Attribute
+	switch {
+	case group.ObjectType == sdk.ObjectTypeDatabase:
+		databaseName, err := sdk.ParseAccountObjectIdentifier(group.ObjectName.FullyQualifiedName())
+		if err != nil {
+			return Import{}, err
+		}
+		id.Kind = resources.OnDatabaseDatabaseRoleGrantKind
+		id.Data = &resources.OnDatabaseGrantData{DatabaseName: databaseName}
+		on = hclgen.String("on_database", databaseName.FullyQualifiedName())
+	case group.ObjectType == sdk.ObjectTypeSchema:
+		schemaName, err := sdk.ParseDatabaseObjectIdentifier(group.ObjectName.FullyQualifiedName())
+		if err != nil {
+			return Import{}, err
+		}
+		id.Kind = resources.OnSchemaDatabaseRoleGrantKind
+		id.Data = &resources.OnSchemaGrantData{Kind: resources.OnSchemaSchemaGrantKind, SchemaName: &schemaName}
+		on = hclgen.Block("on_schema", hclgen.String("schema_name", schemaName.FullyQualifiedName()))
+	case slices.Contains(sdk.ValidGrantToObjectTypesString, group.ObjectType.String()):
+		id.Kind = resources.OnSchemaObjectDatabaseRoleGrantKind
+		id.Data = onSchemaObjectGrantData(group)
+		on = onSchemaObjectAttribute(group)
+	default:
+		return Import{}, fmt.Errorf("privileges on %s are not supported by %s", group.ObjectType, grantPrivilegesToDatabaseRole)
+	}
+
+	return Import{
+		ResourceType: grantPrivilegesToDatabaseRole,
+		ResourceName: privilegesResourceName(role.DatabaseName()+"_"+role.Name(), group),
+		ImportId:     id.String(),
+		attributes:   privilegesAttributes(hclgen.String("database_role_name", role.FullyQualifiedName()), group, on),
+	}, nil
+}
+
+func onSchemaObjectGrantData(group *grantGroup) *resources.OnSchemaObjectGrantData {
+	return &resources.OnSchemaObjectGrantData{
+		Kind:   resources.OnObjectSchemaObjectGrantKind,
+		Object: &sdk.Object{ObjectType: group.ObjectType, Name: group.ObjectName},
+	}
+}
+
+func onSchemaObjectAttribute(group *grantGroup) hclgen.Attribute {
+	return hclgen.Block("on_schema_object",
+		hclgen.String("object_type", group.ObjectType.String()),
+		hclgen.String("object_name", group.ObjectName.FullyQualifiedName()),
+	)
+}
+
+func privilegesAttributes(role hclgen.Attribute, group *grantGroup, on hclgen.Attribute) []hclgen.Attribute {
+	attributes := []hclgen.Attribute{role, hclgen.StringList("privileges", group.Privileges)}
+	if group.WithGrantOption {
+		attributes = append(attributes, hclgen.Bool("with_grant_option", true))
+	}
+	return append(attributes, on)
+}
+
+func privilegesResourceName(grantee string, group *grantGroup) string {
+	parts := []string{grantee, group.ObjectType.String()}
+	if group.ObjectType != sdk.ObjectTypeAccount {
+		parts = append(parts, group.ObjectName.FullyQualifiedName())
+	}
+	if group.WithGrantOption {
+		parts = append(parts, "with_grant_option")
+	}
+	return hclgen.ResourceName(parts...)
+}
@@ -0,0 +1,140 @@
+package grantimport
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func grant(privilege string, objectType sdk.ObjectType, name sdk.ObjectIdentifier, grantOption bool) sdk.Grant {
+	return sdk.Grant{Privilege: privilege, GrantedOn: objectType, Name: name, GrantOption: grantOption}
+}
+
+func Test_Generate_accountRole(t *testing.T) {
+	role := sdk.NewAccountObjectIdentifier("ANALYST")
+	database := sdk.NewAccountObjectIdentifier("DB")
+	schema := sdk.NewDatabaseObjectIdentifier("DB", "PUBLIC")
+	table := sdk.NewSchemaObjectIdentifier("DB", "PUBLIC", "ORDERS")
+
+	result, err := Generate(Grantee{AccountRole: &role}, []sdk.Grant{
+		grant("USAGE", sdk.ObjectTypeDatabase, database, false),
+		grant("SELECT", sdk.ObjectTypeTable, table, false),
+		grant("MONITOR", sdk.ObjectTypeDatabase, database, false),
+		grant("CREATE DATABASE", sdk.ObjectTypeAccount, sdk.NewAccountObjectIdentifier("ACC"), false),
+		grant("USAGE", sdk.ObjectTypeSchema, schema, true),
+		grant("INSERT", sdk.ObjectTypeTable, table, false),
+		grant("OWNERSHIP", sdk.ObjectTypeTable, table, false),
+		grant("USAGE", sdk.ObjectTypeRole, sdk.NewAccountObjectIdentifier("READER"), false),
+		grant("USAGE", sdk.ObjectTypeDatabaseRole, sdk.NewDatabaseObjectIdentifier("DB", "DB_READER"), false),
+		grant("USAGE", sdk.ObjectTypeApplicationRole, sdk.NewDatabaseObjectIdentifier("APP", "APP_ROLE"), false),
+	})
+	require.NoError(t, err)
+
+	ids := make(map[string]string)
+	for _, imp := range result.Imports {
+		ids[imp.ResourceType+"."+imp.ResourceName] = imp.ImportId
+	}
+	assert.Equal(t, map[string]string{
+		"snowflake_grant_account_role.reader_to_analyst":                                        `"READER"|ROLE|"ANALYST"`,
+		"snowflake_grant_database_role.db_db_reader_to_analyst":                                 `"DB"."DB_READER"|ROLE|"ANALYST"`,
+		"snowflake_grant_ownership.analyst_owns_table_db_public_orders":                         `ToAccountRole|"ANALYST"||OnObject|TABLE|"DB"."PUBLIC"."ORDERS"`,
+		"snowflake_grant_privileges_to_account_role.analyst_account":                            `"ANALYST"|false|false|CREATE DATABASE|OnAccount`,
+		"snowflake_grant_privileges_to_account_role.analyst_database_db":                        `"ANALYST"|false|false|MONITOR,USAGE|OnAccountObject|DATABASE|"DB"`,
+		"snowflake_grant_privileges_to_account_role.analyst_schema_db_public_with_grant_option": `"ANALYST"|true|false|USAGE|OnSchema|OnSchema|"DB"."PUBLIC"`,
+		"snowflake_grant_privileges_to_account_role.analyst_table_db_public_orders":             `"ANALYST"|false|false|INSERT,SELECT|OnSchemaObject|OnObject|TABLE|"DB"."PUBLIC"."ORDERS"`,
+	}, ids)
+	require.Len(t, result.Skipped, 1)
+	assert.Contains(t, result.Skipped[0], "APPLICATION ROLE")
+
+	// every generated identifier has to be accepted by the resource importer
+	for _, imp := range result.Imports {
+		switch imp.ResourceType {
+		case grantPrivilegesToAccountRole:
+			id, err := resources.ParseGrantPrivilegesToAccountRoleId(imp.ImportId)
+			require.NoError(t, err)
+			assert.Equal(t, imp.ImportId, id.String())
+		case grantOwnership:
+			id, err := resources.ParseGrantOwnershipId(imp.ImportId)
+			require.NoError(t, err)
+			assert.Equal(t, imp.ImportId, id.String())
+		}
+	}
+
+	assert.Contains(t, result.HCL(), `import {
+  to = snowflake_grant_privileges_to_account_role.analyst_table_db_public_orders
+  id = "\"ANALYST\"|false|false|INSERT,SELECT|OnSchemaObject|OnObject|TABLE|\"DB\".\"PUBLIC\".\"ORDERS\""
+}
+
+resource "snowflake_grant_privileges_to_account_role" "analyst_table_db_public_orders" {
+  account_role_name = "\"ANALYST\""
+  privileges        = ["INSERT", "SELECT"]
+  on_schema_object {
+    object_type = "TABLE"
+    object_name = "\"DB\".\"PUBLIC\".\"ORDERS\""
+  }
+}
+`)
+}
+
+func Test_Generate_databaseRole(t *testing.T) {
+	role := sdk.NewDatabaseObjectIdentifier("DB", "WRITER")
+	database := sdk.NewAccountObjectIdentifier("DB")
+	view := sdk.NewSchemaObjectIdentifier("DB", "PUBLIC", "V")
+
+	result, err := Generate(Grantee{DatabaseRole: &role}, []sdk.Grant{
+		grant("CREATE SCHEMA", sdk.ObjectTypeDatabase, database, false),
+		grant("SELECT", sdk.ObjectTypeView, view, true),
+		grant("USAGE", sdk.ObjectTypeDatabaseRole, sdk.NewDatabaseObjectIdentifier("DB", "READER"), false),
+		grant("USAGE", sdk.ObjectTypeWarehouse, sdk.NewAccountObjectIdentifier("WH"), false),
+	})
+	require.NoError(t, err)
+
+	ids := make(map[string]string)
+	for _, imp := range result.Imports {
+		ids[imp.ResourceType+"."+imp.ResourceName] = imp.ImportId
+	}
+	assert.Equal(t, map[string]string{
+		"snowflake_grant_database_role.db_reader_to_db_writer":                                     `"DB"."READER"|DATABASE ROLE|"DB"."WRITER"`,
+		"snowflake_grant_privileges_to_database_role.db_writer_database_db":                        `"DB"."WRITER"|false|false|CREATE SCHEMA|OnDatabase|"DB"`,
+		"snowflake_grant_privileges_to_database_role.db_writer_view_db_public_v_with_grant_option": `"DB"."WRITER"|true|false|SELECT|OnSchemaObject|OnObject|VIEW|"DB"."PUBLIC"."V"`,
+	}, ids)
+	require.Len(t, result.Skipped, 1)
+	assert.Contains(t, result.Skipped[0], "WAREHOUSE")
+
+	for _, imp := range result.Imports {
+		if imp.ResourceType == grantPrivilegesToDatabaseRole {
+			id, err := resources.ParseGrantPrivilegesToDatabaseRoleId(imp.ImportId)
+			require.NoError(t, err)
+			assert.Equal(t, imp.ImportId, id.String())
+		}
+	}
+}
+
+func Test_Generate_invalidGrantee(t *testing.T) {
+	_, err := Generate(Grantee{}, nil)
+	require.ErrorContains(t, err, "exactly one of account role or database role has to be set")
+}
+
+func Test_Generate_uniqueResourceNames(t *testing.T) {
+	role := sdk.NewAccountObjectIdentifier("ANALYST")
+
+	result, err := Generate(Grantee{AccountRole: &role}, []sdk.Grant{
+		grant("USAGE", sdk.ObjectTypeDatabase, sdk.NewAccountObjectIdentifier("A B"), false),
+		grant("USAGE", sdk.ObjectTypeDatabase, sdk.NewAccountObjectIdentifier("A_B"), false),
+		grant("OWNERSHIP", sdk.ObjectTypeDatabase, sdk.NewAccountObjectIdentifier("A_B"), false),
+	})
+	require.NoError(t, err)
+
+	names := make([]string, len(result.Imports))
+	for i, imp := range result.Imports {
+		names[i] = imp.ResourceType + "." + imp.ResourceName
+	}
+	assert.Equal(t, []string{
+		"snowflake_grant_ownership.analyst_owns_database_a_b",
+		"snowflake_grant_privileges_to_account_role.analyst_database_a_b",
+		"snowflake_grant_privileges_to_account_role.analyst_database_a_b_2",
+	}, names)
+}
@@ -0,0 +1,160 @@
+// Package hclgen writes Terraform configuration (resource and import blocks) for the objects read from Snowflake.
+// It is used by the tools and data sources generating the configuration for the existing objects.
+package hclgen
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Attribute is a single argument of the generated resource; blocks hold the nested attributes.
+type Attribute struct {
+	Name  string
+	Value string
+	Block []Attribute
+}
+
+// String returns the attribute with the quoted value; the template sequences (${ and %{) are escaped.
+func String(name string, value string) Attribute {
+	return Attribute{Name: name, Value: quote(value)}
+}
+
+func Bool(name string, value bool) Attribute {
+	return Attribute{Name: name, Value: strconv.FormatBool(value)}
+}
+
+func Int(name string, value int) Attribute {
+	return Attribute{Name: name, Value: strconv.Itoa(value)}
+}
+
+// StringList returns the attribute with the list of quoted values.
+func StringList(name string, values []string) Attribute {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = quote(v)
+	}
+	return Attribute{Name: name, Value: "[" + strings.Join(quoted, ", ") + "]"}
+}
+
+// IntList returns the attribute with the list of numbers.
+func IntList(name string, values []int) Attribute {
+	formatted := make([]string, len(values))
+	for i, v := range values {
+		formatted[i] = strconv.Itoa(v)
+	}
+	return Attribute{Name: name, Value: "[" + strings.Join(formatted, ", ") + "]"}
+}
+
+// Block returns the nested block with the given attributes.
+func Block(name string, attributes ...Attribute) Attribute {
+	return Attribute{Name: name, Block: attributes}
+}
+
+// Resource is a single resource block.
+type Resource struct {
+	Type       string
+	Name       string
+	Attributes []Attribute
+}
+
+// Address returns the address of the resource in the configuration (e.g. snowflake_database.db).
+func (r Resource) Address() string {
+	return r.Type + "." + r.Name
+}
+
+// HCL returns the resource block. The consecutive attributes are aligned in the same way as by terraform fmt.
+func (r Resource) HCL() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("resource %q %q {\n", r.Type, r.Name))
+	writeAttributes(&sb, r.Attributes, "  ")
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// ImportBlock returns the import block for the resource with the given address.
+func ImportBlock(address string, id string) string {
+	return fmt.Sprintf("import {\n  to = %s\n  id = %s\n}\n", address, quote(id))
+}
+
+func writeAttributes(sb *strings.Builder, attributes []Attribute, indent string) {
+	for i := 0; i < len(attributes); {
+		if attributes[i].Block != nil {
+			sb.WriteString(fmt.Sprintf("%s%s {\n", indent, attributes[i].Name))
+			writeAttributes(sb, attributes[i].Block, indent+"  ")
+			sb.WriteString(fmt.Sprintf("%s}\n", indent))
+			i++
+			continue
+		}
+		end, width := i, 0
+		for ; end < len(attributes) && attributes[end].Block == nil; end++ {
+			width = max(width, len(attributes[end].Name))
+		}
+		for _, a := range attributes[i:end] {
+			sb.WriteString(fmt.Sprintf("%s%-*s = %s\n", indent, width, a.Name, a.Value))
+		}
+		i = end
+	}
+}
+
+// quote returns the HCL string literal for the given value.
+func quote(value string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for i, r := range value {
+		switch {
+		case r == '"':
+			sb.WriteString(`\"`)
+		case r == '\\':
+			sb.WriteString(`\\`)
+		case r == '\n':
+			sb.WriteString(`\n`)
+		case r == '\r':
+			sb.WriteString(`\r`)
+		case r == '\t':
+			sb.WriteString(`\t`)
+		case r < 0x20 || r == 0x7f:
+			sb.WriteString(fmt.Sprintf(`\u%04x`, r))
+		case (r == '$' || r == '%') && strings.HasPrefix(value[i+1:], "{"):
+			sb.WriteRune(r)
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+var invalidResourceNameCharacters = regexp.MustCompile(`[^a-z0-9]+`)
+
+// ResourceName builds a valid Terraform resource name (letters, digits, and underscores, not starting with a digit) from the given parts.
+func ResourceName(parts ...string) string {
+	name := strings.Trim(invalidResourceNameCharacters.ReplaceAllString(strings.ToLower(strings.Join(parts, "_")), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	return name
+}
+
+// ResourceNames generates unique resource names for every resource type.
+type ResourceNames struct {
+	used map[string]int
+}
+
+func NewResourceNames() *ResourceNames {
+	return &ResourceNames{used: make(map[string]int)}
+}
+
+// Next returns the resource name built from the given parts with ResourceName. The names that would clash after the sanitization
+// (e.g. "A B" and "A_B") get a numeric suffix.
+func (n *ResourceNames) Next(resourceType string, parts ...string) string {
+	name := ResourceName(parts...)
+	key := resourceType + "." + name
+	n.used[key]++
+	if count := n.used[key]; count > 1 {
+		return fmt.Sprintf("%s_%d", name, count)
+	}
+	return name
+}
@@ -0,0 +1,81 @@
+package hclgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Resource_HCL(t *testing.T) {
+	resource := Resource{
+		Type: "snowflake_masking_policy",
+		Name: "db_public_mask",
+		Attributes: []Attribute{
+			String("database", "DB"),
+			String("schema", "PUBLIC"),
+			String("name", "MASK"),
+			Block("argument", String("name", "VAL"), String("type", "VARCHAR")),
+			String("body", "case when current_role() = 'ADMIN' then val else '${masked}' end"),
+			String("return_data_type", "VARCHAR"),
+			StringList("allowed_values", []string{"a", `"b"`}),
+			Bool("enabled", true),
+			Int("credit_quota", 10),
+		},
+	}
+
+	assert.Equal(t, "snowflake_masking_policy.db_public_mask", resource.Address())
+	assert.Equal(t, `resource "snowflake_masking_policy" "db_public_mask" {
+  database = "DB"
+  schema   = "PUBLIC"
+  name     = "MASK"
+  argument {
+    name = "VAL"
+    type = "VARCHAR"
+  }
+  body             = "case when current_role() = 'ADMIN' then val else '$${masked}' end"
+  return_data_type = "VARCHAR"
+  allowed_values   = ["a", "\"b\""]
+  enabled          = true
+  credit_quota     = 10
+}
+`, resource.HCL())
+}
+
+func Test_ImportBlock(t *testing.T) {
+	assert.Equal(t, `import {
+  to = snowflake_schema.db_public
+  id = "\"DB\".\"PUBLIC\""
+}
+`, ImportBlock("snowflake_schema.db_public", `"DB"."PUBLIC"`))
+}
+
+func Test_quote(t *testing.T) {
+	testCases := map[string]string{
+		"":              `""`,
+		"select 1":      `"select 1"`,
+		"a\nb\tc\r":     `"a\nb\tc\r"`,
+		`back\slash`:    `"back\\slash"`,
+		"${var} %{if}":  `"$${var} %%{if}"`,
+		"$ alone % too": `"$ alone % too"`,
+		"bell\a":        `"bell\u0007"`,
+		"zażółć":        `"zażółć"`,
+	}
+	for value, expected := range testCases {
+		assert.Equal(t, expected, quote(value), value)
+	}
+}
+
+func Test_ResourceName(t *testing.T) {
+	assert.Equal(t, "my_role_database_db", ResourceName("My Role", "DATABASE", `"DB"`))
+	assert.Equal(t, "_1role", ResourceName("1ROLE"))
+	assert.Equal(t, "_", ResourceName(`"-"`))
+}
+
+func Test_ResourceNames_Next(t *testing.T) {
+	names := NewResourceNames()
+
+	assert.Equal(t, "a_b", names.Next("snowflake_database", "A B"))
+	assert.Equal(t, "a_b_2", names.Next("snowflake_database", "A_B"))
+	assert.Equal(t, "a_b", names.Next("snowflake_warehouse", "a-b"))
+	assert.Equal(t, "a_b_3", names.Next("snowflake_database", "a.b"))
+}
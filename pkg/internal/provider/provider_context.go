@@ -1,8 +1,14 @@
 package provider
 
-import "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/tracking"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
 
 type Context struct {
 	Client          *sdk.Client
 	EnabledFeatures []string
+	SqlPreview      bool
+	// TerraformContext is added to the usage tracking metadata of all the resources and data sources.
+	TerraformContext tracking.TerraformContext
 }
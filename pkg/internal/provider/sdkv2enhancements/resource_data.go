@@ -15,15 +15,11 @@ import (
 // - (m schemaMap) Data method allowing to create schema.ResourceData from terraform.InstanceState and terraform.InstanceDiff
 // - terraform.InstanceState and terraform.InstanceDiff are unexported in schema.ResourceDiff, so we get them using reflection
 func CreateResourceDataFromResourceDiff(resourceSchema schema.InternalMap, diff *schema.ResourceDiff) (*schema.ResourceData, bool) {
-	unexportedState := reflect.ValueOf(diff).Elem().FieldByName("state")
-	stateFromResourceDiff := reflect.NewAt(unexportedState.Type(), unexportedState.Addr().UnsafePointer()).Elem().Interface()
-	unexportedDiff := reflect.ValueOf(diff).Elem().FieldByName("diff")
-	diffFroResourceDif := reflect.NewAt(unexportedDiff.Type(), unexportedDiff.Addr().UnsafePointer()).Elem().Interface()
-	castState, ok := stateFromResourceDiff.(*terraform.InstanceState)
+	castState, ok := unexportedResourceDiffField[*terraform.InstanceState](diff, "state")
 	if !ok {
 		return nil, false
 	}
-	castDiff, ok := diffFroResourceDif.(*terraform.InstanceDiff)
+	castDiff, ok := unexportedResourceDiffField[*terraform.InstanceDiff](diff, "diff")
 	if !ok {
 		return nil, false
 	}
@@ -33,3 +29,23 @@ func CreateResourceDataFromResourceDiff(resourceSchema schema.InternalMap, diff
 	}
 	return resourceData, true
 }
+
+// CreateResourceDataFromResourceDiffState creates schema.ResourceData out of the prior state of schema.ResourceDiff, i.e. without the planned changes.
+// It returns false for the resources that do not exist yet.
+func CreateResourceDataFromResourceDiffState(resourceSchema schema.InternalMap, diff *schema.ResourceDiff) (*schema.ResourceData, bool) {
+	castState, ok := unexportedResourceDiffField[*terraform.InstanceState](diff, "state")
+	if !ok || castState == nil {
+		return nil, false
+	}
+	resourceData, err := resourceSchema.Data(castState, nil)
+	if err != nil {
+		return nil, false
+	}
+	return resourceData, true
+}
+
+func unexportedResourceDiffField[T any](diff *schema.ResourceDiff, name string) (T, bool) {
+	unexportedField := reflect.ValueOf(diff).Elem().FieldByName(name)
+	value, ok := reflect.NewAt(unexportedField.Type(), unexportedField.Addr().UnsafePointer()).Elem().Interface().(T)
+	return value, ok
+}
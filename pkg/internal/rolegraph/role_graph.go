@@ -0,0 +1,319 @@
+package rolegraph
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+// Node is a role or a grantee of a role in the graph. Name is the fully qualified name of the object.
+type Node struct {
+	Type sdk.ObjectType `json:"type"`
+	Name string         `json:"name"`
+}
+
+func (n Node) key() string {
+	return fmt.Sprintf("%s|%s", n.Type, n.Name)
+}
+
+func (n Node) isRole() bool {
+	return slices.Contains([]sdk.ObjectType{sdk.ObjectTypeRole, sdk.ObjectTypeDatabaseRole, sdk.ObjectTypeApplicationRole}, n.Type)
+}
+
+func compareNodes(a, b Node) int {
+	return cmp.Or(strings.Compare(string(a.Type), string(b.Type)), strings.Compare(a.Name, b.Name))
+}
+
+// Edge is the role (GrantedRole) granted to the role or user (Grantee).
+type Edge struct {
+	GrantedRole Node `json:"granted_role"`
+	Grantee     Node `json:"grantee"`
+}
+
+// Graph is the role hierarchy of the account. The nodes and edges are sorted, so the exported graph is stable.
+type Graph struct {
+	Nodes []Node
+	Edges []Edge
+}
+
+// NewGraph creates the graph from the role grants; the nodes used in the edges are added to the graph automatically.
+func NewGraph(nodes []Node, edges []Edge) *Graph {
+	unique := make(map[string]Node)
+	for _, node := range nodes {
+		unique[node.key()] = node
+	}
+	uniqueEdges := make(map[string]Edge)
+	for _, edge := range edges {
+		unique[edge.GrantedRole.key()] = edge.GrantedRole
+		unique[edge.Grantee.key()] = edge.Grantee
+		uniqueEdges[edge.GrantedRole.key()+"|"+edge.Grantee.key()] = edge
+	}
+
+	g := &Graph{}
+	for _, node := range unique {
+		g.Nodes = append(g.Nodes, node)
+	}
+	for _, edge := range uniqueEdges {
+		g.Edges = append(g.Edges, edge)
+	}
+	slices.SortFunc(g.Nodes, compareNodes)
+	slices.SortFunc(g.Edges, func(a, b Edge) int {
+		return cmp.Or(compareNodes(a.GrantedRole, b.GrantedRole), compareNodes(a.Grantee, b.Grantee))
+	})
+	return g
+}
+
+// Load walks SHOW GRANTS OF for every account role, database role, and application role in the account, the same way snowflake_grant_account_role reads the grants.
+func Load(ctx context.Context, client *sdk.Client) (*Graph, error) {
+	var nodes []Node
+	var edges []Edge
+
+	roles, err := client.Roles.Show(ctx, sdk.NewShowRoleRequest())
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range roles {
+		node := Node{Type: sdk.ObjectTypeRole, Name: role.ID().FullyQualifiedName()}
+		nodes = append(nodes, node)
+		grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{Of: &sdk.ShowGrantsOf{Role: role.ID()}})
+		if err != nil {
+			return nil, err
+		}
+		edges = append(edges, edgesOf(node, grants, "")...)
+	}
+
+	databases, err := client.Databases.Show(ctx, &sdk.ShowDatabasesOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, database := range databases {
+		// the roles of the imported databases are managed by the provider account and the applications are handled below
+		if database.Kind != "" && database.Kind != "STANDARD" {
+			continue
+		}
+		databaseRoles, err := client.DatabaseRoles.Show(ctx, sdk.NewShowDatabaseRoleRequest(database.ID()))
+		if err != nil {
+			return nil, err
+		}
+		for _, databaseRole := range databaseRoles {
+			id := sdk.NewDatabaseObjectIdentifier(database.Name, databaseRole.Name)
+			node := Node{Type: sdk.ObjectTypeDatabaseRole, Name: id.FullyQualifiedName()}
+			nodes = append(nodes, node)
+			grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{Of: &sdk.ShowGrantsOf{DatabaseRole: id}})
+			if err != nil {
+				return nil, err
+			}
+			edges = append(edges, edgesOf(node, grants, "")...)
+		}
+	}
+
+	applications, err := client.Applications.Show(ctx, sdk.NewShowApplicationRequest())
+	if err != nil {
+		return nil, err
+	}
+	for _, application := range applications {
+		applicationRoles, err := client.ApplicationRoles.Show(ctx, sdk.NewShowApplicationRoleRequest().WithApplicationName(sdk.NewAccountObjectIdentifier(application.Name)))
+		if err != nil {
+			return nil, err
+		}
+		for _, applicationRole := range applicationRoles {
+			id := sdk.NewDatabaseObjectIdentifier(application.Name, applicationRole.Name)
+			node := Node{Type: sdk.ObjectTypeApplicationRole, Name: id.FullyQualifiedName()}
+			nodes = append(nodes, node)
+			grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{Of: &sdk.ShowGrantsOf{ApplicationRole: id}})
+			if err != nil {
+				return nil, err
+			}
+			edges = append(edges, edgesOf(node, grants, application.Name)...)
+		}
+	}
+
+	return NewGraph(nodes, edges), nil
+}
+
+// edgesOf maps the result of SHOW GRANTS OF to the edges. The application roles granted to other application roles
+// are returned without the application name, so it is filled in from the granted role.
+func edgesOf(grantedRole Node, grants []sdk.Grant, applicationName string) []Edge {
+	edges := make([]Edge, 0, len(grants))
+	for _, grant := range grants {
+		granteeName := grant.GranteeName.FullyQualifiedName()
+		if grant.GrantedTo == sdk.ObjectTypeApplicationRole && applicationName != "" {
+			granteeName = sdk.NewDatabaseObjectIdentifier(applicationName, grant.GranteeName.Name()).FullyQualifiedName()
+		}
+		edges = append(edges, Edge{
+			GrantedRole: grantedRole,
+			Grantee:     Node{Type: grant.GrantedTo, Name: granteeName},
+		})
+	}
+	return edges
+}
+
+// Cycles returns the groups of nodes granted to each other in a cycle (strongly connected components with more than one node, or a node granted to itself).
+func (g *Graph) Cycles() [][]Node {
+	adjacency := make(map[string][]Node)
+	selfGranted := make(map[string]bool)
+	for _, edge := range g.Edges {
+		adjacency[edge.GrantedRole.key()] = append(adjacency[edge.GrantedRole.key()], edge.Grantee)
+		if edge.GrantedRole == edge.Grantee {
+			selfGranted[edge.GrantedRole.key()] = true
+		}
+	}
+
+	// Tarjan's algorithm
+	index := 0
+	indices := make(map[string]int)
+	lowLinks := make(map[string]int)
+	onStack := make(map[string]bool)
+	var stack []Node
+	var cycles [][]Node
+
+	var visit func(node Node)
+	visit = func(node Node) {
+		key := node.key()
+		indices[key] = index
+		lowLinks[key] = index
+		index++
+		stack = append(stack, node)
+		onStack[key] = true
+
+		for _, next := range adjacency[key] {
+			nextKey := next.key()
+			if _, visited := indices[nextKey]; !visited {
+				visit(next)
+				lowLinks[key] = min(lowLinks[key], lowLinks[nextKey])
+			} else if onStack[nextKey] {
+				lowLinks[key] = min(lowLinks[key], indices[nextKey])
+			}
+		}
+
+		if lowLinks[key] == indices[key] {
+			var component []Node
+			for {
+				top := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[top.key()] = false
+				component = append(component, top)
+				if top == node {
+					break
+				}
+			}
+			if len(component) > 1 || selfGranted[key] {
+				slices.SortFunc(component, compareNodes)
+				cycles = append(cycles, component)
+			}
+		}
+	}
+
+	for _, node := range g.Nodes {
+		if _, visited := indices[node.key()]; !visited {
+			visit(node)
+		}
+	}
+	slices.SortFunc(cycles, func(a, b []Node) int {
+		return compareNodes(a[0], b[0])
+	})
+	return cycles
+}
+
+// OrphanRoles returns the roles that are not granted to any role or user, so they can't be used by anyone.
+// PUBLIC is skipped, because it is implicitly granted to every user and role.
+func (g *Graph) OrphanRoles() []Node {
+	granted := make(map[string]bool)
+	for _, edge := range g.Edges {
+		granted[edge.GrantedRole.key()] = true
+	}
+	orphans := make([]Node, 0)
+	for _, node := range g.Nodes {
+		if node.isRole() && !granted[node.key()] && node != (Node{Type: sdk.ObjectTypeRole, Name: snowflakeroles.Public.FullyQualifiedName()}) {
+			orphans = append(orphans, node)
+		}
+	}
+	return orphans
+}
+
+// DOT returns the graph in the Graphviz DOT format. The edges point from the granted role to the grantee, the orphan roles are dashed, and the edges in cycles are red.
+func (g *Graph) DOT() string {
+	orphans := make(map[string]bool)
+	for _, node := range g.OrphanRoles() {
+		orphans[node.key()] = true
+	}
+	inCycle := make(map[string]int)
+	for i, cycle := range g.Cycles() {
+		for _, node := range cycle {
+			inCycle[node.key()] = i + 1
+		}
+	}
+
+	var builder strings.Builder
+	builder.WriteString("digraph roles {\n")
+	for _, node := range g.Nodes {
+		attributes := []string{fmt.Sprintf("label=%s", dotQuote(node.Name)), fmt.Sprintf("shape=%s", dotShape(node.Type))}
+		if orphans[node.key()] {
+			attributes = append(attributes, "style=dashed")
+		}
+		builder.WriteString(fmt.Sprintf("  %s [%s];\n", dotQuote(node.key()), strings.Join(attributes, ", ")))
+	}
+	for _, edge := range g.Edges {
+		var attributes string
+		if cycle := inCycle[edge.GrantedRole.key()]; cycle != 0 && cycle == inCycle[edge.Grantee.key()] {
+			attributes = " [color=red]"
+		}
+		builder.WriteString(fmt.Sprintf("  %s -> %s%s;\n", dotQuote(edge.GrantedRole.key()), dotQuote(edge.Grantee.key()), attributes))
+	}
+	builder.WriteString("}\n")
+	return builder.String()
+}
+
+func dotQuote(s string) string {
+	return `"` + strings.ReplaceAll(strings.ReplaceAll(s, `\`, `\\`), `"`, `\"`) + `"`
+}
+
+func dotShape(objectType sdk.ObjectType) string {
+	switch objectType {
+	case sdk.ObjectTypeRole:
+		return "box"
+	case sdk.ObjectTypeDatabaseRole:
+		return "ellipse"
+	case sdk.ObjectTypeApplicationRole:
+		return "hexagon"
+	default:
+		return "plaintext"
+	}
+}
+
+type graphJson struct {
+	Nodes       []Node   `json:"nodes"`
+	Edges       []Edge   `json:"edges"`
+	Cycles      [][]Node `json:"cycles"`
+	OrphanRoles []Node   `json:"orphan_roles"`
+}
+
+// JSON returns the nodes, edges, cycles, and orphan roles of the graph as a JSON document.
+func (g *Graph) JSON() (string, error) {
+	graph := graphJson{
+		Nodes:       g.Nodes,
+		Edges:       g.Edges,
+		Cycles:      g.Cycles(),
+		OrphanRoles: g.OrphanRoles(),
+	}
+	if graph.Nodes == nil {
+		graph.Nodes = make([]Node, 0)
+	}
+	if graph.Edges == nil {
+		graph.Edges = make([]Edge, 0)
+	}
+	if graph.Cycles == nil {
+		graph.Cycles = make([][]Node, 0)
+	}
+	result, err := json.MarshalIndent(graph, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(result), nil
+}
@@ -0,0 +1,129 @@
+package rolegraph
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func role(name string) Node {
+	return Node{Type: sdk.ObjectTypeRole, Name: sdk.NewAccountObjectIdentifier(name).FullyQualifiedName()}
+}
+
+func databaseRole(database string, name string) Node {
+	return Node{Type: sdk.ObjectTypeDatabaseRole, Name: sdk.NewDatabaseObjectIdentifier(database, name).FullyQualifiedName()}
+}
+
+func user(name string) Node {
+	return Node{Type: sdk.ObjectTypeUser, Name: sdk.NewAccountObjectIdentifier(name).FullyQualifiedName()}
+}
+
+func Test_NewGraph(t *testing.T) {
+	t.Run("nodes from edges and duplicates", func(t *testing.T) {
+		graph := NewGraph(
+			[]Node{role("B"), role("A"), role("B")},
+			[]Edge{
+				{GrantedRole: role("A"), Grantee: user("U")},
+				{GrantedRole: databaseRole("DB", "R"), Grantee: role("A")},
+				{GrantedRole: role("A"), Grantee: user("U")},
+			},
+		)
+
+		assert.Equal(t, []Node{databaseRole("DB", "R"), role("A"), role("B"), user("U")}, graph.Nodes)
+		assert.Equal(t, []Edge{
+			{GrantedRole: databaseRole("DB", "R"), Grantee: role("A")},
+			{GrantedRole: role("A"), Grantee: user("U")},
+		}, graph.Edges)
+	})
+}
+
+func Test_Cycles(t *testing.T) {
+	t.Run("no cycles", func(t *testing.T) {
+		graph := NewGraph(nil, []Edge{
+			{GrantedRole: role("A"), Grantee: role("B")},
+			{GrantedRole: role("B"), Grantee: role("C")},
+			{GrantedRole: role("A"), Grantee: role("C")},
+		})
+
+		assert.Empty(t, graph.Cycles())
+	})
+
+	t.Run("cycles", func(t *testing.T) {
+		graph := NewGraph(nil, []Edge{
+			{GrantedRole: role("A"), Grantee: role("B")},
+			{GrantedRole: role("B"), Grantee: role("C")},
+			{GrantedRole: role("C"), Grantee: role("A")},
+			{GrantedRole: role("C"), Grantee: role("D")},
+			{GrantedRole: role("E"), Grantee: role("E")},
+		})
+
+		assert.Equal(t, [][]Node{
+			{role("A"), role("B"), role("C")},
+			{role("E")},
+		}, graph.Cycles())
+	})
+}
+
+func Test_OrphanRoles(t *testing.T) {
+	graph := NewGraph(
+		[]Node{role("PUBLIC"), role("ORPHAN"), databaseRole("DB", "ORPHAN")},
+		[]Edge{
+			{GrantedRole: role("A"), Grantee: user("U")},
+			{GrantedRole: role("B"), Grantee: role("A")},
+		},
+	)
+
+	assert.Equal(t, []Node{databaseRole("DB", "ORPHAN"), role("ORPHAN")}, graph.OrphanRoles())
+}
+
+func Test_DOT(t *testing.T) {
+	graph := NewGraph(
+		[]Node{role("ORPHAN")},
+		[]Edge{
+			{GrantedRole: role("A"), Grantee: role("B")},
+			{GrantedRole: role("B"), Grantee: role("A")},
+			{GrantedRole: databaseRole("DB", "R"), Grantee: role("A")},
+			{GrantedRole: role("A"), Grantee: user("U")},
+		},
+	)
+
+	expected := `digraph roles {
+  "DATABASE ROLE|\"DB\".\"R\"" [label="\"DB\".\"R\"", shape=ellipse];
+  "ROLE|\"A\"" [label="\"A\"", shape=box];
+  "ROLE|\"B\"" [label="\"B\"", shape=box];
+  "ROLE|\"ORPHAN\"" [label="\"ORPHAN\"", shape=box, style=dashed];
+  "USER|\"U\"" [label="\"U\"", shape=plaintext];
+  "DATABASE ROLE|\"DB\".\"R\"" -> "ROLE|\"A\"";
+  "ROLE|\"A\"" -> "ROLE|\"B\"" [color=red];
+  "ROLE|\"A\"" -> "USER|\"U\"";
+  "ROLE|\"B\"" -> "ROLE|\"A\"" [color=red];
+}
+`
+	assert.Equal(t, expected, graph.DOT())
+}
+
+func Test_JSON(t *testing.T) {
+	t.Run("empty graph", func(t *testing.T) {
+		result, err := NewGraph(nil, nil).JSON()
+		require.NoError(t, err)
+
+		assert.JSONEq(t, `{"nodes": [], "edges": [], "cycles": [], "orphan_roles": []}`, result)
+	})
+
+	t.Run("graph", func(t *testing.T) {
+		graph := NewGraph([]Node{role("ORPHAN")}, []Edge{{GrantedRole: role("A"), Grantee: user("U")}})
+
+		result, err := graph.JSON()
+		require.NoError(t, err)
+
+		var parsed graphJson
+		require.NoError(t, json.Unmarshal([]byte(result), &parsed))
+		assert.Equal(t, graph.Nodes, parsed.Nodes)
+		assert.Equal(t, graph.Edges, parsed.Edges)
+		assert.Empty(t, parsed.Cycles)
+		assert.Equal(t, []Node{role("ORPHAN")}, parsed.OrphanRoles)
+	})
+}
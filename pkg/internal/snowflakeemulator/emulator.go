@@ -0,0 +1,308 @@
+// Package snowflakeemulator contains the in-memory stand-in for Snowflake, which understands the statements generated by the SDK
+// for the core objects (databases, schemas, roles, warehouses, tables, and the grants on them), and answers the matching SHOW
+// and DESCRIBE queries with the rows shaped like the ones returned by Snowflake. It is meant for the unit tests of the resources,
+// which can run without an account:
+//
+//	emulator := snowflakeemulator.New()
+//	client, err := sdk.NewClientWithConnector(&gosnowflake.Config{Account: "account", User: "user"}, emulator.ConnectorFactory)
+//
+// The client created with the connector factory of the emulator runs its statements in the emulator. The resource tests
+// (see pkg/resources/emulatortests) either call the resource functions directly with this client in the provider context, or
+// run the whole Terraform flow with resource.UnitTest and the provider configured with provider.ConfigureProviderWithConnector
+// (the latter needs the Terraform CLI). The changes made outside Terraform (e.g. to test the drift handling) can be applied
+// with Emulator.Execute.
+package snowflakeemulator
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/snowflakedb/gosnowflake"
+)
+
+const (
+	defaultAccountLocator = "EMULATOR"
+	defaultUser           = "EMULATOR_USER"
+	defaultRole           = "ACCOUNTADMIN"
+)
+
+// systemRoles are the roles existing in every account.
+var systemRoles = []string{"ACCOUNTADMIN", "SECURITYADMIN", "SYSADMIN", "USERADMIN", "PUBLIC"}
+
+// Emulator keeps the objects and the grants of a single emulated account. It is safe for the concurrent use.
+type Emulator struct {
+	mu         sync.Mutex
+	objects    map[string]*object
+	grants     []*grant
+	statements []string
+	sessions   int
+	now        func() time.Time
+}
+
+func New() *Emulator {
+	e := &Emulator{
+		objects: make(map[string]*object),
+		now:     time.Now,
+	}
+	for _, role := range systemRoles {
+		e.put(newObject(roleType, []string{role}, "", e.now()))
+	}
+	return e
+}
+
+// ConnectorFactory returns the connector of the emulator; it can be passed to sdk.NewClientWithConnector.
+func (e *Emulator) ConnectorFactory(*gosnowflake.Config) (driver.Connector, error) {
+	return e.Connector(), nil
+}
+
+// Connector returns the connector of the emulator. Every connection has its own session (e.g. the current role).
+func (e *Emulator) Connector() driver.Connector {
+	return &connector{emulator: e}
+}
+
+// Execute runs the statement in the emulator outside any client, e.g. to change an object outside Terraform.
+func (e *Emulator) Execute(sql string) error {
+	_, err := e.run(e.newSession(), sql)
+	return err
+}
+
+// Statements returns all the statements run in the emulator so far.
+func (e *Emulator) Statements() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]string(nil), e.statements...)
+}
+
+type session struct {
+	id             int
+	role           string
+	secondaryRoles string
+	warehouse      string
+	database       string
+	schema         string
+}
+
+func (e *Emulator) newSession() *session {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.sessions++
+	return &session{id: e.sessions, role: defaultRole}
+}
+
+// result is the result of the statement: the rows for the queries, or the status for the other statements.
+type result struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func statusResult(status string) *result {
+	return &result{columns: []string{"status"}, rows: [][]driver.Value{{status}}}
+}
+
+// run parses and runs the statement. The statement is run while holding the lock, so the statements are atomic.
+// The statements separated by semicolons (the multi-statement calls) are run one by one until the first failure, and the result
+// of the last one is returned.
+func (e *Emulator) run(s *session, sql string) (*result, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.statements = append(e.statements, sql)
+
+	tokens, err := tokenize(sql)
+	if err != nil {
+		return nil, syntaxError(sql, err)
+	}
+	var r *result
+	for _, statement := range splitStatements(tokens) {
+		if r, err = e.runStatement(s, sql, statement); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// splitStatements splits the tokens at the semicolons. The empty statements are skipped, but at least one statement is returned.
+func splitStatements(tokens []token) [][]token {
+	var statements [][]token
+	start := 0
+	for i, t := range tokens {
+		if t.kind == symbolToken && t.value == ";" {
+			if i > start {
+				statements = append(statements, tokens[start:i])
+			}
+			start = i + 1
+		}
+	}
+	if start < len(tokens) || len(statements) == 0 {
+		statements = append(statements, tokens[start:])
+	}
+	return statements
+}
+
+func (e *Emulator) runStatement(s *session, sql string, tokens []token) (*result, error) {
+	p := &parser{tokens: tokens}
+	var r *result
+	var err error
+	switch p.peekWord() {
+	case "CREATE":
+		r, err = e.create(s, p)
+	case "ALTER":
+		r, err = e.alter(s, p)
+	case "DROP":
+		r, err = e.drop(p)
+	case "SHOW":
+		r, err = e.show(s, p)
+	case "DESC", "DESCRIBE":
+		r, err = e.describe(p)
+	case "GRANT":
+		r, err = e.grant(s, p)
+	case "REVOKE":
+		r, err = e.revoke(p)
+	case "USE":
+		r, err = e.use(s, p)
+	case "SELECT":
+		r, err = e.selectContextFunctions(s, p)
+	default:
+		err = errUnsupported
+	}
+	var snowflakeError *gosnowflake.SnowflakeError
+	if errors.As(err, &snowflakeError) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, syntaxError(sql, err)
+	}
+	return r, nil
+}
+
+var errUnsupported = errors.New("the statement is not supported by the emulator")
+
+// Snowflake error codes and SQL states, see https://docs.snowflake.com/en/developer-guide/sql-api/errors.
+const (
+	errorCodeSyntax                      = 1003
+	errorCodeObjectAlreadyExists         = 2002
+	errorCodeObjectNotExistOrAuthorized  = 2003
+	sqlStateSyntaxError                  = "42000"
+	sqlStateDuplicateObject              = "42710"
+	sqlStateObjectNotExistOrUnauthorized = "02000"
+)
+
+func syntaxError(sql string, err error) error {
+	return &gosnowflake.SnowflakeError{
+		Number:   errorCodeSyntax,
+		SQLState: sqlStateSyntaxError,
+		Message:  fmt.Sprintf("SQL compilation error: %s: %s", err, sql),
+	}
+}
+
+func notExistError(objectType string, name []string) error {
+	return &gosnowflake.SnowflakeError{
+		Number:   errorCodeObjectNotExistOrAuthorized,
+		SQLState: sqlStateObjectNotExistOrUnauthorized,
+		Message:  fmt.Sprintf("SQL compilation error:\n%s '%s' does not exist or not authorized.", objectTypeDisplayName(objectType), displayName(name)),
+	}
+}
+
+func alreadyExistsError(name []string) error {
+	return &gosnowflake.SnowflakeError{
+		Number:   errorCodeObjectAlreadyExists,
+		SQLState: sqlStateDuplicateObject,
+		Message:  fmt.Sprintf("SQL compilation error:\nObject '%s' already exists.", displayName(name)),
+	}
+}
+
+type connector struct {
+	emulator *Emulator
+}
+
+func (c *connector) Connect(context.Context) (driver.Conn, error) {
+	return &conn{emulator: c.emulator, session: c.emulator.newSession()}, nil
+}
+
+func (c *connector) Driver() driver.Driver {
+	return nil
+}
+
+type conn struct {
+	emulator *Emulator
+	session  *session
+}
+
+var (
+	_ driver.ExecerContext     = new(conn)
+	_ driver.QueryerContext    = new(conn)
+	_ driver.NamedValueChecker = new(conn)
+	_ driver.Pinger            = new(conn)
+)
+
+func (c *conn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported by the emulator")
+}
+
+func (c *conn) Close() error { return nil }
+
+func (c *conn) Ping(context.Context) error { return nil }
+
+// Begin starts the transaction, which is not isolated; the statements are applied immediately.
+func (c *conn) Begin() (driver.Tx, error) { return tx{}, nil }
+
+func (c *conn) CheckNamedValue(*driver.NamedValue) error { return nil }
+
+func (c *conn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if len(args) > 0 {
+		return nil, errors.New("the arguments are not supported by the emulator")
+	}
+	if _, err := c.emulator.run(c.session, query); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(0), nil
+}
+
+func (c *conn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if len(args) > 0 {
+		return nil, errors.New("the arguments are not supported by the emulator")
+	}
+	r, err := c.emulator.run(c.session, query)
+	if err != nil {
+		return nil, err
+	}
+	return &rows{result: r}, nil
+}
+
+type tx struct{}
+
+func (tx) Commit() error   { return nil }
+func (tx) Rollback() error { return nil }
+
+type rows struct {
+	result *result
+	row    int
+}
+
+func (r *rows) Columns() []string { return r.result.columns }
+func (r *rows) Close() error      { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if r.row >= len(r.result.rows) {
+		return io.EOF
+	}
+	copy(dest, r.result.rows[r.row])
+	r.row++
+	return nil
+}
+
+func boolString(value bool) string {
+	return strconv.FormatBool(value)
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "Y"
+	}
+	return "N"
+}
@@ -0,0 +1,162 @@
+package snowflakeemulator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newClient(t *testing.T) (*Emulator, *sdk.Client) {
+	t.Helper()
+	emulator := New()
+	client, err := sdk.NewClientWithConnector(&gosnowflake.Config{Account: "account", User: "user"}, emulator.ConnectorFactory)
+	require.NoError(t, err)
+	return emulator, client
+}
+
+func Test_Emulator_databasesAndSchemas(t *testing.T) {
+	ctx := context.Background()
+	emulator, client := newClient(t)
+	databaseId := sdk.NewAccountObjectIdentifier("DB")
+	schemaId := sdk.NewDatabaseObjectIdentifier("DB", "SC")
+
+	require.NoError(t, client.Databases.Create(ctx, databaseId, &sdk.CreateDatabaseOptions{Comment: sdk.String("comment"), DataRetentionTimeInDays: sdk.Int(3)}))
+	require.ErrorIs(t, client.Databases.Create(ctx, databaseId, nil), sdk.ErrObjectAlreadyExists)
+	require.NoError(t, client.Schemas.Create(ctx, schemaId, &sdk.CreateSchemaOptions{WithManagedAccess: sdk.Bool(true)}))
+
+	database, err := client.Databases.ShowByID(ctx, databaseId)
+	require.NoError(t, err)
+	assert.Equal(t, "comment", database.Comment)
+	assert.Equal(t, 3, database.RetentionTime)
+	assert.Equal(t, "ACCOUNTADMIN", database.Owner)
+
+	schema, err := client.Schemas.ShowByID(ctx, schemaId)
+	require.NoError(t, err)
+	assert.Equal(t, "DB", schema.DatabaseName)
+	assert.Equal(t, "MANAGED ACCESS", *schema.Options)
+
+	schemas, err := client.Schemas.Show(ctx, &sdk.ShowSchemaOptions{In: &sdk.SchemaIn{Database: sdk.Bool(true), Name: databaseId}})
+	require.NoError(t, err)
+	assert.Len(t, schemas, 2)
+
+	// the change made outside Terraform
+	require.NoError(t, emulator.Execute(`ALTER DATABASE "DB" UNSET COMMENT`))
+	database, err = client.Databases.ShowByID(ctx, databaseId)
+	require.NoError(t, err)
+	assert.Empty(t, database.Comment)
+
+	require.NoError(t, client.Databases.Drop(ctx, databaseId, nil))
+	_, err = client.Schemas.ShowByID(ctx, schemaId)
+	require.ErrorIs(t, err, sdk.ErrObjectNotFound)
+	require.ErrorIs(t, client.Databases.Drop(ctx, databaseId, nil), sdk.ErrObjectNotExistOrAuthorized)
+}
+
+func Test_Emulator_warehouses(t *testing.T) {
+	ctx := context.Background()
+	_, client := newClient(t)
+	id := sdk.NewAccountObjectIdentifier("WH")
+
+	require.NoError(t, client.Warehouses.Create(ctx, id, &sdk.CreateWarehouseOptions{WarehouseSize: sdk.Pointer(sdk.WarehouseSizeSmall), AutoSuspend: sdk.Int(60)}))
+	require.NoError(t, client.Warehouses.Alter(ctx, id, &sdk.AlterWarehouseOptions{Suspend: sdk.Bool(true)}))
+
+	warehouse, err := client.Warehouses.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.WarehouseSizeSmall, warehouse.Size)
+	assert.Equal(t, 60, warehouse.AutoSuspend)
+	assert.Equal(t, sdk.WarehouseStateSuspended, warehouse.State)
+}
+
+func Test_Emulator_tables(t *testing.T) {
+	ctx := context.Background()
+	_, client := newClient(t)
+	require.NoError(t, client.Databases.Create(ctx, sdk.NewAccountObjectIdentifier("DB"), nil))
+	id := sdk.NewSchemaObjectIdentifier("DB", "PUBLIC", "T")
+
+	columns := []sdk.TableColumnRequest{
+		*sdk.NewTableColumnRequest("ID", sdk.DataTypeNumber).WithNotNull(sdk.Bool(true)),
+		*sdk.NewTableColumnRequest("NAME", sdk.DataTypeVARCHAR).WithComment(sdk.String("name")),
+	}
+	require.NoError(t, client.Tables.Create(ctx, sdk.NewCreateTableRequest(id, columns).WithComment(sdk.String("comment"))))
+
+	table, err := client.Tables.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "comment", table.Comment)
+
+	details, err := client.Tables.DescribeColumns(ctx, sdk.NewDescribeTableColumnsRequest(id))
+	require.NoError(t, err)
+	require.Len(t, details, 2)
+	assert.Equal(t, "ID", details[0].Name)
+	assert.Equal(t, sdk.DataType("NUMBER(38,0)"), details[0].Type)
+	assert.False(t, details[0].IsNullable)
+	assert.Equal(t, "name", *details[1].Comment)
+}
+
+func Test_Emulator_grants(t *testing.T) {
+	ctx := context.Background()
+	_, client := newClient(t)
+	databaseId := sdk.NewAccountObjectIdentifier("DB")
+	roleId := sdk.NewAccountObjectIdentifier("ROLE")
+	require.NoError(t, client.Databases.Create(ctx, databaseId, nil))
+	require.NoError(t, client.Roles.Create(ctx, sdk.NewCreateRoleRequest(roleId)))
+
+	privileges := &sdk.AccountRoleGrantPrivileges{AccountObjectPrivileges: []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeUsage, sdk.AccountObjectPrivilegeMonitor}}
+	on := &sdk.AccountRoleGrantOn{AccountObject: &sdk.GrantOnAccountObject{Database: &databaseId}}
+	require.NoError(t, client.Grants.GrantPrivilegesToAccountRole(ctx, privileges, on, roleId, nil))
+	require.NoError(t, client.Roles.Grant(ctx, sdk.NewGrantRoleRequest(roleId, sdk.GrantRole{Role: sdk.Pointer(sdk.NewAccountObjectIdentifier("SYSADMIN"))})))
+
+	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: &sdk.ShowGrantsTo{Role: roleId}})
+	require.NoError(t, err)
+	require.Len(t, grants, 2)
+	assert.Equal(t, "USAGE", grants[0].Privilege)
+	assert.Equal(t, sdk.ObjectTypeDatabase, grants[0].GrantedOn)
+	assert.Equal(t, databaseId, grants[0].Name)
+	assert.Equal(t, roleId, grants[0].GranteeName)
+
+	grants, err = client.Grants.Show(ctx, &sdk.ShowGrantOptions{On: &sdk.ShowGrantsOn{Object: &sdk.Object{ObjectType: sdk.ObjectTypeDatabase, Name: databaseId}}})
+	require.NoError(t, err)
+	require.Len(t, grants, 3)
+	assert.Equal(t, "OWNERSHIP", grants[0].Privilege)
+
+	role, err := client.Roles.ShowByID(ctx, roleId)
+	require.NoError(t, err)
+	assert.Equal(t, 1, role.GrantedToRoles)
+
+	privileges.AccountObjectPrivileges = []sdk.AccountObjectPrivilege{sdk.AccountObjectPrivilegeMonitor}
+	require.NoError(t, client.Grants.RevokePrivilegesFromAccountRole(ctx, privileges, on, roleId, nil))
+	grants, err = client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: &sdk.ShowGrantsTo{Role: roleId}})
+	require.NoError(t, err)
+	require.Len(t, grants, 1)
+	assert.Equal(t, "USAGE", grants[0].Privilege)
+
+	require.NoError(t, client.Roles.Drop(ctx, sdk.NewDropRoleRequest(roleId)))
+	grants, err = client.Grants.Show(ctx, &sdk.ShowGrantOptions{On: &sdk.ShowGrantsOn{Object: &sdk.Object{ObjectType: sdk.ObjectTypeDatabase, Name: databaseId}}})
+	require.NoError(t, err)
+	assert.Len(t, grants, 1)
+}
+
+func Test_Emulator_multiStatement(t *testing.T) {
+	emulator := New()
+
+	require.NoError(t, emulator.Execute("CREATE ROLE \"FIRST\";\nCREATE ROLE \"SECOND\";"))
+	_, ok := emulator.get(roleType, []string{"SECOND"})
+	assert.True(t, ok)
+
+	err := emulator.Execute(`CREATE ROLE "THIRD"; CREATE ROLE "FIRST"; CREATE ROLE "FOURTH"`)
+	require.Error(t, err)
+	_, ok = emulator.get(roleType, []string{"THIRD"})
+	assert.True(t, ok)
+	_, ok = emulator.get(roleType, []string{"FOURTH"})
+	assert.False(t, ok)
+}
+
+func Test_Emulator_unsupportedStatement(t *testing.T) {
+	emulator := New()
+
+	err := emulator.Execute(`CREATE DATABASE "CLONED" CLONE "DB"`)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not supported by the emulator")
+}
@@ -0,0 +1,373 @@
+package snowflakeemulator
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const accountType = "ACCOUNT"
+
+// grant is the privilege on the object (or the role, with the USAGE privilege) granted to the role or the user.
+// The ownership is not kept as the grant; it is the owner of the object.
+type grant struct {
+	createdOn   time.Time
+	privilege   string
+	objectType  string
+	objectName  []string
+	granteeType string
+	granteeName string
+	grantOption bool
+	grantedBy   string
+}
+
+func (g *grant) on(o *object) bool {
+	return g.objectType == o.objectType && slices.Equal(g.objectName, o.name)
+}
+
+// grantTarget is the object in GRANT or REVOKE: a single object, or all the objects of the given type in the database or schema.
+type grantTarget struct {
+	objects []*object
+}
+
+// target reads the object after ON. The account is returned as the object without a name.
+func (e *Emulator) target(p *parser) (*grantTarget, error) {
+	if err := p.expectWords("ON"); err != nil {
+		return nil, err
+	}
+	if p.acceptWords("ACCOUNT") {
+		return &grantTarget{objects: []*object{{objectType: accountType, name: []string{defaultAccountLocator}}}}, nil
+	}
+	if p.acceptWords("FUTURE") {
+		return nil, errUnsupported
+	}
+	if p.acceptWords("ALL") {
+		objectType, ok := objectTypeFromPlural(p.peekWord())
+		if !ok {
+			return nil, errUnsupported
+		}
+		p.pos++
+		if err := p.expectWords("IN"); err != nil {
+			return nil, err
+		}
+		scopeType, err := p.objectType()
+		if err != nil {
+			return nil, err
+		}
+		scopeName, err := p.objectName(scopeType)
+		if err != nil {
+			return nil, err
+		}
+		scope, ok := e.get(scopeType, scopeName)
+		if !ok {
+			return nil, notExistError(scopeType, scopeName)
+		}
+		target := &grantTarget{}
+		for _, child := range e.children(scope) {
+			if child.objectType == objectType {
+				target.objects = append(target.objects, child)
+			}
+		}
+		return target, nil
+	}
+	objectType, err := p.objectType()
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.objectName(objectType)
+	if err != nil {
+		return nil, err
+	}
+	o, ok := e.get(objectType, name)
+	if !ok {
+		return nil, notExistError(objectType, name)
+	}
+	return &grantTarget{objects: []*object{o}}, nil
+}
+
+// privileges reads the comma-separated privileges (e.g. CREATE SCHEMA, USAGE) until ON.
+func (p *parser) privileges() ([]string, error) {
+	var privileges []string
+	var current []string
+	for !p.done() && p.peekWord() != "ON" {
+		if p.acceptSymbol(",") {
+			privileges = append(privileges, strings.Join(current, " "))
+			current = nil
+			continue
+		}
+		t, _ := p.next()
+		if t.kind != wordToken {
+			return nil, fmt.Errorf("unexpected %s", t.value)
+		}
+		current = append(current, strings.ToUpper(t.value))
+	}
+	if len(current) == 0 {
+		return nil, fmt.Errorf("expected privilege")
+	}
+	return append(privileges, strings.Join(current, " ")), nil
+}
+
+// grantee reads the role or the user after TO or FROM. The users are not emulated, so they are not validated.
+func (e *Emulator) grantee(p *parser) (string, string, error) {
+	granteeType := roleType
+	if p.acceptWords("USER") {
+		granteeType = userType
+	} else if err := p.expectWords("ROLE"); err != nil {
+		return "", "", err
+	}
+	name, err := p.identifier()
+	if err != nil {
+		return "", "", err
+	}
+	if len(name) != 1 {
+		return "", "", fmt.Errorf("invalid grantee %s", strings.Join(name, "."))
+	}
+	if granteeType == roleType {
+		if _, ok := e.get(roleType, name); !ok {
+			return "", "", notExistError(roleType, name)
+		}
+	}
+	return granteeType, name[0], nil
+}
+
+func (e *Emulator) findGrant(g *grant) int {
+	return slices.IndexFunc(e.grants, func(existing *grant) bool {
+		return existing.privilege == g.privilege && existing.objectType == g.objectType && slices.Equal(existing.objectName, g.objectName) &&
+			existing.granteeType == g.granteeType && existing.granteeName == g.granteeName
+	})
+}
+
+func (e *Emulator) grant(s *session, p *parser) (*result, error) {
+	if err := p.expectWords("GRANT"); err != nil {
+		return nil, err
+	}
+	if p.acceptWords("ROLE") {
+		role, err := p.objectName(roleType)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := e.get(roleType, role); !ok {
+			return nil, notExistError(roleType, role)
+		}
+		if err := p.expectWords("TO"); err != nil {
+			return nil, err
+		}
+		granteeType, granteeName, err := e.grantee(p)
+		if err != nil {
+			return nil, err
+		}
+		e.addGrant(&grant{createdOn: e.now(), privilege: "USAGE", objectType: roleType, objectName: role, granteeType: granteeType, granteeName: granteeName, grantedBy: s.role})
+		return statusResult("Statement executed successfully."), nil
+	}
+
+	ownership := p.acceptWords("OWNERSHIP")
+	var privileges []string
+	if !ownership {
+		var err error
+		if privileges, err = p.privileges(); err != nil {
+			return nil, err
+		}
+	}
+	target, err := e.target(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectWords("TO"); err != nil {
+		return nil, err
+	}
+	granteeType, granteeName, err := e.grantee(p)
+	if err != nil {
+		return nil, err
+	}
+	if ownership {
+		if granteeType != roleType {
+			return nil, errUnsupported
+		}
+		// the current grants are kept, like with COPY CURRENT GRANTS
+		for _, o := range target.objects {
+			if o.objectType == accountType {
+				return nil, errUnsupported
+			}
+			o.owner = granteeName
+		}
+		return statusResult("Statement executed successfully."), nil
+	}
+	grantOption := p.acceptWords("WITH", "GRANT", "OPTION")
+	if !p.done() {
+		return nil, errUnsupported
+	}
+	for _, o := range target.objects {
+		for _, privilege := range privileges {
+			e.addGrant(&grant{createdOn: e.now(), privilege: privilege, objectType: o.objectType, objectName: o.name, granteeType: granteeType, granteeName: granteeName, grantOption: grantOption, grantedBy: s.role})
+		}
+	}
+	return statusResult("Statement executed successfully."), nil
+}
+
+// addGrant adds the grant, or updates the grant option of the existing one.
+func (e *Emulator) addGrant(g *grant) {
+	if i := e.findGrant(g); i >= 0 {
+		e.grants[i].grantOption = e.grants[i].grantOption || g.grantOption
+		return
+	}
+	e.grants = append(e.grants, g)
+}
+
+func (e *Emulator) revoke(p *parser) (*result, error) {
+	if err := p.expectWords("REVOKE"); err != nil {
+		return nil, err
+	}
+	if p.acceptWords("ROLE") {
+		role, err := p.objectName(roleType)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectWords("FROM"); err != nil {
+			return nil, err
+		}
+		granteeType, granteeName, err := e.grantee(p)
+		if err != nil {
+			return nil, err
+		}
+		e.removeGrant(&grant{privilege: "USAGE", objectType: roleType, objectName: role, granteeType: granteeType, granteeName: granteeName}, false)
+		return statusResult("Statement executed successfully."), nil
+	}
+
+	grantOptionOnly := p.acceptWords("GRANT", "OPTION", "FOR")
+	privileges, err := p.privileges()
+	if err != nil {
+		return nil, err
+	}
+	target, err := e.target(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectWords("FROM"); err != nil {
+		return nil, err
+	}
+	granteeType, granteeName, err := e.grantee(p)
+	if err != nil {
+		return nil, err
+	}
+	if !p.acceptWords("RESTRICT") {
+		p.acceptWords("CASCADE")
+	}
+	if !p.done() {
+		return nil, errUnsupported
+	}
+	for _, o := range target.objects {
+		for _, privilege := range privileges {
+			if privilege == "ALL" || privilege == "ALL PRIVILEGES" {
+				for _, g := range slices.Clone(e.grants) {
+					if g.on(o) && g.granteeType == granteeType && g.granteeName == granteeName {
+						e.removeGrant(g, grantOptionOnly)
+					}
+				}
+				continue
+			}
+			e.removeGrant(&grant{privilege: privilege, objectType: o.objectType, objectName: o.name, granteeType: granteeType, granteeName: granteeName}, grantOptionOnly)
+		}
+	}
+	return statusResult("Statement executed successfully."), nil
+}
+
+// removeGrant removes the grant (or only its grant option); revoking the privilege which was not granted succeeds, like in Snowflake.
+func (e *Emulator) removeGrant(g *grant, grantOptionOnly bool) {
+	i := e.findGrant(g)
+	if i < 0 {
+		return
+	}
+	if grantOptionOnly {
+		e.grants[i].grantOption = false
+		return
+	}
+	e.grants = slices.Delete(e.grants, i, i+1)
+}
+
+var (
+	showGrantsColumns   = []string{"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by"}
+	showGrantsOfColumns = []string{"created_on", "role", "granted_to", "grantee_name", "granted_by"}
+)
+
+// showGrants answers SHOW GRANTS ON, TO, and OF. The ownership of the objects is returned as the OWNERSHIP privilege granted to the owner.
+func (e *Emulator) showGrants(p *parser) (*result, error) {
+	switch {
+	case p.acceptWords("ON"):
+		p.pos--
+		target, err := e.target(p)
+		if err != nil {
+			return nil, err
+		}
+		if len(target.objects) != 1 || !p.done() {
+			return nil, errUnsupported
+		}
+		o := target.objects[0]
+		return e.grantsResult(func(g *grant) bool { return g.on(o) }, func(owned *object) bool { return owned == o }), nil
+	case p.acceptWords("TO", "ROLE"):
+		role, err := p.objectName(roleType)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := e.get(roleType, role); !ok {
+			return nil, notExistError(roleType, role)
+		}
+		if !p.done() {
+			return nil, errUnsupported
+		}
+		return e.grantsResult(
+			func(g *grant) bool { return g.granteeType == roleType && g.granteeName == role[0] },
+			func(owned *object) bool { return owned.owner == role[0] },
+		), nil
+	case p.acceptWords("TO", "USER"):
+		user, err := p.identifier()
+		if err != nil {
+			return nil, err
+		}
+		return e.roleGrantsResult(func(g *grant) bool { return g.granteeType == userType && g.granteeName == user[0] }), nil
+	case p.acceptWords("OF", "ROLE"):
+		role, err := p.objectName(roleType)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := e.get(roleType, role); !ok {
+			return nil, notExistError(roleType, role)
+		}
+		return e.roleGrantsResult(func(g *grant) bool { return g.objectType == roleType && g.objectName[0] == role[0] }), nil
+	default:
+		return nil, errUnsupported
+	}
+}
+
+func (e *Emulator) grantsResult(grantFilter func(*grant) bool, ownershipFilter func(*object) bool) *result {
+	r := &result{columns: showGrantsColumns}
+	var owned []*object
+	for _, o := range e.objects {
+		if o.owner != "" && ownershipFilter(o) {
+			owned = append(owned, o)
+		}
+	}
+	slices.SortFunc(owned, func(a, b *object) int {
+		return strings.Compare(a.key(), b.key())
+	})
+	for _, o := range owned {
+		r.rows = append(r.rows, []driver.Value{o.createdOn, "OWNERSHIP", o.objectType, displayName(o.name), roleType, o.owner, boolString(true), o.owner})
+	}
+	for _, g := range e.grants {
+		if grantFilter(g) {
+			r.rows = append(r.rows, []driver.Value{g.createdOn, g.privilege, g.objectType, displayName(g.objectName), g.granteeType, g.granteeName, boolString(g.grantOption), g.grantedBy})
+		}
+	}
+	return r
+}
+
+func (e *Emulator) roleGrantsResult(filter func(*grant) bool) *result {
+	r := &result{columns: showGrantsOfColumns}
+	for _, g := range e.grants {
+		if g.objectType == roleType && filter(g) {
+			r.rows = append(r.rows, []driver.Value{g.createdOn, g.objectName[0], g.granteeType, g.granteeName, g.grantedBy})
+		}
+	}
+	return r
+}
@@ -0,0 +1,230 @@
+package snowflakeemulator
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	databaseType  = "DATABASE"
+	schemaType    = "SCHEMA"
+	roleType      = "ROLE"
+	warehouseType = "WAREHOUSE"
+	tableType     = "TABLE"
+	userType      = "USER"
+)
+
+type objectTypeDefinition struct {
+	// nameParts is the number of the parts of the fully qualified name
+	nameParts int
+	// parentType is the type of the object containing this one, if any
+	parentType  string
+	plural      string
+	displayName string
+}
+
+var objectTypes = map[string]objectTypeDefinition{
+	databaseType:  {nameParts: 1, plural: "DATABASES", displayName: "Database"},
+	schemaType:    {nameParts: 2, parentType: databaseType, plural: "SCHEMAS", displayName: "Schema"},
+	roleType:      {nameParts: 1, plural: "ROLES", displayName: "Role"},
+	warehouseType: {nameParts: 1, plural: "WAREHOUSES", displayName: "Warehouse"},
+	tableType:     {nameParts: 3, parentType: schemaType, plural: "TABLES", displayName: "Table"},
+}
+
+func objectTypeDisplayName(objectType string) string {
+	if definition, ok := objectTypes[objectType]; ok {
+		return definition.displayName
+	}
+	return "Object"
+}
+
+// objectTypeFromPlural returns the object type for the plural used in SHOW (e.g. DATABASES).
+func objectTypeFromPlural(plural string) (string, bool) {
+	for objectType, definition := range objectTypes {
+		if definition.plural == plural {
+			return objectType, true
+		}
+	}
+	return "", false
+}
+
+// parseObjectType reads the object type (e.g. DATABASE) from the statement.
+func (p *parser) objectType() (string, error) {
+	word := p.peekWord()
+	if _, ok := objectTypes[word]; !ok {
+		return "", fmt.Errorf("unsupported object type %s", word)
+	}
+	p.pos++
+	return word, nil
+}
+
+// objectName reads the fully qualified name of the object of the given type.
+func (p *parser) objectName(objectType string) ([]string, error) {
+	name, err := p.identifier()
+	if err != nil {
+		return nil, err
+	}
+	if len(name) != objectTypes[objectType].nameParts {
+		return nil, fmt.Errorf("the %s identifier has to be fully qualified: %s", strings.ToLower(objectType), strings.Join(name, "."))
+	}
+	return name, nil
+}
+
+type object struct {
+	objectType string
+	name       []string
+	owner      string
+	createdOn  time.Time
+	// properties are the properties set in CREATE or ALTER ... SET, with the upper-cased keys; the keywords (e.g. TRANSIENT) have empty values
+	properties map[string]string
+	columns    []*column
+	suspended  bool
+}
+
+func newObject(objectType string, name []string, owner string, createdOn time.Time) *object {
+	return &object{
+		objectType: objectType,
+		name:       name,
+		owner:      owner,
+		createdOn:  createdOn,
+		properties: make(map[string]string),
+	}
+}
+
+func (o *object) key() string {
+	return objectKey(o.objectType, o.name)
+}
+
+func (o *object) property(key string) (string, bool) {
+	value, ok := o.properties[key]
+	return value, ok
+}
+
+func (o *object) propertyOr(key string, defaultValue string) string {
+	if value, ok := o.properties[key]; ok {
+		return value
+	}
+	return defaultValue
+}
+
+func (o *object) hasKeyword(keyword string) bool {
+	_, ok := o.properties[keyword]
+	return ok
+}
+
+type column struct {
+	name         string
+	dataType     string
+	nullable     bool
+	defaultValue *string
+	comment      *string
+	primaryKey   bool
+	unique       bool
+}
+
+func objectKey(objectType string, name []string) string {
+	return objectType + "|" + strings.Join(name, "\x00")
+}
+
+func (e *Emulator) get(objectType string, name []string) (*object, bool) {
+	o, ok := e.objects[objectKey(objectType, name)]
+	return o, ok
+}
+
+func (e *Emulator) put(o *object) {
+	e.objects[o.key()] = o
+}
+
+// children returns the objects contained in the given one (e.g. the schemas and the tables of the database).
+func (e *Emulator) children(parent *object) []*object {
+	var children []*object
+	for _, o := range e.objects {
+		if o != parent && isParentType(parent.objectType, o.objectType) && hasPrefix(o.name, parent.name) {
+			children = append(children, o)
+		}
+	}
+	return children
+}
+
+func isParentType(parentType string, objectType string) bool {
+	for current := objectTypes[objectType].parentType; current != ""; current = objectTypes[current].parentType {
+		if current == parentType {
+			return true
+		}
+	}
+	return false
+}
+
+func hasPrefix(name []string, prefix []string) bool {
+	return len(name) > len(prefix) && slices.Equal(name[:len(prefix)], prefix)
+}
+
+// remove removes the object with its children and all the grants on them.
+func (e *Emulator) remove(o *object) {
+	for _, removed := range append(e.children(o), o) {
+		delete(e.objects, removed.key())
+		e.grants = slices.DeleteFunc(e.grants, func(g *grant) bool {
+			return g.on(removed) || (removed.objectType == roleType && g.granteeType == roleType && g.granteeName == removed.name[0])
+		})
+	}
+}
+
+// rename renames the object, its children, and the grants on them.
+func (e *Emulator) rename(o *object, newName []string) {
+	oldName := o.name
+	renamed := func(name []string) []string {
+		return append(slices.Clone(newName), name[len(oldName):]...)
+	}
+	for _, g := range e.grants {
+		if g.objectType == o.objectType && slices.Equal(g.objectName, oldName) || isParentType(o.objectType, g.objectType) && hasPrefix(g.objectName, oldName) {
+			g.objectName = renamed(g.objectName)
+		}
+		if o.objectType == roleType && g.granteeType == roleType && g.granteeName == oldName[0] {
+			g.granteeName = newName[0]
+		}
+	}
+	for _, child := range e.children(o) {
+		delete(e.objects, child.key())
+		child.name = renamed(child.name)
+		e.put(child)
+	}
+	delete(e.objects, o.key())
+	o.name = newName
+	e.put(o)
+}
+
+var unquotedIdentifierRegexp = regexp.MustCompile(`^[A-Z_][A-Z0-9_$]*$`)
+
+// displayName returns the name in the form shown by Snowflake (e.g. in SHOW GRANTS): the parts are quoted only when needed.
+func displayName(name []string) string {
+	parts := make([]string, len(name))
+	for i, part := range name {
+		if unquotedIdentifierRegexp.MatchString(part) {
+			parts[i] = part
+		} else {
+			parts[i] = `"` + strings.ReplaceAll(part, `"`, `""`) + `"`
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// likePattern returns the regexp matching the names case-insensitively like the LIKE pattern.
+func likePattern(pattern string) *regexp.Regexp {
+	var builder strings.Builder
+	builder.WriteString("(?is)^")
+	for _, r := range pattern {
+		switch r {
+		case '%':
+			builder.WriteString(".*")
+		case '_':
+			builder.WriteString(".")
+		default:
+			builder.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	builder.WriteString("$")
+	return regexp.MustCompile(builder.String())
+}
@@ -0,0 +1,373 @@
+package snowflakeemulator
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	wordToken tokenKind = iota
+	quotedIdentifierToken
+	stringToken
+	numberToken
+	symbolToken
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+// tokenize splits the statement into tokens, skipping the comments (e.g. the usage tracking metadata appended by the provider).
+func tokenize(sql string) ([]token, error) {
+	var tokens []token
+	runes := []rune(sql)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			end := strings.Index(string(runes[i+2:]), "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated comment")
+			}
+			i += 2 + len([]rune(string(runes[i+2:])[:end])) + 2
+		case r == '"':
+			value, next, err := readQuoted(runes, i, '"')
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: quotedIdentifierToken, value: value})
+			i = next
+		case r == '\'':
+			value, next, err := readQuoted(runes, i, '\'')
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: stringToken, value: value})
+			i = next
+		case r == '$' && i+1 < len(runes) && runes[i+1] == '$':
+			end := strings.Index(string(runes[i+2:]), "$$")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated $$ string")
+			}
+			value := string(runes[i+2:])[:end]
+			tokens = append(tokens, token{kind: stringToken, value: value})
+			i += 2 + len([]rune(value)) + 2
+		case unicode.IsDigit(r) || (r == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			start := i
+			i++
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{kind: numberToken, value: string(runes[start:i])})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_' || runes[i] == '$') {
+				i++
+			}
+			tokens = append(tokens, token{kind: wordToken, value: string(runes[start:i])})
+		default:
+			tokens = append(tokens, token{kind: symbolToken, value: string(r)})
+			i++
+		}
+	}
+	return tokens, nil
+}
+
+// readQuoted reads the value quoted with the given rune, in which the quote is escaped by doubling it (or with a backslash for strings).
+func readQuoted(runes []rune, start int, quote rune) (string, int, error) {
+	var builder strings.Builder
+	for i := start + 1; i < len(runes); i++ {
+		switch {
+		case quote == '\'' && runes[i] == '\\' && i+1 < len(runes):
+			builder.WriteRune(runes[i+1])
+			i++
+		case runes[i] == quote && i+1 < len(runes) && runes[i+1] == quote:
+			builder.WriteRune(quote)
+			i++
+		case runes[i] == quote:
+			return builder.String(), i + 1, nil
+		default:
+			builder.WriteRune(runes[i])
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated %c", quote)
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens) || (p.tokens[p.pos].kind == symbolToken && p.tokens[p.pos].value == ";" && p.pos == len(p.tokens)-1)
+}
+
+func (p *parser) peek() (token, bool) {
+	if p.done() {
+		return token{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+// peekWord returns the upper-cased next word, or an empty string when the next token is not a word.
+func (p *parser) peekWord() string {
+	t, ok := p.peek()
+	if !ok || t.kind != wordToken {
+		return ""
+	}
+	return strings.ToUpper(t.value)
+}
+
+func (p *parser) next() (token, bool) {
+	t, ok := p.peek()
+	if ok {
+		p.pos++
+	}
+	return t, ok
+}
+
+// acceptWords consumes the given sequence of words (case-insensitive) if the next tokens match it.
+func (p *parser) acceptWords(words ...string) bool {
+	for i, word := range words {
+		if p.pos+i >= len(p.tokens) {
+			return false
+		}
+		t := p.tokens[p.pos+i]
+		if t.kind != wordToken || !strings.EqualFold(t.value, word) {
+			return false
+		}
+	}
+	p.pos += len(words)
+	return true
+}
+
+func (p *parser) expectWords(words ...string) error {
+	if !p.acceptWords(words...) {
+		return fmt.Errorf("expected %s", strings.Join(words, " "))
+	}
+	return nil
+}
+
+func (p *parser) acceptSymbol(symbol string) bool {
+	t, ok := p.peek()
+	if ok && t.kind == symbolToken && t.value == symbol {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// identifier reads the identifier with the parts separated by dots. The unquoted parts are upper-cased, like in Snowflake.
+func (p *parser) identifier() ([]string, error) {
+	var parts []string
+	for {
+		t, ok := p.next()
+		if !ok {
+			return nil, fmt.Errorf("expected identifier")
+		}
+		switch t.kind {
+		case quotedIdentifierToken:
+			parts = append(parts, t.value)
+		case wordToken:
+			parts = append(parts, strings.ToUpper(t.value))
+		default:
+			return nil, fmt.Errorf("expected identifier, got %s", t.value)
+		}
+		if !p.acceptSymbol(".") {
+			return parts, nil
+		}
+	}
+}
+
+// value reads the value of the property: a string, a number, a word, or a group in parentheses (returned as the raw text).
+func (p *parser) value() (string, error) {
+	t, ok := p.peek()
+	if !ok {
+		return "", fmt.Errorf("expected value")
+	}
+	if t.kind == symbolToken && t.value == "(" {
+		return p.group()
+	}
+	p.pos++
+	switch t.kind {
+	case wordToken:
+		value := strings.ToUpper(t.value)
+		// identifiers of the related objects (e.g. RESOURCE_MONITOR = "DB"."MONITOR")
+		for p.acceptSymbol(".") {
+			part, err := p.identifier()
+			if err != nil {
+				return "", err
+			}
+			value = value + "." + strings.Join(part, ".")
+		}
+		return value, nil
+	case quotedIdentifierToken:
+		value := t.value
+		for p.acceptSymbol(".") {
+			part, err := p.identifier()
+			if err != nil {
+				return "", err
+			}
+			value = value + "." + strings.Join(part, ".")
+		}
+		return value, nil
+	case stringToken, numberToken:
+		return t.value, nil
+	default:
+		return "", fmt.Errorf("expected value, got %s", t.value)
+	}
+}
+
+// group reads the tokens in the parentheses (including the nested ones) and returns them as text.
+func (p *parser) group() (string, error) {
+	if !p.acceptSymbol("(") {
+		return "", fmt.Errorf("expected (")
+	}
+	depth := 1
+	var parts []string
+	for depth > 0 {
+		t, ok := p.next()
+		if !ok {
+			return "", fmt.Errorf("unterminated (")
+		}
+		if t.kind == symbolToken && t.value == "(" {
+			depth++
+		}
+		if t.kind == symbolToken && t.value == ")" {
+			depth--
+			if depth == 0 {
+				break
+			}
+		}
+		parts = append(parts, t.text())
+	}
+	return "(" + strings.Join(parts, " ") + ")", nil
+}
+
+// groupTokens returns the tokens in the parentheses split by the top-level commas.
+func (p *parser) groupTokens() ([][]token, error) {
+	if !p.acceptSymbol("(") {
+		return nil, fmt.Errorf("expected (")
+	}
+	depth := 1
+	items := [][]token{{}}
+	for {
+		t, ok := p.next()
+		if !ok {
+			return nil, fmt.Errorf("unterminated (")
+		}
+		if t.kind == symbolToken {
+			switch t.value {
+			case "(":
+				depth++
+			case ")":
+				depth--
+				if depth == 0 {
+					return items, nil
+				}
+			case ",":
+				if depth == 1 {
+					items = append(items, []token{})
+					continue
+				}
+			}
+		}
+		items[len(items)-1] = append(items[len(items)-1], t)
+	}
+}
+
+func (t token) text() string {
+	switch t.kind {
+	case quotedIdentifierToken:
+		return `"` + strings.ReplaceAll(t.value, `"`, `""`) + `"`
+	case stringToken:
+		return `'` + strings.ReplaceAll(t.value, `'`, `''`) + `'`
+	case wordToken:
+		return strings.ToUpper(t.value)
+	default:
+		return t.value
+	}
+}
+
+// properties reads the KEY = value pairs and the keywords (e.g. WITH MANAGED ACCESS) until the end of the statement,
+// or until one of the stop words. The keywords are returned as the properties with an empty value.
+func (p *parser) properties(stopWords ...string) (map[string]string, error) {
+	properties := make(map[string]string)
+	var keyword []string
+	flush := func() {
+		if len(keyword) > 0 {
+			properties[strings.Join(keyword, " ")] = ""
+			keyword = nil
+		}
+	}
+	for !p.done() {
+		word := p.peekWord()
+		for _, stopWord := range stopWords {
+			if word == stopWord {
+				flush()
+				return properties, nil
+			}
+		}
+		if p.acceptSymbol(",") {
+			flush()
+			continue
+		}
+		t, _ := p.next()
+		if t.kind != wordToken {
+			// e.g. the parameters of the keyword, like CLUSTER BY (...), are stored as its value
+			if t.kind == symbolToken && t.value == "(" && len(keyword) > 0 {
+				p.pos--
+				group, err := p.group()
+				if err != nil {
+					return nil, err
+				}
+				properties[strings.Join(keyword, " ")] = group
+				keyword = nil
+				continue
+			}
+			return nil, fmt.Errorf("unexpected %s", t.value)
+		}
+		if p.acceptSymbol("=") {
+			// the keys of the properties are single words, so the preceding words are the separate keyword
+			flush()
+			value, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			properties[strings.ToUpper(t.value)] = value
+			continue
+		}
+		keyword = append(keyword, strings.ToUpper(t.value))
+	}
+	flush()
+	return properties, nil
+}
+
+// names reads the comma-separated list of the property names (e.g. in UNSET).
+func (p *parser) names() []string {
+	var names []string
+	var current []string
+	for !p.done() {
+		if p.acceptSymbol(",") {
+			names = append(names, strings.Join(current, " "))
+			current = nil
+			continue
+		}
+		t, _ := p.next()
+		current = append(current, strings.ToUpper(t.value))
+	}
+	if len(current) > 0 {
+		names = append(names, strings.Join(current, " "))
+	}
+	return names
+}
@@ -0,0 +1,366 @@
+package snowflakeemulator
+
+import (
+	"database/sql/driver"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+func (e *Emulator) show(s *session, p *parser) (*result, error) {
+	if err := p.expectWords("SHOW"); err != nil {
+		return nil, err
+	}
+	p.acceptWords("TERSE")
+	switch {
+	case p.acceptWords("GRANTS"):
+		return e.showGrants(p)
+	case p.acceptWords("PARAMETERS"):
+		return e.showParameters(p)
+	}
+
+	objectType, ok := objectTypeFromPlural(p.peekWord())
+	if !ok {
+		return nil, errUnsupported
+	}
+	p.pos++
+
+	var like string
+	if p.acceptWords("LIKE") {
+		pattern, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		like = pattern
+	}
+	var scope []string
+	if p.acceptWords("IN") {
+		switch {
+		case p.acceptWords("ACCOUNT"):
+		case p.acceptWords("DATABASE"), p.acceptWords("SCHEMA"):
+			name, err := p.identifier()
+			if err != nil {
+				return nil, err
+			}
+			scope = name
+		default:
+			return nil, errUnsupported
+		}
+	}
+	var startsWith string
+	if p.acceptWords("STARTS", "WITH") {
+		prefix, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		startsWith = prefix
+	}
+	limit := -1
+	if p.acceptWords("LIMIT") {
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		if limit, err = strconv.Atoi(value); err != nil {
+			return nil, err
+		}
+	}
+	if !p.done() {
+		return nil, errUnsupported
+	}
+
+	var objects []*object
+	for _, o := range e.objects {
+		if o.objectType != objectType || (len(scope) > 0 && !hasPrefix(o.name, scope)) {
+			continue
+		}
+		name := o.name[len(o.name)-1]
+		if (like != "" && !likePattern(like).MatchString(name)) || !strings.HasPrefix(name, startsWith) {
+			continue
+		}
+		objects = append(objects, o)
+	}
+	slices.SortFunc(objects, func(a, b *object) int {
+		return slices.Compare(a.name, b.name)
+	})
+	if limit >= 0 && len(objects) > limit {
+		objects = objects[:limit]
+	}
+
+	r := &result{columns: showColumns[objectType]}
+	for _, o := range objects {
+		r.rows = append(r.rows, e.showRow(s, o))
+	}
+	return r, nil
+}
+
+// showColumns are the columns returned by SHOW for the given object type, in the order used by Snowflake.
+var showColumns = map[string][]string{
+	databaseType:  {"created_on", "name", "is_default", "is_current", "origin", "owner", "comment", "options", "retention_time", "kind", "budget", "owner_role_type"},
+	schemaType:    {"created_on", "name", "is_default", "is_current", "database_name", "owner", "comment", "options", "retention_time", "owner_role_type", "budget"},
+	roleType:      {"created_on", "name", "is_default", "is_current", "is_inherited", "assigned_to_users", "granted_to_roles", "granted_roles", "owner", "comment"},
+	warehouseType: {"name", "state", "type", "size", "min_cluster_count", "max_cluster_count", "started_clusters", "running", "queued", "is_default", "is_current", "auto_suspend", "auto_resume", "available", "provisioning", "quiescing", "other", "created_on", "resumed_on", "updated_on", "owner", "comment", "enable_query_acceleration", "query_acceleration_max_scale_factor", "resource_monitor", "actives", "pendings", "failed", "suspended", "uuid", "scaling_policy", "budget", "owner_role_type"},
+	tableType:     {"created_on", "name", "database_name", "schema_name", "kind", "comment", "cluster_by", "rows", "bytes", "owner", "retention_time", "automatic_clustering", "change_tracking", "search_optimization", "search_optimization_progress", "search_optimization_bytes", "is_external", "enable_schema_evolution", "owner_role_type", "is_event", "budget"},
+}
+
+// warehouseSizes maps the sizes used in CREATE WAREHOUSE to the ones shown by SHOW WAREHOUSES.
+var warehouseSizes = map[string]string{
+	"XSMALL":   "X-Small",
+	"X-SMALL":  "X-Small",
+	"SMALL":    "Small",
+	"MEDIUM":   "Medium",
+	"LARGE":    "Large",
+	"XLARGE":   "X-Large",
+	"X-LARGE":  "X-Large",
+	"XXLARGE":  "2X-Large",
+	"X2LARGE":  "2X-Large",
+	"2X-LARGE": "2X-Large",
+	"XXXLARGE": "3X-Large",
+	"X3LARGE":  "3X-Large",
+	"3X-LARGE": "3X-Large",
+	"X4LARGE":  "4X-Large",
+	"4X-LARGE": "4X-Large",
+	"X5LARGE":  "5X-Large",
+	"5X-LARGE": "5X-Large",
+	"X6LARGE":  "6X-Large",
+	"6X-LARGE": "6X-Large",
+}
+
+func (e *Emulator) showRow(s *session, o *object) []driver.Value {
+	comment := nullableProperty(o, "COMMENT")
+	name := o.name[len(o.name)-1]
+	switch o.objectType {
+	case databaseType:
+		return []driver.Value{o.createdOn, name, "N", yesNo(s.database == name), "", o.owner, emptyIfNull(comment), options(o), e.parameterValue(o, "DATA_RETENTION_TIME_IN_DAYS", "1"), "STANDARD", nil, ownerRoleType(o)}
+	case schemaType:
+		return []driver.Value{o.createdOn, name, "N", yesNo(s.database == o.name[0] && s.schema == name), o.name[0], o.owner, emptyIfNull(comment), options(o), e.parameterValue(o, "DATA_RETENTION_TIME_IN_DAYS", "1"), ownerRoleType(o), nil}
+	case roleType:
+		var assignedToUsers, grantedToRoles, grantedRoles int64
+		for _, g := range e.grants {
+			if g.objectType == roleType && g.objectName[0] == name {
+				if g.granteeType == userType {
+					assignedToUsers++
+				} else {
+					grantedToRoles++
+				}
+			}
+			if g.objectType == roleType && g.granteeType == roleType && g.granteeName == name {
+				grantedRoles++
+			}
+		}
+		return []driver.Value{o.createdOn, name, "N", yesNo(s.role == name), "N", assignedToUsers, grantedToRoles, grantedRoles, o.owner, emptyIfNull(comment)}
+	case warehouseType:
+		state, startedClusters := "STARTED", int64(1)
+		if o.suspended {
+			state, startedClusters = "SUSPENDED", 0
+		}
+		size := warehouseSizes[strings.ToUpper(o.propertyOr("WAREHOUSE_SIZE", "XSMALL"))]
+		var autoSuspend driver.Value = o.propertyOr("AUTO_SUSPEND", "600")
+		if autoSuspend == "0" || autoSuspend == "NULL" {
+			autoSuspend = nil
+		}
+		return []driver.Value{
+			name, state, o.propertyOr("WAREHOUSE_TYPE", "STANDARD"), size, o.propertyOr("MIN_CLUSTER_COUNT", "1"), o.propertyOr("MAX_CLUSTER_COUNT", "1"), startedClusters, int64(0), int64(0),
+			"N", yesNo(s.warehouse == name), autoSuspend, strings.ToLower(o.propertyOr("AUTO_RESUME", "true")), "", "", "", "",
+			o.createdOn, o.createdOn, o.createdOn, o.owner, emptyIfNull(comment), strings.ToLower(o.propertyOr("ENABLE_QUERY_ACCELERATION", "false")), o.propertyOr("QUERY_ACCELERATION_MAX_SCALE_FACTOR", "8"), o.propertyOr("RESOURCE_MONITOR", "null"),
+			"", "", "", "", "", o.propertyOr("SCALING_POLICY", "STANDARD"), nil, ownerRoleType(o),
+		}
+	case tableType:
+		kind := "TABLE"
+		if o.hasKeyword("TRANSIENT") {
+			kind = "TRANSIENT"
+		}
+		var clusterBy driver.Value = ""
+		if value, ok := o.property("CLUSTER BY"); ok {
+			clusterBy = "LINEAR" + value
+		}
+		return []driver.Value{
+			o.createdOn, name, o.name[0], o.name[1], kind, emptyIfNull(comment), clusterBy, int64(0), int64(0), o.owner, e.parameterValue(o, "DATA_RETENTION_TIME_IN_DAYS", "1"),
+			"OFF", onOff(o.propertyOr("CHANGE_TRACKING", "FALSE")), "OFF", nil, nil, "N", yesNo(strings.EqualFold(o.propertyOr("ENABLE_SCHEMA_EVOLUTION", "FALSE"), "TRUE")), ownerRoleType(o), "N", nil,
+		}
+	default:
+		return nil
+	}
+}
+
+func nullableProperty(o *object, key string) driver.Value {
+	if value, ok := o.property(key); ok {
+		return value
+	}
+	return nil
+}
+
+func emptyIfNull(value driver.Value) driver.Value {
+	if value == nil {
+		return ""
+	}
+	return value
+}
+
+func options(o *object) string {
+	var options []string
+	if o.hasKeyword("TRANSIENT") {
+		options = append(options, "TRANSIENT")
+	}
+	if o.hasKeyword("WITH MANAGED ACCESS") {
+		options = append(options, "MANAGED ACCESS")
+	}
+	return strings.Join(options, ", ")
+}
+
+func ownerRoleType(o *object) driver.Value {
+	if o.owner == "" {
+		return ""
+	}
+	return "ROLE"
+}
+
+func onOff(value string) string {
+	if strings.EqualFold(value, "TRUE") {
+		return "ON"
+	}
+	return "OFF"
+}
+
+// objectProperties are the properties of the objects which are not the parameters (see SHOW PARAMETERS).
+var objectProperties = map[string][]string{
+	databaseType:  {"COMMENT"},
+	schemaType:    {"COMMENT"},
+	roleType:      {"COMMENT"},
+	warehouseType: {"COMMENT", "WAREHOUSE_TYPE", "WAREHOUSE_SIZE", "MAX_CLUSTER_COUNT", "MIN_CLUSTER_COUNT", "SCALING_POLICY", "AUTO_SUSPEND", "AUTO_RESUME", "INITIALLY_SUSPENDED", "RESOURCE_MONITOR", "ENABLE_QUERY_ACCELERATION", "QUERY_ACCELERATION_MAX_SCALE_FACTOR", "RESOURCE_CONSTRAINT"},
+	tableType:     {"COMMENT", "CHANGE_TRACKING", "ENABLE_SCHEMA_EVOLUTION", "STAGE_FILE_FORMAT", "STAGE_COPY_OPTIONS"},
+}
+
+func isParameter(objectType string, key string) bool {
+	return !strings.Contains(key, " ") && key != "TRANSIENT" && !slices.Contains(objectProperties[objectType], key)
+}
+
+// parameterValue returns the value of the parameter set on the object or inherited from its parents, or the default value.
+func (e *Emulator) parameterValue(o *object, key string, defaultValue string) string {
+	if value, _, ok := e.parameter(o, key); ok {
+		return value
+	}
+	return defaultValue
+}
+
+// parameter returns the value of the parameter set on the object or inherited from its parents, and the level it was set on.
+func (e *Emulator) parameter(o *object, key string) (string, string, bool) {
+	for current := o; current != nil; current = e.parent(current) {
+		if value, ok := current.property(key); ok {
+			return value, current.objectType, true
+		}
+	}
+	return "", "", false
+}
+
+func (e *Emulator) parent(o *object) *object {
+	parentType := objectTypes[o.objectType].parentType
+	if parentType == "" {
+		return nil
+	}
+	parent, _ := e.get(parentType, o.name[:len(o.name)-1])
+	return parent
+}
+
+// showParameters returns the parameters set on the object or inherited from its parents. Contrary to Snowflake,
+// the parameters that were not set are not returned.
+func (e *Emulator) showParameters(p *parser) (*result, error) {
+	var like string
+	if p.acceptWords("LIKE") {
+		pattern, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		like = pattern
+	}
+	if err := p.expectWords("IN"); err != nil {
+		return nil, errUnsupported
+	}
+	objectType, err := p.objectType()
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.objectName(objectType)
+	if err != nil {
+		return nil, err
+	}
+	o, ok := e.get(objectType, name)
+	if !ok {
+		return nil, notExistError(objectType, name)
+	}
+
+	var keys []string
+	for current := o; current != nil; current = e.parent(current) {
+		for key := range current.properties {
+			if isParameter(current.objectType, key) && !slices.Contains(keys, key) && (like == "" || likePattern(like).MatchString(key)) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	slices.Sort(keys)
+	r := &result{columns: []string{"key", "value", "default", "level", "description", "type"}}
+	for _, key := range keys {
+		value, level, _ := e.parameter(o, key)
+		r.rows = append(r.rows, []driver.Value{key, value, "", level, "", "STRING"})
+	}
+	return r, nil
+}
+
+func (e *Emulator) describe(p *parser) (*result, error) {
+	if !p.acceptWords("DESCRIBE") && !p.acceptWords("DESC") {
+		return nil, errUnsupported
+	}
+	objectType, err := p.objectType()
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.objectName(objectType)
+	if err != nil {
+		return nil, err
+	}
+	o, ok := e.get(objectType, name)
+	if !ok {
+		return nil, notExistError(objectType, name)
+	}
+
+	r := &result{columns: []string{"created_on", "name", "kind"}}
+	switch objectType {
+	case databaseType, schemaType:
+		children := e.children(o)
+		slices.SortFunc(children, func(a, b *object) int { return slices.Compare(a.name, b.name) })
+		for _, child := range children {
+			if len(child.name) == len(name)+1 {
+				r.rows = append(r.rows, []driver.Value{child.createdOn, child.name[len(child.name)-1], child.objectType})
+			}
+		}
+	case warehouseType:
+		r.rows = append(r.rows, []driver.Value{o.createdOn, name[0], warehouseType})
+	case tableType:
+		describeType := "COLUMNS"
+		if p.acceptWords("TYPE") && p.acceptSymbol("=") {
+			describeType = p.peekWord()
+		}
+		if describeType != "COLUMNS" {
+			return nil, errUnsupported
+		}
+		return describeColumns(o), nil
+	default:
+		return nil, errUnsupported
+	}
+	return r, nil
+}
+
+func describeColumns(o *object) *result {
+	r := &result{columns: []string{"name", "type", "kind", "null?", "default", "primary key", "unique key", "check", "expression", "comment", "policy name", "privacy domain", "schema evolution record"}}
+	for _, c := range o.columns {
+		var defaultValue, comment driver.Value
+		if c.defaultValue != nil {
+			defaultValue = *c.defaultValue
+		}
+		if c.comment != nil {
+			comment = *c.comment
+		}
+		r.rows = append(r.rows, []driver.Value{c.name, c.dataType, "COLUMN", yesNo(c.nullable), defaultValue, yesNo(c.primaryKey), yesNo(c.unique), nil, nil, comment, nil, nil, nil})
+	}
+	return r
+}
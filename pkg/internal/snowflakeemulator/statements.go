@@ -0,0 +1,474 @@
+package snowflakeemulator
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// createModifiers are the words that can precede the object type in CREATE; only TRANSIENT is kept as the property.
+var createModifiers = []string{"TRANSIENT", "TEMPORARY", "TEMP", "VOLATILE", "LOCAL", "GLOBAL"}
+
+func (e *Emulator) create(s *session, p *parser) (*result, error) {
+	if err := p.expectWords("CREATE"); err != nil {
+		return nil, err
+	}
+	orReplace := p.acceptWords("OR", "REPLACE")
+	var modifiers []string
+	for slices.Contains(createModifiers, p.peekWord()) {
+		modifiers = append(modifiers, p.peekWord())
+		p.pos++
+	}
+	objectType, err := p.objectType()
+	if err != nil {
+		return nil, err
+	}
+	ifNotExists := p.acceptWords("IF", "NOT", "EXISTS")
+	name, err := p.objectName(objectType)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains([]string{"CLONE", "AS", "LIKE", "USING", "FROM"}, p.peekWord()) {
+		return nil, errUnsupported
+	}
+
+	o := newObject(objectType, name, s.role, e.now())
+	if objectType == tableType {
+		if o.columns, err = p.columns(); err != nil {
+			return nil, err
+		}
+	}
+	if o.properties, err = p.properties(); err != nil {
+		return nil, err
+	}
+	if slices.Contains(modifiers, "TRANSIENT") {
+		o.properties["TRANSIENT"] = ""
+	}
+	if objectType == warehouseType {
+		o.suspended = strings.EqualFold(o.properties["INITIALLY_SUSPENDED"], "TRUE")
+	}
+
+	if parentType := objectTypes[objectType].parentType; parentType != "" {
+		parentName := name[:len(name)-1]
+		if _, ok := e.get(parentType, parentName); !ok {
+			return nil, notExistError(parentType, parentName)
+		}
+	}
+	if existing, ok := e.get(objectType, name); ok {
+		switch {
+		case ifNotExists:
+			return statusResult(fmt.Sprintf("%s already exists, statement succeeded.", displayName(name))), nil
+		case orReplace:
+			e.remove(existing)
+		default:
+			return nil, alreadyExistsError(name)
+		}
+	}
+	e.put(o)
+	if objectType == databaseType {
+		e.put(newObject(schemaType, []string{name[0], "PUBLIC"}, s.role, o.createdOn))
+	}
+	return statusResult(fmt.Sprintf("%s %s successfully created.", objectTypeDisplayName(objectType), displayName(name))), nil
+}
+
+// columnConstraintWords end the data type in the column definition.
+var columnConstraintWords = []string{"COMMENT", "NOT", "NULL", "DEFAULT", "COLLATE", "PRIMARY", "UNIQUE", "IDENTITY", "AUTOINCREMENT", "MASKING", "WITH", "CONSTRAINT", "FOREIGN", "REFERENCES", "PROJECTION"}
+
+// columns reads the column definitions of the table. The out-of-line constraints are skipped.
+func (p *parser) columns() ([]*column, error) {
+	items, err := p.groupTokens()
+	if err != nil {
+		return nil, err
+	}
+	var columns []*column
+	for _, item := range items {
+		if len(item) == 0 {
+			continue
+		}
+		if item[0].kind == wordToken && slices.Contains([]string{"CONSTRAINT", "PRIMARY", "UNIQUE", "FOREIGN"}, strings.ToUpper(item[0].value)) {
+			continue
+		}
+		c, err := parseColumn(item)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, c)
+	}
+	return columns, nil
+}
+
+func parseColumn(tokens []token) (*column, error) {
+	p := &parser{tokens: tokens}
+	name, err := p.identifier()
+	if err != nil {
+		return nil, err
+	}
+	c := &column{name: name[0], nullable: true}
+	var dataType []string
+	for !p.done() && !slices.Contains(columnConstraintWords, p.peekWord()) {
+		t, _ := p.next()
+		dataType = append(dataType, t.text())
+	}
+	if len(dataType) == 0 {
+		return nil, fmt.Errorf("missing data type of column %s", c.name)
+	}
+	c.dataType = normalizeDataType(strings.Join(dataType, " "))
+	for !p.done() {
+		switch {
+		case p.acceptWords("COMMENT"):
+			comment, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			c.comment = &comment
+		case p.acceptWords("NOT", "NULL"):
+			c.nullable = false
+		case p.acceptWords("NULL"):
+			c.nullable = true
+		case p.acceptWords("DEFAULT"):
+			t, _ := p.next()
+			value := t.text()
+			if p.acceptSymbol("(") {
+				// e.g. DEFAULT CURRENT_TIMESTAMP()
+				p.pos--
+				group, err := p.group()
+				if err != nil {
+					return nil, err
+				}
+				value += group
+			}
+			c.defaultValue = &value
+		case p.acceptWords("PRIMARY", "KEY"):
+			c.primaryKey = true
+			c.nullable = false
+		case p.acceptWords("UNIQUE"):
+			c.unique = true
+		default:
+			// the other clauses (e.g. COLLATE or MASKING POLICY) are not emulated
+			p.pos++
+		}
+	}
+	return c, nil
+}
+
+// normalizeDataType returns the data type in the form shown by DESCRIBE TABLE, e.g. NUMBER(38,0) for INT.
+func normalizeDataType(dataType string) string {
+	dataType = strings.ReplaceAll(strings.ToUpper(dataType), " ", "")
+	switch dataType {
+	case "NUMBER", "NUMERIC", "DECIMAL", "INT", "INTEGER", "BIGINT", "SMALLINT", "TINYINT", "BYTEINT":
+		return "NUMBER(38,0)"
+	case "VARCHAR", "STRING", "TEXT", "NVARCHAR", "NVARCHAR2", "CHARVARYING", "NCHARVARYING":
+		return "VARCHAR(16777216)"
+	case "CHAR", "CHARACTER", "NCHAR":
+		return "VARCHAR(1)"
+	case "FLOAT", "FLOAT4", "FLOAT8", "DOUBLE", "DOUBLEPRECISION", "REAL":
+		return "FLOAT"
+	case "BINARY", "VARBINARY":
+		return "BINARY(8388608)"
+	case "TIMESTAMP", "DATETIME", "TIMESTAMP_NTZ":
+		return "TIMESTAMP_NTZ(9)"
+	case "TIMESTAMP_LTZ":
+		return "TIMESTAMP_LTZ(9)"
+	case "TIMESTAMP_TZ":
+		return "TIMESTAMP_TZ(9)"
+	case "TIME":
+		return "TIME(9)"
+	}
+	for _, prefix := range []string{"NUMBER", "NUMERIC", "DECIMAL"} {
+		if precision, ok := strings.CutPrefix(dataType, prefix+"("); ok {
+			if !strings.Contains(precision, ",") {
+				return "NUMBER(" + strings.TrimSuffix(precision, ")") + ",0)"
+			}
+			return "NUMBER(" + precision
+		}
+	}
+	for _, prefix := range []string{"STRING", "TEXT", "NVARCHAR", "CHAR", "CHARACTER"} {
+		if length, ok := strings.CutPrefix(dataType, prefix+"("); ok {
+			return "VARCHAR(" + length
+		}
+	}
+	return dataType
+}
+
+func (e *Emulator) alter(s *session, p *parser) (*result, error) {
+	if err := p.expectWords("ALTER"); err != nil {
+		return nil, err
+	}
+	objectType, err := p.objectType()
+	if err != nil {
+		return nil, err
+	}
+	ifExists := p.acceptWords("IF", "EXISTS")
+	name, err := p.objectName(objectType)
+	if err != nil {
+		return nil, err
+	}
+	o, ok := e.get(objectType, name)
+	if !ok {
+		if ifExists {
+			return statusResult("Statement executed successfully."), nil
+		}
+		return nil, notExistError(objectType, name)
+	}
+
+	switch {
+	case p.acceptWords("SET"):
+		if objectType == tableType && p.peekWord() == "DATA" {
+			return nil, errUnsupported
+		}
+		properties, err := p.properties()
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range properties {
+			o.properties[key] = value
+		}
+	case p.acceptWords("UNSET"):
+		for _, key := range p.names() {
+			delete(o.properties, key)
+		}
+	case p.acceptWords("RENAME", "TO"):
+		newName, err := p.objectName(objectType)
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := e.get(objectType, newName); exists {
+			return nil, alreadyExistsError(newName)
+		}
+		if parentType := objectTypes[objectType].parentType; parentType != "" {
+			if _, ok := e.get(parentType, newName[:len(newName)-1]); !ok {
+				return nil, notExistError(parentType, newName[:len(newName)-1])
+			}
+		}
+		e.rename(o, newName)
+	case objectType == warehouseType && p.acceptWords("SUSPEND"):
+		o.suspended = true
+	case objectType == warehouseType && p.acceptWords("RESUME"):
+		o.suspended = false
+	case objectType == schemaType && p.acceptWords("ENABLE", "MANAGED", "ACCESS"):
+		o.properties["WITH MANAGED ACCESS"] = ""
+	case objectType == schemaType && p.acceptWords("DISABLE", "MANAGED", "ACCESS"):
+		delete(o.properties, "WITH MANAGED ACCESS")
+	case objectType == tableType:
+		if err := alterTableColumns(o, p); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errUnsupported
+	}
+	return statusResult("Statement executed successfully."), nil
+}
+
+// alterTableColumns handles the changes of the table columns: ADD, DROP, RENAME, and ALTER/MODIFY with COMMENT or NOT NULL.
+func alterTableColumns(o *object, p *parser) error {
+	findColumn := func() (int, error) {
+		name, err := p.identifier()
+		if err != nil {
+			return 0, err
+		}
+		i := slices.IndexFunc(o.columns, func(c *column) bool { return c.name == name[0] })
+		if i < 0 {
+			return 0, fmt.Errorf("invalid identifier '%s'", name[0])
+		}
+		return i, nil
+	}
+	switch {
+	case p.acceptWords("ADD"):
+		p.acceptWords("COLUMN")
+		p.acceptWords("IF", "NOT", "EXISTS")
+		c, err := parseColumn(p.tokens[p.pos:])
+		if err != nil {
+			return err
+		}
+		p.pos = len(p.tokens)
+		o.columns = append(o.columns, c)
+	case p.acceptWords("DROP"):
+		p.acceptWords("COLUMN")
+		p.acceptWords("IF", "EXISTS")
+		for {
+			i, err := findColumn()
+			if err != nil {
+				return err
+			}
+			o.columns = slices.Delete(o.columns, i, i+1)
+			if !p.acceptSymbol(",") {
+				return nil
+			}
+		}
+	case p.acceptWords("RENAME", "COLUMN"):
+		i, err := findColumn()
+		if err != nil {
+			return err
+		}
+		if err := p.expectWords("TO"); err != nil {
+			return err
+		}
+		newName, err := p.identifier()
+		if err != nil {
+			return err
+		}
+		o.columns[i].name = newName[0]
+	case p.acceptWords("ALTER"), p.acceptWords("MODIFY"):
+		p.acceptWords("COLUMN")
+		i, err := findColumn()
+		if err != nil {
+			return err
+		}
+		switch {
+		case p.acceptWords("COMMENT"):
+			comment, err := p.value()
+			if err != nil {
+				return err
+			}
+			o.columns[i].comment = &comment
+		case p.acceptWords("UNSET", "COMMENT"):
+			o.columns[i].comment = nil
+		case p.acceptWords("SET", "NOT", "NULL"):
+			o.columns[i].nullable = false
+		case p.acceptWords("DROP", "NOT", "NULL"):
+			o.columns[i].nullable = true
+		case p.acceptWords("DROP", "DEFAULT"):
+			o.columns[i].defaultValue = nil
+		default:
+			return errUnsupported
+		}
+	default:
+		return errUnsupported
+	}
+	return nil
+}
+
+func (e *Emulator) drop(p *parser) (*result, error) {
+	if err := p.expectWords("DROP"); err != nil {
+		return nil, err
+	}
+	objectType, err := p.objectType()
+	if err != nil {
+		return nil, err
+	}
+	ifExists := p.acceptWords("IF", "EXISTS")
+	name, err := p.objectName(objectType)
+	if err != nil {
+		return nil, err
+	}
+	o, ok := e.get(objectType, name)
+	if !ok {
+		if ifExists {
+			return statusResult(fmt.Sprintf("%s successfully dropped.", displayName(name))), nil
+		}
+		return nil, notExistError(objectType, name)
+	}
+	e.remove(o)
+	return statusResult(fmt.Sprintf("%s successfully dropped.", displayName(name))), nil
+}
+
+func (e *Emulator) use(s *session, p *parser) (*result, error) {
+	if err := p.expectWords("USE"); err != nil {
+		return nil, err
+	}
+	if p.acceptWords("SECONDARY", "ROLES") {
+		value := strings.Join(p.names(), ",")
+		if value == "NONE" {
+			value = ""
+		}
+		s.secondaryRoles = value
+		return statusResult("Statement executed successfully."), nil
+	}
+	objectType, err := p.objectType()
+	if err != nil {
+		return nil, err
+	}
+	name, err := p.objectName(objectType)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := e.get(objectType, name); !ok {
+		return nil, notExistError(objectType, name)
+	}
+	switch objectType {
+	case roleType:
+		s.role = name[0]
+	case warehouseType:
+		s.warehouse = name[0]
+	case databaseType:
+		s.database, s.schema = name[0], "PUBLIC"
+	case schemaType:
+		s.database, s.schema = name[0], name[1]
+	default:
+		return nil, errUnsupported
+	}
+	return statusResult("Statement executed successfully."), nil
+}
+
+// selectContextFunctions answers the queries selecting the context functions, e.g. SELECT CURRENT_ROLE() as CURRENT_ROLE.
+func (e *Emulator) selectContextFunctions(s *session, p *parser) (*result, error) {
+	if err := p.expectWords("SELECT"); err != nil {
+		return nil, err
+	}
+	r := &result{rows: [][]driver.Value{{}}}
+	for {
+		t, ok := p.next()
+		if !ok || t.kind != wordToken || !p.acceptSymbol("(") || !p.acceptSymbol(")") {
+			return nil, errUnsupported
+		}
+		function := strings.ToUpper(t.value)
+		value, err := s.contextFunction(function)
+		if err != nil {
+			return nil, err
+		}
+		columnName := function + "()"
+		if p.acceptWords("AS") {
+			alias, err := p.identifier()
+			if err != nil {
+				return nil, err
+			}
+			columnName = alias[0]
+		}
+		r.columns = append(r.columns, columnName)
+		r.rows[0] = append(r.rows[0], value)
+		if !p.acceptSymbol(",") {
+			break
+		}
+	}
+	if !p.done() {
+		return nil, errUnsupported
+	}
+	return r, nil
+}
+
+func (s *session) contextFunction(function string) (driver.Value, error) {
+	nullable := func(value string) driver.Value {
+		if value == "" {
+			return nil
+		}
+		return value
+	}
+	switch function {
+	case "CURRENT_ACCOUNT", "CURRENT_ACCOUNT_NAME":
+		return defaultAccountLocator, nil
+	case "CURRENT_ORGANIZATION_NAME":
+		return defaultAccountLocator + "_ORGANIZATION", nil
+	case "CURRENT_REGION":
+		return "AWS_US_WEST_2", nil
+	case "CURRENT_USER":
+		return defaultUser, nil
+	case "CURRENT_SESSION":
+		return strconv.Itoa(s.id), nil
+	case "CURRENT_ROLE":
+		return s.role, nil
+	case "CURRENT_SECONDARY_ROLES":
+		value, err := json.Marshal(map[string]string{"roles": "", "value": s.secondaryRoles})
+		return string(value), err
+	case "CURRENT_WAREHOUSE":
+		return nullable(s.warehouse), nil
+	case "CURRENT_DATABASE":
+		return nullable(s.database), nil
+	case "CURRENT_SCHEMA":
+		return nullable(s.schema), nil
+	default:
+		return nil, errUnsupported
+	}
+}
@@ -47,6 +47,15 @@ const (
 	DisableConsoleLogin                = "SNOWFLAKE_DISABLE_CONSOLE_LOGIN"
 	SkipTomlFilePermissionVerification = "SNOWFLAKE_SKIP_TOML_FILE_PERMISSION_VERIFICATION"
 	UseLegacyTomlFile                  = "SNOWFLAKE_USE_LEGACY_TOML_FILE"
+	SqlPreview                         = "SNOWFLAKE_SQL_PREVIEW"
+	CacheShowResults                   = "SNOWFLAKE_CACHE_SHOW_RESULTS"
+	TransientErrorsMaxRetries          = "SNOWFLAKE_TRANSIENT_ERRORS_MAX_RETRIES"
+	UsageTrackingWorkspace             = "SNOWFLAKE_USAGE_TRACKING_WORKSPACE"
+	UsageTrackingModuleAddress         = "SNOWFLAKE_USAGE_TRACKING_MODULE_ADDRESS"
+	UsageTrackingAsQueryTag            = "SNOWFLAKE_USAGE_TRACKING_AS_QUERY_TAG"
+	TracingExporter                    = "SNOWFLAKE_TRACING_EXPORTER"
+	TracingOtlpEndpoint                = "SNOWFLAKE_TRACING_OTLP_ENDPOINT"
+	TracingFilePath                    = "SNOWFLAKE_TRACING_FILE_PATH"
 
 	ConfigPath = "SNOWFLAKE_CONFIG_PATH"
 )
@@ -7,6 +7,8 @@ var (
 	Accountadmin   = sdk.NewAccountObjectIdentifier("ACCOUNTADMIN")
 	SecurityAdmin  = sdk.NewAccountObjectIdentifier("SECURITYADMIN")
 	PentestingRole = sdk.NewAccountObjectIdentifier("PENTESTING_ROLE")
+	// Public is implicitly granted to every user and account role.
+	Public = sdk.NewAccountObjectIdentifier("PUBLIC")
 
 	OktaProvisioner        = sdk.NewAccountObjectIdentifier("OKTA_PROVISIONER")
 	AadProvisioner         = sdk.NewAccountObjectIdentifier("AAD_PROVISIONER")
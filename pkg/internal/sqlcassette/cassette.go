@@ -0,0 +1,228 @@
+package sqlcassette
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/snowflakedb/gosnowflake"
+)
+
+type Mode string
+
+const (
+	// RecordMode runs the statements on the live connection and writes them with their results to the cassette.
+	RecordMode Mode = "record"
+	// ReplayMode serves the statements from the cassette without connecting to Snowflake.
+	ReplayMode Mode = "replay"
+)
+
+var AllModes = []Mode{
+	RecordMode,
+	ReplayMode,
+}
+
+func ToMode(s string) (Mode, error) {
+	lowerCase := strings.ToLower(s)
+	switch lowerCase {
+	case string(RecordMode),
+		string(ReplayMode):
+		return Mode(lowerCase), nil
+	default:
+		return "", fmt.Errorf("invalid sql cassette mode: %s", s)
+	}
+}
+
+const (
+	execKind        = "exec"
+	queryKind       = "query"
+	transactionKind = "transaction"
+)
+
+// Interaction is a single statement run on the connection, stored as a line of the cassette file.
+type Interaction struct {
+	Connection   string      `json:"connection"`
+	Kind         string      `json:"kind"`
+	Query        string      `json:"query"`
+	Args         []Value     `json:"args,omitempty"`
+	ResultSets   []ResultSet `json:"result_sets,omitempty"`
+	RowsAffected int64       `json:"rows_affected,omitempty"`
+	Error        *Error      `json:"error,omitempty"`
+}
+
+type ResultSet struct {
+	Columns []string  `json:"columns"`
+	Rows    [][]Value `json:"rows"`
+}
+
+// Error is the recorded error. The Snowflake errors are replayed as *gosnowflake.SnowflakeError, so that they are classified the same way as the live ones.
+type Error struct {
+	Number   int    `json:"number,omitempty"`
+	SQLState string `json:"sql_state,omitempty"`
+	QueryID  string `json:"query_id,omitempty"`
+	Message  string `json:"message"`
+}
+
+func newError(err error) *Error {
+	if err == nil {
+		return nil
+	}
+	var snowflakeError *gosnowflake.SnowflakeError
+	if errors.As(err, &snowflakeError) {
+		return &Error{
+			Number:   snowflakeError.Number,
+			SQLState: snowflakeError.SQLState,
+			QueryID:  snowflakeError.QueryID,
+			Message:  snowflakeError.Message,
+		}
+	}
+	return &Error{Message: err.Error()}
+}
+
+func (e *Error) err() error {
+	if e == nil {
+		return nil
+	}
+	if e.Number != 0 || e.SQLState != "" {
+		return &gosnowflake.SnowflakeError{
+			Number:   e.Number,
+			SQLState: e.SQLState,
+			QueryID:  e.QueryID,
+			Message:  e.Message,
+		}
+	}
+	return errors.New(e.Message)
+}
+
+// Cassette records the statements to, or replays them from, the file with JSON lines.
+//
+// The replayed interaction is the first not yet replayed one with the same connection, kind, query, and arguments, so the order
+// of the statements run in parallel does not matter. When all the matching interactions were already replayed, the last one is
+// replayed again (e.g. SELECT CURRENT_ACCOUNT() run by every new client).
+type Cassette struct {
+	mode           Mode
+	path           string
+	normalizeQuery func(string) string
+
+	mu           sync.Mutex
+	file         *os.File
+	interactions map[string][]Interaction
+	replayed     map[string]int
+}
+
+// New opens the cassette at the given path. In the record mode the file is truncated. The queries are matched after normalizeQuery
+// (e.g. removing the parts that change between the runs), and they are recorded in the normalized form.
+func New(mode Mode, path string, normalizeQuery func(string) string) (*Cassette, error) {
+	if path == "" {
+		return nil, errors.New("sql cassette path is required")
+	}
+	if normalizeQuery == nil {
+		normalizeQuery = func(query string) string { return query }
+	}
+	cassette := &Cassette{
+		mode:           mode,
+		path:           path,
+		normalizeQuery: normalizeQuery,
+		interactions:   make(map[string][]Interaction),
+		replayed:       make(map[string]int),
+	}
+	switch mode {
+	case RecordMode:
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("create sql cassette %s: %w", path, err)
+		}
+		cassette.file = file
+	case ReplayMode:
+		if err := cassette.load(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("invalid sql cassette mode: %s", mode)
+	}
+	return cassette, nil
+}
+
+func (c *Cassette) Mode() Mode {
+	return c.mode
+}
+
+// Close closes the file of the recorded cassette.
+func (c *Cassette) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.file == nil {
+		return nil
+	}
+	err := c.file.Close()
+	c.file = nil
+	return err
+}
+
+func (c *Cassette) load() error {
+	file, err := os.Open(c.path)
+	if err != nil {
+		return fmt.Errorf("open sql cassette %s: %w", c.path, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(strings.TrimSpace(scanner.Text())) == 0 {
+			continue
+		}
+		var interaction Interaction
+		if err := json.Unmarshal(scanner.Bytes(), &interaction); err != nil {
+			return fmt.Errorf("parse line %d of sql cassette %s: %w", line, c.path, err)
+		}
+		key := interactionKey(interaction.Connection, interaction.Kind, interaction.Query, interaction.Args)
+		c.interactions[key] = append(c.interactions[key], interaction)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read sql cassette %s: %w", c.path, err)
+	}
+	return nil
+}
+
+func (c *Cassette) record(interaction Interaction) error {
+	interaction.Query = c.normalizeQuery(interaction.Query)
+	line, err := json.Marshal(interaction)
+	if err != nil {
+		return fmt.Errorf("marshal sql cassette interaction: %w", err)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.file == nil {
+		return fmt.Errorf("sql cassette %s is closed", c.path)
+	}
+	if _, err := c.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("write sql cassette %s: %w", c.path, err)
+	}
+	return nil
+}
+
+func (c *Cassette) replay(connection string, kind string, query string, args []Value) (Interaction, error) {
+	query = c.normalizeQuery(query)
+	key := interactionKey(connection, kind, query, args)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	interactions := c.interactions[key]
+	if len(interactions) == 0 {
+		return Interaction{}, fmt.Errorf("no %s of %q for connection %s recorded in sql cassette %s", kind, query, connection, c.path)
+	}
+	i := min(c.replayed[key], len(interactions)-1)
+	c.replayed[key] = i + 1
+	return interactions[i], nil
+}
+
+func interactionKey(connection string, kind string, query string, args []Value) string {
+	// the arguments are encoded the same way in both modes, so the marshaling error is not possible here
+	encodedArgs, _ := json.Marshal(args)
+	return strings.Join([]string{connection, kind, query, string(encodedArgs)}, "\x00")
+}
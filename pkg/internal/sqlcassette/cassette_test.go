@@ -0,0 +1,150 @@
+package sqlcassette
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// liveConnector returns the same row for every query, and the Snowflake error for the statements containing FAIL.
+type liveConnector struct {
+	statements []string
+}
+
+func (c *liveConnector) Connect(context.Context) (driver.Conn, error) {
+	return &liveConn{connector: c}, nil
+}
+func (c *liveConnector) Driver() driver.Driver { return nil }
+
+type liveConn struct {
+	connector *liveConnector
+}
+
+func (c *liveConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c *liveConn) Close() error                        { return nil }
+func (c *liveConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+func (c *liveConn) run(query string) error {
+	c.connector.statements = append(c.connector.statements, query)
+	if strings.Contains(query, "FAIL") {
+		return &gosnowflake.SnowflakeError{Number: 2003, SQLState: "02000", Message: "Object does not exist"}
+	}
+	return nil
+}
+
+func (c *liveConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	if err := c.run(query); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(3), nil
+}
+
+func (c *liveConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	if err := c.run(query); err != nil {
+		return nil, err
+	}
+	return &liveRows{}, nil
+}
+
+type liveRows struct {
+	done bool
+}
+
+func (r *liveRows) Columns() []string { return []string{"name", "count", "created_on", "comment"} }
+func (r *liveRows) Close() error      { return nil }
+
+func (r *liveRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	dest[0] = "TABLE"
+	dest[1] = int64(42)
+	dest[2] = time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	dest[3] = nil
+	r.done = true
+	return nil
+}
+
+type row struct {
+	Name      string
+	Count     int64
+	CreatedOn time.Time
+	Comment   sql.NullString
+}
+
+func runStatements(t *testing.T, db *sql.DB) (row, sql.Result, error) {
+	t.Helper()
+	var r row
+	require.NoError(t, db.QueryRow(`SHOW TABLES --metadata {"version": "v1"}`).Scan(&r.Name, &r.Count, &r.CreatedOn, &r.Comment))
+	result, err := db.Exec(`DROP TABLE "T"`)
+	require.NoError(t, err)
+	_, err = db.Exec(`DROP TABLE "FAIL"`)
+	return r, result, err
+}
+
+func Test_Cassette(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.jsonl")
+	normalizeQuery := func(query string) string { return strings.Split(query, " --metadata")[0] }
+
+	recording, err := New(RecordMode, path, normalizeQuery)
+	require.NoError(t, err)
+	live := &liveConnector{}
+	recordedRow, recordedResult, recordedErr := runStatements(t, sql.OpenDB(recording.Connector("ACCOUNT.USER", live)))
+	require.NoError(t, recording.Close())
+	require.Len(t, live.statements, 3)
+
+	replaying, err := New(ReplayMode, path, normalizeQuery)
+	require.NoError(t, err)
+	replayedRow, replayedResult, replayedErr := runStatements(t, sql.OpenDB(replaying.Connector("ACCOUNT.USER", nil)))
+
+	assert.Equal(t, recordedRow, replayedRow)
+	assert.Equal(t, row{Name: "TABLE", Count: 42, CreatedOn: time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)}, replayedRow)
+	rowsAffected, err := replayedResult.RowsAffected()
+	require.NoError(t, err)
+	recordedRowsAffected, err := recordedResult.RowsAffected()
+	require.NoError(t, err)
+	assert.Equal(t, recordedRowsAffected, rowsAffected)
+
+	var snowflakeError *gosnowflake.SnowflakeError
+	require.ErrorAs(t, replayedErr, &snowflakeError)
+	assert.Equal(t, 2003, snowflakeError.Number)
+	assert.Equal(t, recordedErr.Error(), replayedErr.Error())
+
+	t.Run("statement not recorded", func(t *testing.T) {
+		_, err := sql.OpenDB(replaying.Connector("ACCOUNT.USER", nil)).Exec(`DROP TABLE "OTHER"`)
+		require.ErrorContains(t, err, `no exec of "DROP TABLE \"OTHER\"" for connection ACCOUNT.USER recorded`)
+	})
+
+	t.Run("statement recorded for another connection", func(t *testing.T) {
+		_, err := sql.OpenDB(replaying.Connector("SECONDARY.USER", nil)).Exec(`DROP TABLE "T"`)
+		require.ErrorContains(t, err, "for connection SECONDARY.USER recorded")
+	})
+}
+
+func Test_ToMode(t *testing.T) {
+	for _, valid := range []string{"record", "REPLAY"} {
+		t.Run(valid, func(t *testing.T) {
+			_, err := ToMode(valid)
+			require.NoError(t, err)
+		})
+	}
+
+	_, err := ToMode("live")
+	require.ErrorContains(t, err, "invalid sql cassette mode: live")
+}
+
+func Test_New_replayWithoutCassette(t *testing.T) {
+	_, err := New(ReplayMode, filepath.Join(t.TempDir(), "missing.jsonl"), nil)
+
+	require.ErrorContains(t, err, "open sql cassette")
+}
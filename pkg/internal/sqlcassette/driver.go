@@ -0,0 +1,287 @@
+package sqlcassette
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"log"
+)
+
+// Connector returns the connector that records the statements run on the connections of the live connector, or replays them
+// (the live connector is not used then). The connection name distinguishes the statements run by different clients in the same
+// cassette (e.g. the same SELECT CURRENT_ACCOUNT() run for the primary and the secondary account).
+func (c *Cassette) Connector(connection string, live driver.Connector) driver.Connector {
+	return &connector{cassette: c, connection: connection, live: live}
+}
+
+type connector struct {
+	cassette   *Cassette
+	connection string
+	live       driver.Connector
+}
+
+func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
+	if c.cassette.mode == ReplayMode {
+		return &conn{connector: c}, nil
+	}
+	liveConn, err := c.live.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &conn{connector: c, live: liveConn}, nil
+}
+
+func (c *connector) Driver() driver.Driver {
+	if c.live == nil {
+		return nil
+	}
+	return c.live.Driver()
+}
+
+// conn records the statements run on the live connection, or replays them when the live connection is not set.
+type conn struct {
+	connector *connector
+	live      driver.Conn
+}
+
+var (
+	_ driver.ExecerContext      = new(conn)
+	_ driver.QueryerContext     = new(conn)
+	_ driver.ConnBeginTx        = new(conn)
+	_ driver.Pinger             = new(conn)
+	_ driver.NamedValueChecker  = new(conn)
+	_ driver.RowsNextResultSet  = new(rows)
+	_ driver.ConnPrepareContext = new(conn)
+)
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+func (c *conn) PrepareContext(context.Context, string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported by the sql cassette")
+}
+
+func (c *conn) Close() error {
+	if c.live == nil {
+		return nil
+	}
+	return c.live.Close()
+}
+
+func (c *conn) Ping(ctx context.Context) error {
+	if pinger, ok := c.live.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+// CheckNamedValue accepts all the values in the replay mode; they are recorded with their types anyway.
+func (c *conn) CheckNamedValue(value *driver.NamedValue) error {
+	if checker, ok := c.live.(driver.NamedValueChecker); ok {
+		return checker.CheckNamedValue(value)
+	}
+	if c.live == nil {
+		return nil
+	}
+	return driver.ErrSkip
+}
+
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if c.live == nil {
+		interaction, err := c.connector.cassette.replay(c.connector.connection, execKind, query, newArgs(args))
+		if err != nil {
+			return nil, err
+		}
+		if interaction.Error != nil {
+			return nil, interaction.Error.err()
+		}
+		return driver.RowsAffected(interaction.RowsAffected), nil
+	}
+
+	execer, ok := c.live.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	result, err := execer.ExecContext(ctx, query, args)
+	interaction := Interaction{Kind: execKind, Query: query, Args: newArgs(args), Error: newError(err)}
+	if err == nil {
+		// the error is ignored, because the driver does not report the number of rows for all the statements
+		interaction.RowsAffected, _ = result.RowsAffected()
+	}
+	c.record(interaction, err)
+	return result, err
+}
+
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if c.live == nil {
+		interaction, err := c.connector.cassette.replay(c.connector.connection, queryKind, query, newArgs(args))
+		if err != nil {
+			return nil, err
+		}
+		if interaction.Error != nil {
+			return nil, interaction.Error.err()
+		}
+		return &rows{resultSets: interaction.ResultSets}, nil
+	}
+
+	queryer, ok := c.live.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	liveRows, err := queryer.QueryContext(ctx, query, args)
+	var resultSets []ResultSet
+	if err == nil {
+		resultSets, err = readResultSets(liveRows)
+	}
+	c.record(Interaction{Kind: queryKind, Query: query, Args: newArgs(args), ResultSets: resultSets, Error: newError(err)}, err)
+	if err != nil {
+		return nil, err
+	}
+	return &rows{resultSets: resultSets}, nil
+}
+
+func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if c.live == nil {
+		if err := c.replayTransaction("BEGIN"); err != nil {
+			return nil, err
+		}
+		return &tx{conn: c}, nil
+	}
+
+	var liveTx driver.Tx
+	var err error
+	if beginner, ok := c.live.(driver.ConnBeginTx); ok {
+		liveTx, err = beginner.BeginTx(ctx, opts)
+	} else {
+		liveTx, err = c.live.Begin() //nolint:staticcheck
+	}
+	c.record(Interaction{Kind: transactionKind, Query: "BEGIN", Error: newError(err)}, err)
+	if err != nil {
+		return nil, err
+	}
+	return &tx{conn: c, live: liveTx}, nil
+}
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *conn) replayTransaction(operation string) error {
+	interaction, err := c.connector.cassette.replay(c.connector.connection, transactionKind, operation, nil)
+	if err != nil {
+		return err
+	}
+	return interaction.Error.err()
+}
+
+// record writes the interaction to the cassette. The bad connections are not recorded, because database/sql retries them on another connection.
+func (c *conn) record(interaction Interaction, err error) {
+	if errors.Is(err, driver.ErrBadConn) {
+		return
+	}
+	interaction.Connection = c.connector.connection
+	if recordErr := c.connector.cassette.record(interaction); recordErr != nil {
+		log.Printf("[WARN] recording the statement failed: %v", recordErr)
+	}
+}
+
+type tx struct {
+	conn *conn
+	live driver.Tx
+}
+
+func (t *tx) Commit() error {
+	return t.end("COMMIT", func() error { return t.live.Commit() })
+}
+
+func (t *tx) Rollback() error {
+	return t.end("ROLLBACK", func() error { return t.live.Rollback() })
+}
+
+func (t *tx) end(operation string, live func() error) error {
+	if t.live == nil {
+		return t.conn.replayTransaction(operation)
+	}
+	err := live()
+	t.conn.record(Interaction{Kind: transactionKind, Query: operation, Error: newError(err)}, err)
+	return err
+}
+
+// readResultSets reads all the result sets of the live rows and closes them.
+func readResultSets(liveRows driver.Rows) (resultSets []ResultSet, err error) {
+	defer func() {
+		err = errors.Join(err, liveRows.Close())
+	}()
+	for {
+		resultSet := ResultSet{Columns: liveRows.Columns(), Rows: [][]Value{}}
+		dest := make([]driver.Value, len(resultSet.Columns))
+		for {
+			if err := liveRows.Next(dest); err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return nil, err
+			}
+			row := make([]Value, len(dest))
+			for i, value := range dest {
+				row[i] = newValue(value)
+			}
+			resultSet.Rows = append(resultSet.Rows, row)
+		}
+		resultSets = append(resultSets, resultSet)
+
+		multipleResultSets, ok := liveRows.(driver.RowsNextResultSet)
+		if !ok || !multipleResultSets.HasNextResultSet() {
+			return resultSets, nil
+		}
+		if err := multipleResultSets.NextResultSet(); err != nil {
+			if errors.Is(err, io.EOF) {
+				return resultSets, nil
+			}
+			return nil, err
+		}
+	}
+}
+
+// rows returns the recorded result sets.
+type rows struct {
+	resultSets []ResultSet
+	row        int
+}
+
+func (r *rows) Columns() []string {
+	if len(r.resultSets) == 0 {
+		return nil
+	}
+	return r.resultSets[0].Columns
+}
+
+func (r *rows) Close() error { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if len(r.resultSets) == 0 || r.row >= len(r.resultSets[0].Rows) {
+		return io.EOF
+	}
+	for i, value := range r.resultSets[0].Rows[r.row] {
+		driverValue, err := value.driverValue()
+		if err != nil {
+			return err
+		}
+		dest[i] = driverValue
+	}
+	r.row++
+	return nil
+}
+
+func (r *rows) HasNextResultSet() bool { return len(r.resultSets) > 1 }
+
+func (r *rows) NextResultSet() error {
+	if !r.HasNextResultSet() {
+		return io.EOF
+	}
+	r.resultSets = r.resultSets[1:]
+	r.row = 0
+	return nil
+}
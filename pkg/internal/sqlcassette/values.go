@@ -0,0 +1,78 @@
+package sqlcassette
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	nullValue   = "null"
+	stringValue = "string"
+	bytesValue  = "bytes"
+	intValue    = "int"
+	floatValue  = "float"
+	boolValue   = "bool"
+	timeValue   = "time"
+)
+
+// Value is the recorded driver.Value with its type, so that the replayed value is the same as the one returned by the driver.
+type Value struct {
+	Type  string `json:"type"`
+	Value string `json:"value,omitempty"`
+}
+
+func newValue(value driver.Value) Value {
+	switch v := value.(type) {
+	case nil:
+		return Value{Type: nullValue}
+	case string:
+		return Value{Type: stringValue, Value: v}
+	case []byte:
+		return Value{Type: bytesValue, Value: base64.StdEncoding.EncodeToString(v)}
+	case int64:
+		return Value{Type: intValue, Value: strconv.FormatInt(v, 10)}
+	case float64:
+		return Value{Type: floatValue, Value: strconv.FormatFloat(v, 'g', -1, 64)}
+	case bool:
+		return Value{Type: boolValue, Value: strconv.FormatBool(v)}
+	case time.Time:
+		return Value{Type: timeValue, Value: v.Format(time.RFC3339Nano)}
+	default:
+		return Value{Type: stringValue, Value: fmt.Sprintf("%v", v)}
+	}
+}
+
+func (v Value) driverValue() (driver.Value, error) {
+	switch v.Type {
+	case nullValue:
+		return nil, nil
+	case stringValue:
+		return v.Value, nil
+	case bytesValue:
+		return base64.StdEncoding.DecodeString(v.Value)
+	case intValue:
+		return strconv.ParseInt(v.Value, 10, 64)
+	case floatValue:
+		return strconv.ParseFloat(v.Value, 64)
+	case boolValue:
+		return strconv.ParseBool(v.Value)
+	case timeValue:
+		return time.Parse(time.RFC3339Nano, v.Value)
+	default:
+		return nil, fmt.Errorf("invalid sql cassette value type: %s", v.Type)
+	}
+}
+
+func newArgs(args []driver.NamedValue) []Value {
+	if len(args) == 0 {
+		return nil
+	}
+	values := make([]Value, len(args))
+	for i, arg := range args {
+		values[i] = newValue(arg.Value)
+	}
+	return values
+}
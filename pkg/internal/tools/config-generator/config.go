@@ -0,0 +1,21 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/hclgen"
+)
+
+// generateConfig returns the configuration containing all the given resources followed by the import blocks for them.
+func generateConfig(objects []generatedObject) string {
+	var sb strings.Builder
+	for _, object := range objects {
+		sb.WriteString(object.Resource.HCL())
+		sb.WriteString("\n")
+	}
+	for _, object := range objects {
+		sb.WriteString(hclgen.ImportBlock(object.Resource.Address(), object.ImportId))
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
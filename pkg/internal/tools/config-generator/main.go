@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/tools/toolclient"
+)
+
+/*
+This tool generates Terraform configuration for the objects already existing in a Snowflake account.
+It connects to Snowflake using the profile from the TOML config file (the same one that is used by the provider),
+lists the objects using SDK, and writes the resource definitions together with the import blocks for them.
+
+Currently supported objects:
+- databases (standard, secondary, and shared ones; application databases are skipped)
+- schemas (INFORMATION_SCHEMA is skipped) and database roles
+- masking policies, row access policies, tags, tasks, streams, and streamlits (in the standard databases)
+- warehouses
+- account roles (system-defined roles are skipped)
+- users (depending on the type: snowflake_user, snowflake_service_user, or snowflake_legacy_service_user)
+- resource monitors and network policies
+- SCIM and SAML2 security integrations
+
+The objects are listed with SHOW; DESCRIBE is called for every object with the fields not returned by SHOW (masking policies, row access policies,
+streamlits, network policies, and security integrations), so the generation takes longer on accounts with many such objects.
+The schema-level objects are listed with a single SHOW ... IN DATABASE for every object type.
+
+The other stable resources are not generated: views (the statement can not be reliably extracted from SHOW VIEWS), the security integrations
+and secrets requiring sensitive values that can not be read from Snowflake (e.g. oauth_client_secret), and the account-level replication
+objects (snowflake_account, snowflake_primary_connection, snowflake_secondary_connection).
+
+Only the identifying, the required, and a few basic fields (like comment) are generated. The generated configuration should be treated as
+a starting point: after running `terraform plan`, the differences should be moved to the config.
+
+Usage:
+	go run ./pkg/internal/tools/config-generator/ -profile <profile> -output <file>.tf
+*/
+
+func main() {
+	clientFlags := toolclient.RegisterFlags()
+	output := flag.String("output", "", "Path to the output file. The configuration is written to the standard output when empty.")
+	flag.Parse()
+
+	client, err := clientFlags.NewClient()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx := context.Background()
+	objects, err := collectObjects(ctx, client)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Found %d objects", len(objects))
+
+	generatedConfig := generateConfig(objects)
+
+	if *output == "" {
+		fmt.Print(generatedConfig)
+		return
+	}
+	if err := os.WriteFile(*output, []byte(generatedConfig), 0o600); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Configuration written to %s", *output)
+}
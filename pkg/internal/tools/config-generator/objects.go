@@ -0,0 +1,590 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/hclgen"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+// generatedObject is a single resource from the generated config together with the id used to import it.
+type generatedObject struct {
+	Resource hclgen.Resource
+	ImportId string
+}
+
+var systemDefinedRoles = []string{"ACCOUNTADMIN", "ORGADMIN", "SECURITYADMIN", "SYSADMIN", "USERADMIN", "PUBLIC"}
+
+var systemDefinedUsers = []string{"SNOWFLAKE"}
+
+// generator lists the objects with SHOW (and DESCRIBE for the fields that are not returned by SHOW) and maps them to the resources.
+type generator struct {
+	client  *sdk.Client
+	names   *hclgen.ResourceNames
+	objects []generatedObject
+}
+
+// newObject returns the resource with a unique name built from the identifier of the object. The identifier is also used as the import id.
+func (g *generator) newObject(resourceType string, id sdk.ObjectIdentifier, attributes ...hclgen.Attribute) generatedObject {
+	return generatedObject{
+		Resource: hclgen.Resource{
+			Type:       resourceType,
+			Name:       g.names.Next(resourceType, id.FullyQualifiedName()),
+			Attributes: attributes,
+		},
+		ImportId: helpers.EncodeResourceIdentifier(id.FullyQualifiedName()),
+	}
+}
+
+func collectObjects(ctx context.Context, client *sdk.Client) ([]generatedObject, error) {
+	g := &generator{client: client, names: hclgen.NewResourceNames()}
+	for _, collect := range []func(context.Context) error{
+		g.collectDatabases,
+		g.collectWarehouses,
+		g.collectAccountRoles,
+		g.collectUsers,
+		g.collectResourceMonitors,
+		g.collectNetworkPolicies,
+		g.collectSecurityIntegrations,
+	} {
+		if err := collect(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return g.objects, nil
+}
+
+func (g *generator) collectDatabases(ctx context.Context) error {
+	databases, err := g.client.Databases.Show(ctx, &sdk.ShowDatabasesOptions{})
+	if err != nil {
+		return fmt.Errorf("listing databases: %w", err)
+	}
+	for _, database := range databases {
+		switch {
+		case isStandardDatabase(database):
+			g.objects = append(g.objects, databaseObject(g, database))
+			if err := g.collectDatabaseObjects(ctx, database.ID()); err != nil {
+				return err
+			}
+		case isSecondaryDatabase(database):
+			g.objects = append(g.objects, secondaryDatabaseObject(g, database))
+		case isSharedDatabase(database):
+			g.objects = append(g.objects, sharedDatabaseObject(g, database))
+		default:
+			log.Printf("[DEBUG] Skipping database %s of kind %s", database.Name, database.Kind)
+		}
+	}
+	return nil
+}
+
+// collectDatabaseObjects lists the objects in the given database; the schema-level objects are listed with a single SHOW ... IN DATABASE for every object type.
+func (g *generator) collectDatabaseObjects(ctx context.Context, databaseId sdk.AccountObjectIdentifier) error {
+	in := sdk.ExtendedIn{In: sdk.In{Database: databaseId}}
+
+	schemas, err := g.client.Schemas.Show(ctx, &sdk.ShowSchemaOptions{In: &sdk.SchemaIn{Database: sdk.Bool(true), Name: databaseId}})
+	if err != nil {
+		return fmt.Errorf("listing schemas in database %s: %w", databaseId.FullyQualifiedName(), err)
+	}
+	for _, schema := range schemas {
+		if schema.Name == "INFORMATION_SCHEMA" {
+			continue
+		}
+		g.objects = append(g.objects, schemaObject(g, schema))
+	}
+
+	databaseRoles, err := g.client.DatabaseRoles.Show(ctx, sdk.NewShowDatabaseRoleRequest(databaseId))
+	if err != nil {
+		return fmt.Errorf("listing database roles in database %s: %w", databaseId.FullyQualifiedName(), err)
+	}
+	for _, databaseRole := range databaseRoles {
+		g.objects = append(g.objects, databaseRoleObject(g, databaseRole))
+	}
+
+	maskingPolicies, err := g.client.MaskingPolicies.Show(ctx, &sdk.ShowMaskingPolicyOptions{In: &in})
+	if err != nil {
+		return fmt.Errorf("listing masking policies in database %s: %w", databaseId.FullyQualifiedName(), err)
+	}
+	for _, maskingPolicy := range maskingPolicies {
+		details, err := g.client.MaskingPolicies.Describe(ctx, maskingPolicy.ID())
+		if err != nil {
+			return fmt.Errorf("describing masking policy %s: %w", maskingPolicy.ID().FullyQualifiedName(), err)
+		}
+		g.objects = append(g.objects, maskingPolicyObject(g, maskingPolicy, *details))
+	}
+
+	rowAccessPolicies, err := g.client.RowAccessPolicies.Show(ctx, sdk.NewShowRowAccessPolicyRequest().WithIn(in))
+	if err != nil {
+		return fmt.Errorf("listing row access policies in database %s: %w", databaseId.FullyQualifiedName(), err)
+	}
+	for _, rowAccessPolicy := range rowAccessPolicies {
+		description, err := g.client.RowAccessPolicies.Describe(ctx, rowAccessPolicy.ID())
+		if err != nil {
+			return fmt.Errorf("describing row access policy %s: %w", rowAccessPolicy.ID().FullyQualifiedName(), err)
+		}
+		g.objects = append(g.objects, rowAccessPolicyObject(g, rowAccessPolicy, *description))
+	}
+
+	tags, err := g.client.Tags.Show(ctx, sdk.NewShowTagRequest().WithIn(&in))
+	if err != nil {
+		return fmt.Errorf("listing tags in database %s: %w", databaseId.FullyQualifiedName(), err)
+	}
+	for _, tag := range tags {
+		g.objects = append(g.objects, tagObject(g, tag))
+	}
+
+	tasks, err := g.client.Tasks.Show(ctx, sdk.NewShowTaskRequest().WithIn(in))
+	if err != nil {
+		return fmt.Errorf("listing tasks in database %s: %w", databaseId.FullyQualifiedName(), err)
+	}
+	for _, task := range tasks {
+		g.objects = append(g.objects, taskObject(g, task))
+	}
+
+	streams, err := g.client.Streams.Show(ctx, sdk.NewShowStreamRequest().WithIn(in))
+	if err != nil {
+		return fmt.Errorf("listing streams in database %s: %w", databaseId.FullyQualifiedName(), err)
+	}
+	for _, stream := range streams {
+		object, err := streamObject(g, stream)
+		if err != nil {
+			log.Printf("[DEBUG] Skipping stream %s: %s", stream.ID().FullyQualifiedName(), err)
+			continue
+		}
+		g.objects = append(g.objects, object)
+	}
+
+	streamlits, err := g.client.Streamlits.Show(ctx, sdk.NewShowStreamlitRequest().WithIn(in.In))
+	if err != nil {
+		return fmt.Errorf("listing streamlits in database %s: %w", databaseId.FullyQualifiedName(), err)
+	}
+	for _, streamlit := range streamlits {
+		details, err := g.client.Streamlits.Describe(ctx, streamlit.ID())
+		if err != nil {
+			return fmt.Errorf("describing streamlit %s: %w", streamlit.ID().FullyQualifiedName(), err)
+		}
+		object, err := streamlitObject(g, streamlit, *details)
+		if err != nil {
+			log.Printf("[DEBUG] Skipping streamlit %s: %s", streamlit.ID().FullyQualifiedName(), err)
+			continue
+		}
+		g.objects = append(g.objects, object)
+	}
+	return nil
+}
+
+func (g *generator) collectWarehouses(ctx context.Context) error {
+	warehouses, err := g.client.Warehouses.Show(ctx, &sdk.ShowWarehouseOptions{})
+	if err != nil {
+		return fmt.Errorf("listing warehouses: %w", err)
+	}
+	for _, warehouse := range warehouses {
+		g.objects = append(g.objects, warehouseObject(g, warehouse))
+	}
+	return nil
+}
+
+func (g *generator) collectAccountRoles(ctx context.Context) error {
+	roles, err := g.client.Roles.Show(ctx, sdk.NewShowRoleRequest())
+	if err != nil {
+		return fmt.Errorf("listing account roles: %w", err)
+	}
+	for _, role := range roles {
+		if slices.Contains(systemDefinedRoles, role.Name) {
+			continue
+		}
+		g.objects = append(g.objects, accountRoleObject(g, role))
+	}
+	return nil
+}
+
+func (g *generator) collectUsers(ctx context.Context) error {
+	users, err := g.client.Users.Show(ctx, &sdk.ShowUserOptions{})
+	if err != nil {
+		return fmt.Errorf("listing users: %w", err)
+	}
+	for _, user := range users {
+		if slices.Contains(systemDefinedUsers, user.Name) {
+			continue
+		}
+		g.objects = append(g.objects, userObject(g, user))
+	}
+	return nil
+}
+
+func (g *generator) collectResourceMonitors(ctx context.Context) error {
+	resourceMonitors, err := g.client.ResourceMonitors.Show(ctx, &sdk.ShowResourceMonitorOptions{})
+	if err != nil {
+		return fmt.Errorf("listing resource monitors: %w", err)
+	}
+	for _, resourceMonitor := range resourceMonitors {
+		g.objects = append(g.objects, resourceMonitorObject(g, resourceMonitor))
+	}
+	return nil
+}
+
+func (g *generator) collectNetworkPolicies(ctx context.Context) error {
+	networkPolicies, err := g.client.NetworkPolicies.Show(ctx, sdk.NewShowNetworkPolicyRequest())
+	if err != nil {
+		return fmt.Errorf("listing network policies: %w", err)
+	}
+	for _, networkPolicy := range networkPolicies {
+		properties, err := g.client.NetworkPolicies.Describe(ctx, networkPolicy.ID())
+		if err != nil {
+			return fmt.Errorf("describing network policy %s: %w", networkPolicy.ID().FullyQualifiedName(), err)
+		}
+		g.objects = append(g.objects, networkPolicyObject(g, networkPolicy, properties))
+	}
+	return nil
+}
+
+// collectSecurityIntegrations covers the SCIM and SAML2 integrations; the other security integrations require secrets (e.g. oauth_client_secret) that can not be read from Snowflake.
+func (g *generator) collectSecurityIntegrations(ctx context.Context) error {
+	integrations, err := g.client.SecurityIntegrations.Show(ctx, sdk.NewShowSecurityIntegrationRequest())
+	if err != nil {
+		return fmt.Errorf("listing security integrations: %w", err)
+	}
+	for _, integration := range integrations {
+		var mapping func(*generator, sdk.SecurityIntegration, []sdk.SecurityIntegrationProperty) (generatedObject, error)
+		switch {
+		case strings.HasPrefix(integration.IntegrationType, "SCIM"):
+			mapping = scimIntegrationObject
+		case integration.IntegrationType == "SAML2":
+			mapping = saml2IntegrationObject
+		default:
+			log.Printf("[DEBUG] Skipping security integration %s of type %s", integration.Name, integration.IntegrationType)
+			continue
+		}
+		properties, err := g.client.SecurityIntegrations.Describe(ctx, integration.ID())
+		if err != nil {
+			return fmt.Errorf("describing security integration %s: %w", integration.ID().FullyQualifiedName(), err)
+		}
+		object, err := mapping(g, integration, properties)
+		if err != nil {
+			log.Printf("[DEBUG] Skipping security integration %s: %s", integration.Name, err)
+			continue
+		}
+		g.objects = append(g.objects, object)
+	}
+	return nil
+}
+
+// isStandardDatabase filters out shared, secondary, and application databases that are not managed by the snowflake_database resource.
+func isStandardDatabase(database sdk.Database) bool {
+	return database.Origin == nil && (database.Kind == "" || database.Kind == "STANDARD")
+}
+
+func isSecondaryDatabase(database sdk.Database) bool {
+	return database.Origin != nil && (database.Kind == "" || database.Kind == "STANDARD")
+}
+
+func isSharedDatabase(database sdk.Database) bool {
+	return database.Origin != nil && database.Kind == "IMPORTED DATABASE"
+}
+
+// withComment appends the comment attribute when the comment is set.
+func withComment(attributes []hclgen.Attribute, comment string) []hclgen.Attribute {
+	if comment != "" {
+		attributes = append(attributes, hclgen.String("comment", comment))
+	}
+	return attributes
+}
+
+// securityIntegrationProperty returns the value of the property with the given name from DESCRIBE output.
+func securityIntegrationProperty(properties []sdk.SecurityIntegrationProperty, name string) string {
+	if p, err := collections.FindFirst(properties, func(p sdk.SecurityIntegrationProperty) bool { return p.Name == name }); err == nil {
+		return p.Value
+	}
+	return ""
+}
+
+// networkPolicyProperty returns the value of the property with the given name from DESCRIBE output.
+func networkPolicyProperty(properties []sdk.NetworkPolicyProperty, name string) string {
+	if p, err := collections.FindFirst(properties, func(p sdk.NetworkPolicyProperty) bool { return p.Name == name }); err == nil {
+		return p.Value
+	}
+	return ""
+}
+
+func databaseObject(g *generator, database sdk.Database) generatedObject {
+	id := database.ID()
+	attributes := []hclgen.Attribute{hclgen.String("name", id.Name())}
+	if database.Transient {
+		attributes = append(attributes, hclgen.Bool("is_transient", true))
+	}
+	return g.newObject(resources.Database.String(), id, withComment(attributes, database.Comment)...)
+}
+
+func secondaryDatabaseObject(g *generator, database sdk.Database) generatedObject {
+	id := database.ID()
+	attributes := []hclgen.Attribute{
+		hclgen.String("name", id.Name()),
+		hclgen.String("as_replica_of", database.Origin.FullyQualifiedName()),
+	}
+	return g.newObject(resources.SecondaryDatabase.String(), id, withComment(attributes, database.Comment)...)
+}
+
+func sharedDatabaseObject(g *generator, database sdk.Database) generatedObject {
+	id := database.ID()
+	attributes := []hclgen.Attribute{
+		hclgen.String("name", id.Name()),
+		hclgen.String("from_share", database.Origin.FullyQualifiedName()),
+	}
+	return g.newObject(resources.SharedDatabase.String(), id, withComment(attributes, database.Comment)...)
+}
+
+func schemaObject(g *generator, schema sdk.Schema) generatedObject {
+	id := schema.ID()
+	attributes := []hclgen.Attribute{
+		hclgen.String("database", id.DatabaseName()),
+		hclgen.String("name", id.Name()),
+	}
+	return g.newObject(resources.Schema.String(), id, withComment(attributes, schema.Comment)...)
+}
+
+func databaseRoleObject(g *generator, databaseRole sdk.DatabaseRole) generatedObject {
+	id := sdk.NewDatabaseObjectIdentifier(databaseRole.DatabaseName, databaseRole.Name)
+	attributes := []hclgen.Attribute{
+		hclgen.String("database", id.DatabaseName()),
+		hclgen.String("name", id.Name()),
+	}
+	return g.newObject(resources.DatabaseRole.String(), id, withComment(attributes, databaseRole.Comment)...)
+}
+
+// schemaObjectAttributes returns the identifying attributes of the schema-level objects.
+func schemaObjectAttributes(id sdk.SchemaObjectIdentifier) []hclgen.Attribute {
+	return []hclgen.Attribute{
+		hclgen.String("database", id.DatabaseName()),
+		hclgen.String("schema", id.SchemaName()),
+		hclgen.String("name", id.Name()),
+	}
+}
+
+// signatureBlocks returns the argument blocks of the policies.
+func signatureBlocks(signature []sdk.TableColumnSignature) []hclgen.Attribute {
+	return collections.Map(signature, func(argument sdk.TableColumnSignature) hclgen.Attribute {
+		return hclgen.Block("argument", hclgen.String("name", argument.Name), hclgen.String("type", argument.Type.ToSql()))
+	})
+}
+
+func maskingPolicyObject(g *generator, maskingPolicy sdk.MaskingPolicy, details sdk.MaskingPolicyDetails) generatedObject {
+	id := maskingPolicy.ID()
+	attributes := append(schemaObjectAttributes(id), signatureBlocks(details.Signature)...)
+	attributes = append(attributes,
+		hclgen.String("body", details.Body),
+		hclgen.String("return_data_type", details.ReturnType.ToSql()),
+	)
+	if maskingPolicy.ExemptOtherPolicies {
+		attributes = append(attributes, hclgen.String("exempt_other_policies", "true"))
+	}
+	return g.newObject(resources.MaskingPolicy.String(), id, withComment(attributes, maskingPolicy.Comment)...)
+}
+
+func rowAccessPolicyObject(g *generator, rowAccessPolicy sdk.RowAccessPolicy, description sdk.RowAccessPolicyDescription) generatedObject {
+	id := rowAccessPolicy.ID()
+	attributes := append(schemaObjectAttributes(id), signatureBlocks(description.Signature)...)
+	attributes = append(attributes, hclgen.String("body", description.Body))
+	return g.newObject(resources.RowAccessPolicy.String(), id, withComment(attributes, rowAccessPolicy.Comment)...)
+}
+
+func tagObject(g *generator, tag sdk.Tag) generatedObject {
+	id := tag.ID()
+	attributes := schemaObjectAttributes(id)
+	if len(tag.AllowedValues) > 0 {
+		attributes = append(attributes, hclgen.StringList("allowed_values", tag.AllowedValues))
+	}
+	return g.newObject(resources.Tag.String(), id, withComment(attributes, tag.Comment)...)
+}
+
+func taskObject(g *generator, task sdk.Task) generatedObject {
+	id := task.ID()
+	attributes := append(schemaObjectAttributes(id),
+		hclgen.Bool("started", task.IsStarted()),
+		hclgen.String("sql_statement", task.Definition),
+	)
+	if task.Warehouse != nil {
+		attributes = append(attributes, hclgen.String("warehouse", task.Warehouse.Name()))
+	}
+	if task.Schedule != "" {
+		if schedule, err := sdk.ParseTaskSchedule(task.Schedule); err == nil {
+			if schedule.Cron != "" {
+				attributes = append(attributes, hclgen.Block("schedule", hclgen.String("using_cron", schedule.Cron)))
+			} else {
+				attributes = append(attributes, hclgen.Block("schedule", hclgen.Int("minutes", schedule.Minutes)))
+			}
+		} else {
+			log.Printf("[DEBUG] Skipping schedule of task %s: %s", id.FullyQualifiedName(), err)
+		}
+	}
+	if len(task.Predecessors) > 0 {
+		attributes = append(attributes, hclgen.StringList("after", collections.Map(task.Predecessors, sdk.SchemaObjectIdentifier.FullyQualifiedName)))
+	}
+	if task.Condition != "" {
+		attributes = append(attributes, hclgen.String("when", task.Condition))
+	}
+	return g.newObject(resources.Task.String(), id, withComment(attributes, task.Comment)...)
+}
+
+// streamObject maps the stream to the resource matching its source type.
+func streamObject(g *generator, stream sdk.Stream) (generatedObject, error) {
+	id := stream.ID()
+	if stream.SourceType == nil || stream.TableName == nil {
+		return generatedObject{}, fmt.Errorf("source of the stream is unknown")
+	}
+	sourceId, err := sdk.ParseSchemaObjectIdentifier(*stream.TableName)
+	if err != nil {
+		return generatedObject{}, err
+	}
+
+	var resourceType, sourceAttribute, modeAttribute string
+	var mode sdk.StreamMode
+	switch *stream.SourceType {
+	case sdk.StreamSourceTypeTable:
+		resourceType, sourceAttribute, modeAttribute, mode = resources.StreamOnTable.String(), "table", "append_only", sdk.StreamModeAppendOnly
+	case sdk.StreamSourceTypeView:
+		resourceType, sourceAttribute, modeAttribute, mode = resources.StreamOnView.String(), "view", "append_only", sdk.StreamModeAppendOnly
+	case sdk.StreamSourceTypeExternalTable:
+		resourceType, sourceAttribute, modeAttribute, mode = resources.StreamOnExternalTable.String(), "external_table", "insert_only", sdk.StreamModeInsertOnly
+	case sdk.StreamSourceTypeStage:
+		resourceType, sourceAttribute = resources.StreamOnDirectoryTable.String(), "stage"
+	default:
+		return generatedObject{}, fmt.Errorf("unsupported source type %s", *stream.SourceType)
+	}
+
+	attributes := append(schemaObjectAttributes(id), hclgen.String(sourceAttribute, sourceId.FullyQualifiedName()))
+	if modeAttribute != "" && stream.Mode != nil && *stream.Mode == mode {
+		attributes = append(attributes, hclgen.String(modeAttribute, "true"))
+	}
+	return g.newObject(resourceType, id, withComment(attributes, stringValue(stream.Comment))...), nil
+}
+
+func streamlitObject(g *generator, streamlit sdk.Streamlit, details sdk.StreamlitDetail) (generatedObject, error) {
+	id := streamlit.ID()
+	stageId, location, err := helpers.ParseRootLocation(details.RootLocation)
+	if err != nil {
+		return generatedObject{}, err
+	}
+	attributes := append(schemaObjectAttributes(id),
+		hclgen.String("stage", stageId.FullyQualifiedName()),
+	)
+	if location != "" {
+		attributes = append(attributes, hclgen.String("directory_location", location))
+	}
+	attributes = append(attributes, hclgen.String("main_file", details.MainFile))
+	if streamlit.QueryWarehouse != "" {
+		attributes = append(attributes, hclgen.String("query_warehouse", streamlit.QueryWarehouse))
+	}
+	if streamlit.Title != "" {
+		attributes = append(attributes, hclgen.String("title", streamlit.Title))
+	}
+	return g.newObject(resources.Streamlit.String(), id, withComment(attributes, streamlit.Comment)...), nil
+}
+
+func warehouseObject(g *generator, warehouse sdk.Warehouse) generatedObject {
+	id := warehouse.ID()
+	attributes := []hclgen.Attribute{
+		hclgen.String("name", id.Name()),
+		hclgen.String("warehouse_size", string(warehouse.Size)),
+		hclgen.Int("auto_suspend", warehouse.AutoSuspend),
+		hclgen.String("auto_resume", fmt.Sprintf("%t", warehouse.AutoResume)),
+	}
+	return g.newObject(resources.Warehouse.String(), id, withComment(attributes, warehouse.Comment)...)
+}
+
+func accountRoleObject(g *generator, role sdk.Role) generatedObject {
+	id := role.ID()
+	attributes := []hclgen.Attribute{hclgen.String("name", id.Name())}
+	return g.newObject(resources.AccountRole.String(), id, withComment(attributes, role.Comment)...)
+}
+
+// userObject maps the user to snowflake_user, snowflake_service_user, or snowflake_legacy_service_user depending on its type.
+func userObject(g *generator, user sdk.User) generatedObject {
+	id := user.ID()
+	resourceType := resources.User.String()
+	switch sdk.UserType(strings.ToUpper(user.Type)) {
+	case sdk.UserTypeService:
+		resourceType = resources.ServiceUser.String()
+	case sdk.UserTypeLegacyService:
+		resourceType = resources.LegacyServiceUser.String()
+	}
+	attributes := []hclgen.Attribute{hclgen.String("name", id.Name())}
+	return g.newObject(resourceType, id, withComment(attributes, user.Comment)...)
+}
+
+func resourceMonitorObject(g *generator, resourceMonitor sdk.ResourceMonitor) generatedObject {
+	id := resourceMonitor.ID()
+	attributes := []hclgen.Attribute{hclgen.String("name", id.Name())}
+	if resourceMonitor.CreditQuota != 0 {
+		attributes = append(attributes, hclgen.Int("credit_quota", int(resourceMonitor.CreditQuota)))
+	}
+	if len(resourceMonitor.NotifyUsers) > 0 {
+		attributes = append(attributes, hclgen.StringList("notify_users", resourceMonitor.NotifyUsers))
+	}
+	if len(resourceMonitor.NotifyAt) > 0 {
+		attributes = append(attributes, hclgen.IntList("notify_triggers", resourceMonitor.NotifyAt))
+	}
+	if resourceMonitor.SuspendAt != nil {
+		attributes = append(attributes, hclgen.Int("suspend_trigger", *resourceMonitor.SuspendAt))
+	}
+	if resourceMonitor.SuspendImmediateAt != nil {
+		attributes = append(attributes, hclgen.Int("suspend_immediate_trigger", *resourceMonitor.SuspendImmediateAt))
+	}
+	return g.newObject(resources.ResourceMonitor.String(), id, attributes...)
+}
+
+func networkPolicyObject(g *generator, networkPolicy sdk.NetworkPolicy, properties []sdk.NetworkPolicyProperty) generatedObject {
+	id := networkPolicy.ID()
+	attributes := []hclgen.Attribute{hclgen.String("name", id.Name())}
+	if allowedIpList := networkPolicyProperty(properties, "ALLOWED_IP_LIST"); allowedIpList != "" {
+		attributes = append(attributes, hclgen.StringList("allowed_ip_list", sdk.ParseCommaSeparatedStringArray(allowedIpList, false)))
+	}
+	if blockedIpList := networkPolicyProperty(properties, "BLOCKED_IP_LIST"); blockedIpList != "" {
+		attributes = append(attributes, hclgen.StringList("blocked_ip_list", sdk.ParseCommaSeparatedStringArray(blockedIpList, false)))
+	}
+	return g.newObject(resources.NetworkPolicy.String(), id, withComment(attributes, networkPolicy.Comment)...)
+}
+
+func scimIntegrationObject(g *generator, integration sdk.SecurityIntegration, properties []sdk.SecurityIntegrationProperty) (generatedObject, error) {
+	id := integration.ID()
+	scimClient, err := integration.SubType()
+	if err != nil {
+		return generatedObject{}, err
+	}
+	attributes := []hclgen.Attribute{
+		hclgen.String("name", id.Name()),
+		hclgen.Bool("enabled", integration.Enabled),
+		hclgen.String("scim_client", scimClient),
+		hclgen.String("run_as_role", securityIntegrationProperty(properties, "RUN_AS_ROLE")),
+	}
+	if networkPolicy := securityIntegrationProperty(properties, "NETWORK_POLICY"); networkPolicy != "" {
+		attributes = append(attributes, hclgen.String("network_policy", networkPolicy))
+	}
+	return g.newObject(resources.ScimSecurityIntegration.String(), id, withComment(attributes, integration.Comment)...), nil
+}
+
+func saml2IntegrationObject(g *generator, integration sdk.SecurityIntegration, properties []sdk.SecurityIntegrationProperty) (generatedObject, error) {
+	id := integration.ID()
+	attributes := []hclgen.Attribute{hclgen.String("name", id.Name())}
+	for _, required := range []string{"SAML2_ISSUER", "SAML2_SSO_URL", "SAML2_PROVIDER", "SAML2_X509_CERT"} {
+		value := securityIntegrationProperty(properties, required)
+		if value == "" {
+			return generatedObject{}, fmt.Errorf("property %s is not set", required)
+		}
+		attributes = append(attributes, hclgen.String(strings.ToLower(required), value))
+	}
+	attributes = append(attributes, hclgen.String("enabled", fmt.Sprintf("%t", integration.Enabled)))
+	return g.newObject(resources.Saml2SecurityIntegration.String(), id, withComment(attributes, integration.Comment)...), nil
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
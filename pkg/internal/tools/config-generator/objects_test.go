@@ -0,0 +1,226 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/hclgen"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/datatypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGenerator() *generator {
+	return &generator{names: hclgen.NewResourceNames()}
+}
+
+func Test_databaseKinds(t *testing.T) {
+	origin, err := sdk.ParseExternalObjectIdentifier(`"ORG"."ACC"."DB"`)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		database                    sdk.Database
+		standard, secondary, shared bool
+	}{
+		{database: sdk.Database{Name: "DB"}, standard: true},
+		{database: sdk.Database{Name: "DB", Kind: "STANDARD"}, standard: true},
+		{database: sdk.Database{Name: "DB", Kind: "STANDARD", Origin: &origin}, secondary: true},
+		{database: sdk.Database{Name: "DB", Kind: "IMPORTED DATABASE", Origin: &origin}, shared: true},
+		{database: sdk.Database{Name: "DB", Kind: "APPLICATION"}},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.standard, isStandardDatabase(tc.database))
+		assert.Equal(t, tc.secondary, isSecondaryDatabase(tc.database))
+		assert.Equal(t, tc.shared, isSharedDatabase(tc.database))
+	}
+
+	g := newTestGenerator()
+	object := secondaryDatabaseObject(g, sdk.Database{Name: "REPLICA", Origin: &origin, Comment: "replica"})
+	assert.Equal(t, `resource "snowflake_secondary_database" "replica" {
+  name          = "REPLICA"
+  as_replica_of = "\"ORG\".\"ACC\".\"DB\""
+  comment       = "replica"
+}
+`, object.Resource.HCL())
+	assert.Equal(t, `"REPLICA"`, object.ImportId)
+}
+
+func Test_maskingPolicyObject(t *testing.T) {
+	varchar, err := datatypes.ParseDataType("VARCHAR")
+	require.NoError(t, err)
+
+	object := maskingPolicyObject(newTestGenerator(),
+		sdk.MaskingPolicy{Name: "MASK", DatabaseName: "DB", SchemaName: "PUBLIC", ExemptOtherPolicies: true},
+		sdk.MaskingPolicyDetails{
+			Name:       "MASK",
+			Signature:  []sdk.TableColumnSignature{{Name: "VAL", Type: varchar}},
+			ReturnType: varchar,
+			Body:       "case when current_role() = 'ADMIN' then val else '***' end",
+		},
+	)
+
+	assert.Equal(t, "snowflake_masking_policy.db_public_mask", object.Resource.Address())
+	assert.Equal(t, `"DB"."PUBLIC"."MASK"`, object.ImportId)
+	assert.Equal(t, `resource "snowflake_masking_policy" "db_public_mask" {
+  database = "DB"
+  schema   = "PUBLIC"
+  name     = "MASK"
+  argument {
+    name = "VAL"
+    type = "VARCHAR(16777216)"
+  }
+  body                  = "case when current_role() = 'ADMIN' then val else '***' end"
+  return_data_type      = "VARCHAR(16777216)"
+  exempt_other_policies = "true"
+}
+`, object.Resource.HCL())
+}
+
+func Test_taskObject(t *testing.T) {
+	warehouse := sdk.NewAccountObjectIdentifier("WH")
+	g := newTestGenerator()
+
+	object := taskObject(g, sdk.Task{Name: "T", DatabaseName: "DB", SchemaName: "PUBLIC", State: sdk.TaskStateStarted, Definition: "select 1", Warehouse: &warehouse, Schedule: "USING CRON 0 * * * * UTC"})
+	assert.Contains(t, object.Resource.HCL(), `  started       = true
+  sql_statement = "select 1"
+  warehouse     = "WH"
+  schedule {
+    using_cron = "0 * * * * UTC"
+  }
+`)
+
+	object = taskObject(g, sdk.Task{Name: "CHILD", DatabaseName: "DB", SchemaName: "PUBLIC", State: sdk.TaskStateSuspended, Definition: "select 2", Schedule: "5 MINUTE", Predecessors: []sdk.SchemaObjectIdentifier{sdk.NewSchemaObjectIdentifier("DB", "PUBLIC", "T")}})
+	assert.Contains(t, object.Resource.HCL(), `  schedule {
+    minutes = 5
+  }
+  after = ["\"DB\".\"PUBLIC\".\"T\""]
+`)
+}
+
+func Test_streamObject(t *testing.T) {
+	testCases := []struct {
+		sourceType   sdk.StreamSourceType
+		mode         sdk.StreamMode
+		expectedType string
+		expected     string
+	}{
+		{sourceType: sdk.StreamSourceTypeTable, mode: sdk.StreamModeAppendOnly, expectedType: "snowflake_stream_on_table", expected: "  table       = \"\\\"DB\\\".\\\"PUBLIC\\\".\\\"SRC\\\"\"\n  append_only = \"true\"\n"},
+		{sourceType: sdk.StreamSourceTypeView, mode: sdk.StreamModeDefault, expectedType: "snowflake_stream_on_view", expected: "  view     = \"\\\"DB\\\".\\\"PUBLIC\\\".\\\"SRC\\\"\"\n}"},
+		{sourceType: sdk.StreamSourceTypeExternalTable, mode: sdk.StreamModeInsertOnly, expectedType: "snowflake_stream_on_external_table", expected: "  insert_only    = \"true\"\n"},
+		{sourceType: sdk.StreamSourceTypeStage, expectedType: "snowflake_stream_on_directory_table", expected: "  stage    = \"\\\"DB\\\".\\\"PUBLIC\\\".\\\"SRC\\\"\"\n"},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.sourceType), func(t *testing.T) {
+			object, err := streamObject(newTestGenerator(), sdk.Stream{
+				Name:         "S",
+				DatabaseName: "DB",
+				SchemaName:   "PUBLIC",
+				TableName:    sdk.String("DB.PUBLIC.SRC"),
+				SourceType:   sdk.Pointer(tc.sourceType),
+				Mode:         sdk.Pointer(tc.mode),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedType, object.Resource.Type)
+			assert.Contains(t, object.Resource.HCL(), tc.expected)
+		})
+	}
+
+	_, err := streamObject(newTestGenerator(), sdk.Stream{Name: "S", DatabaseName: "DB", SchemaName: "PUBLIC"})
+	require.ErrorContains(t, err, "source of the stream is unknown")
+}
+
+func Test_streamlitObject(t *testing.T) {
+	object, err := streamlitObject(newTestGenerator(),
+		sdk.Streamlit{Name: "APP", DatabaseName: "DB", SchemaName: "PUBLIC", QueryWarehouse: "WH"},
+		sdk.StreamlitDetail{RootLocation: `@"DB"."PUBLIC"."STAGE"/app`, MainFile: "main.py"},
+	)
+	require.NoError(t, err)
+	assert.Contains(t, object.Resource.HCL(), `  stage              = "\"DB\".\"PUBLIC\".\"STAGE\""
+  directory_location = "app"
+  main_file          = "main.py"
+  query_warehouse    = "WH"
+`)
+}
+
+func Test_securityIntegrationObjects(t *testing.T) {
+	g := newTestGenerator()
+
+	scim, err := scimIntegrationObject(g, sdk.SecurityIntegration{Name: "SCIM", IntegrationType: "SCIM - AZURE", Enabled: true}, []sdk.SecurityIntegrationProperty{
+		{Name: "RUN_AS_ROLE", Value: "AAD_PROVISIONER"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `resource "snowflake_scim_integration" "scim" {
+  name        = "SCIM"
+  enabled     = true
+  scim_client = "AZURE"
+  run_as_role = "AAD_PROVISIONER"
+}
+`, scim.Resource.HCL())
+
+	_, err = saml2IntegrationObject(g, sdk.SecurityIntegration{Name: "SAML", IntegrationType: "SAML2"}, []sdk.SecurityIntegrationProperty{
+		{Name: "SAML2_ISSUER", Value: "issuer"},
+	})
+	require.ErrorContains(t, err, "property SAML2_SSO_URL is not set")
+}
+
+func Test_resourceMonitorAndNetworkPolicyObjects(t *testing.T) {
+	g := newTestGenerator()
+
+	resourceMonitor := resourceMonitorObject(g, sdk.ResourceMonitor{Name: "RM", CreditQuota: 100, NotifyAt: []int{50, 75}, SuspendAt: sdk.Int(90)})
+	assert.Equal(t, `resource "snowflake_resource_monitor" "rm" {
+  name            = "RM"
+  credit_quota    = 100
+  notify_triggers = [50, 75]
+  suspend_trigger = 90
+}
+`, resourceMonitor.Resource.HCL())
+
+	networkPolicy := networkPolicyObject(g, sdk.NetworkPolicy{Name: "NP"}, []sdk.NetworkPolicyProperty{
+		{Name: "ALLOWED_IP_LIST", Value: "192.168.0.1,10.0.0.0/8"},
+	})
+	assert.Equal(t, `resource "snowflake_network_policy" "np" {
+  name            = "NP"
+  allowed_ip_list = ["192.168.0.1", "10.0.0.0/8"]
+}
+`, networkPolicy.Resource.HCL())
+}
+
+func Test_userObject(t *testing.T) {
+	g := newTestGenerator()
+
+	assert.Equal(t, "snowflake_user.u", userObject(g, sdk.User{Name: "U", Type: "PERSON"}).Resource.Address())
+	assert.Equal(t, "snowflake_service_user.u", userObject(g, sdk.User{Name: "U", Type: "SERVICE"}).Resource.Address())
+	assert.Equal(t, "snowflake_legacy_service_user.u", userObject(g, sdk.User{Name: "U", Type: "LEGACY_SERVICE"}).Resource.Address())
+	// names have to be unique only within the same resource type
+	assert.Equal(t, "snowflake_user.u_2", userObject(g, sdk.User{Name: "u"}).Resource.Address())
+}
+
+func Test_generateConfig(t *testing.T) {
+	g := newTestGenerator()
+	objects := []generatedObject{
+		databaseObject(g, sdk.Database{Name: "DB", Comment: "comment"}),
+		schemaObject(g, sdk.Schema{Name: "PUBLIC", DatabaseName: "DB"}),
+	}
+
+	assert.Equal(t, `resource "snowflake_database" "db" {
+  name    = "DB"
+  comment = "comment"
+}
+
+resource "snowflake_schema" "db_public" {
+  database = "DB"
+  name     = "PUBLIC"
+}
+
+import {
+  to = snowflake_database.db
+  id = "\"DB\""
+}
+
+import {
+  to = snowflake_schema.db_public
+  id = "\"DB\".\"PUBLIC\""
+}
+
+`, generateConfig(objects))
+}
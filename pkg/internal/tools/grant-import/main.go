@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/grantimport"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/tools/toolclient"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+/*
+This tool generates the Terraform configuration importing the existing grants of an account role or a database role.
+It connects to Snowflake using the profile from the TOML config file (the same one that is used by the provider)
+and reads SHOW GRANTS TO ROLE (or TO DATABASE ROLE). The same output is available in the snowflake_grant_imports data source.
+
+For every grant resource, the output contains:
+- an import block with the identifier expected by the resource (e.g. "ROLE"|false|false|USAGE|OnAccountObject|DATABASE|"DB"),
+- a resource block; the privileges granted on the same object with the same grant option are grouped into a single resource.
+
+Role grants are mapped to snowflake_grant_account_role and snowflake_grant_database_role, and OWNERSHIP to snowflake_grant_ownership.
+The grants that can not be managed by any of the grant resources are logged. Future grants are not returned by SHOW GRANTS TO ROLE.
+
+Usage:
+	go run ./pkg/internal/tools/grant-import/ -profile <profile> -account-role <role> -output grants.tf
+	terraform plan # the imported grants should show no changes
+*/
+
+func main() {
+	clientFlags := toolclient.RegisterFlags()
+	accountRole := flag.String("account-role", "", "Fully qualified name of the account role whose grants are imported.")
+	databaseRole := flag.String("database-role", "", "Fully qualified name of the database role whose grants are imported.")
+	output := flag.String("output", "", "Path to the output file. The configuration is written to the standard output when empty.")
+	flag.Parse()
+
+	grantee, err := parseGrantee(*accountRole, *databaseRole)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	client, err := clientFlags.NewClient()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	result, err := grantimport.Load(context.Background(), client, grantee)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Generated %d resources, skipped %d grants", len(result.Imports), len(result.Skipped))
+	for _, skipped := range result.Skipped {
+		log.Printf("Skipped %s", skipped)
+	}
+
+	if *output == "" {
+		fmt.Print(result.HCL())
+		return
+	}
+	if err := os.WriteFile(*output, []byte(result.HCL()), 0o600); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Configuration written to %s", *output)
+}
+
+func parseGrantee(accountRole string, databaseRole string) (grantimport.Grantee, error) {
+	var grantee grantimport.Grantee
+	switch {
+	case accountRole != "" && databaseRole != "":
+		return grantee, fmt.Errorf("only one of -account-role and -database-role can be set")
+	case accountRole != "":
+		id, err := sdk.ParseAccountObjectIdentifier(accountRole)
+		if err != nil {
+			return grantee, err
+		}
+		grantee.AccountRole = &id
+	case databaseRole != "":
+		id, err := sdk.ParseDatabaseObjectIdentifier(databaseRole)
+		if err != nil {
+			return grantee, err
+		}
+		grantee.DatabaseRole = &id
+	default:
+		return grantee, fmt.Errorf("one of -account-role and -database-role has to be set")
+	}
+	return grantee, nil
+}
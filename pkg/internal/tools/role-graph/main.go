@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/rolegraph"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/tools/toolclient"
+)
+
+/*
+This tool exports the role hierarchy of a Snowflake account as a DOT (Graphviz) or JSON document.
+It connects to Snowflake using the profile from the TOML config file (the same one that is used by the provider)
+and walks SHOW GRANTS OF for every account role, database role, and application role, the same way the provider
+reads snowflake_grant_account_role. The same graph is available in the snowflake_role_graph data source.
+
+The output contains:
+- account roles, database roles, application roles, and the users they are granted to,
+- the cycles in the role hierarchy,
+- the orphan roles (not granted to any role or user).
+
+Usage:
+	go run ./pkg/internal/tools/role-graph/ -profile <profile> -format dot -output roles.dot
+	dot -Tsvg roles.dot > roles.svg
+*/
+
+func main() {
+	clientFlags := toolclient.RegisterFlags()
+	format := flag.String("format", "dot", "Output format: dot or json.")
+	output := flag.String("output", "", "Path to the output file. The graph is written to the standard output when empty.")
+	flag.Parse()
+
+	if *format != "dot" && *format != "json" {
+		log.Fatalf("invalid format %s, expected dot or json", *format)
+	}
+
+	client, err := clientFlags.NewClient()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	graph, err := rolegraph.Load(context.Background(), client)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Found %d nodes, %d grants, %d cycles, and %d orphan roles", len(graph.Nodes), len(graph.Edges), len(graph.Cycles()), len(graph.OrphanRoles()))
+
+	var exported string
+	switch *format {
+	case "dot":
+		exported = graph.DOT()
+	case "json":
+		if exported, err = graph.JSON(); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if *output == "" {
+		fmt.Print(exported)
+		return
+	}
+	if err := os.WriteFile(*output, []byte(exported), 0o600); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Role graph written to %s", *output)
+}
@@ -0,0 +1,35 @@
+// Package toolclient connects the tools to Snowflake using the profile from the TOML config file (the same one that is used by the provider).
+package toolclient
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+// Flags hold the connection flags shared by the tools.
+type Flags struct {
+	profile           *string
+	useLegacyTomlFile *bool
+}
+
+// RegisterFlags registers the -profile and -use-legacy-toml-file flags; it has to be called before flag.Parse.
+func RegisterFlags() *Flags {
+	return &Flags{
+		profile:           flag.String("profile", "default", "Profile from the TOML config file used to connect to Snowflake."),
+		useLegacyTomlFile: flag.Bool("use-legacy-toml-file", false, "Use the legacy format of the TOML config file."),
+	}
+}
+
+// NewClient creates the client for the profile given in the flags.
+func (f *Flags) NewClient() (*sdk.Client, error) {
+	config, err := sdk.ProfileConfig(*f.profile, sdk.WithUseLegacyTomlFormat(*f.useLegacyTomlFile))
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, fmt.Errorf("profile %s not found in the config file", *f.profile)
+	}
+	return sdk.NewClient(config)
+}
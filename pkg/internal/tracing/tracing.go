@@ -0,0 +1,124 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/tracking"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const serviceName = "terraform-provider-snowflake"
+
+type Exporter string
+
+const (
+	// OtlpExporter sends the spans to the OTLP endpoint over HTTP.
+	OtlpExporter Exporter = "otlp"
+	// FileExporter writes the spans as JSON lines to the file.
+	FileExporter Exporter = "file"
+)
+
+var AllExporters = []Exporter{
+	OtlpExporter,
+	FileExporter,
+}
+
+func ToExporter(s string) (Exporter, error) {
+	lowerCase := strings.ToLower(s)
+	switch lowerCase {
+	case string(OtlpExporter),
+		string(FileExporter):
+		return Exporter(lowerCase), nil
+	default:
+		return "", fmt.Errorf("invalid tracing exporter: %s", s)
+	}
+}
+
+type Config struct {
+	Exporter Exporter
+	// OtlpEndpoint is the URL of the OTLP endpoint (e.g. http://localhost:4318/v1/traces). When empty,
+	// the standard OTEL_EXPORTER_OTLP_ENDPOINT and OTEL_EXPORTER_OTLP_TRACES_ENDPOINT environment variables are used.
+	OtlpEndpoint string
+	FilePath     string
+}
+
+var (
+	tracerProvidersMu sync.Mutex
+	tracerProviders   []*sdktrace.TracerProvider
+	files             []*os.File
+)
+
+// NewTracerProvider returns the tracer provider exporting the spans as configured. The tracer provider is flushed by Shutdown.
+func NewTracerProvider(ctx context.Context, config Config) (*sdktrace.TracerProvider, error) {
+	var processor sdktrace.SpanProcessor
+	switch config.Exporter {
+	case OtlpExporter:
+		var opts []otlptracehttp.Option
+		if config.OtlpEndpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpointURL(config.OtlpEndpoint))
+		}
+		exporter, err := otlptracehttp.New(ctx, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("create OTLP trace exporter: %w", err)
+		}
+		processor = sdktrace.NewBatchSpanProcessor(exporter)
+	case FileExporter:
+		if config.FilePath == "" {
+			return nil, errors.New("file path is required for the file tracing exporter")
+		}
+		file, err := os.OpenFile(config.FilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("open trace file: %w", err)
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("create file trace exporter: %w", err), file.Close())
+		}
+		// the spans are written right away, because the provider process can be stopped without the graceful shutdown
+		processor = sdktrace.NewSimpleSpanProcessor(exporter)
+		tracerProvidersMu.Lock()
+		files = append(files, file)
+		tracerProvidersMu.Unlock()
+	default:
+		return nil, fmt.Errorf("invalid tracing exporter: %s", config.Exporter)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(processor),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", serviceName),
+			attribute.String("service.version", tracking.ProviderVersion),
+		)),
+	)
+	tracerProvidersMu.Lock()
+	tracerProviders = append(tracerProviders, tracerProvider)
+	tracerProvidersMu.Unlock()
+	return tracerProvider, nil
+}
+
+// Shutdown flushes the spans of all the tracer providers created with NewTracerProvider and closes the trace files.
+// It should be called before the provider process exits.
+func Shutdown(ctx context.Context) error {
+	tracerProvidersMu.Lock()
+	defer tracerProvidersMu.Unlock()
+
+	var errs []error
+	for _, tracerProvider := range tracerProviders {
+		errs = append(errs, tracerProvider.Shutdown(ctx))
+	}
+	for _, file := range files {
+		errs = append(errs, file.Close())
+	}
+	tracerProviders = nil
+	files = nil
+	return errors.Join(errs...)
+}
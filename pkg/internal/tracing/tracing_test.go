@@ -0,0 +1,45 @@
+package tracing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ToExporter(t *testing.T) {
+	for _, valid := range []string{"otlp", "OTLP", "file"} {
+		t.Run(valid, func(t *testing.T) {
+			_, err := ToExporter(valid)
+			require.NoError(t, err)
+		})
+	}
+
+	_, err := ToExporter("jaeger")
+	require.ErrorContains(t, err, "invalid tracing exporter: jaeger")
+}
+
+func Test_NewTracerProvider_file(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.json")
+
+	tracerProvider, err := NewTracerProvider(context.Background(), Config{Exporter: FileExporter, FilePath: path})
+	require.NoError(t, err)
+
+	_, span := tracerProvider.Tracer("test").Start(context.Background(), "SHOW TABLES")
+	span.End()
+	require.NoError(t, Shutdown(context.Background()))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `"Name":"SHOW TABLES"`)
+	assert.Contains(t, string(content), serviceName)
+}
+
+func Test_NewTracerProvider_fileWithoutPath(t *testing.T) {
+	_, err := NewTracerProvider(context.Background(), Config{Exporter: FileExporter})
+
+	require.ErrorContains(t, err, "file path is required")
+}
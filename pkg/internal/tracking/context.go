@@ -34,6 +34,30 @@ type Metadata struct {
 	Resource      string    `json:"resource,omitempty"`
 	Datasource    string    `json:"datasource,omitempty"`
 	Operation     Operation `json:"operation,omitempty"`
+
+	// The fields below are optional and describe where the object is managed in the Terraform configuration.
+	Workspace     string `json:"workspace,omitempty"`
+	ModuleAddress string `json:"module_address,omitempty"`
+	// ResourceId is the id of the resource (e.g. "DB"). Terraform does not pass the address of the resource from the configuration
+	// (e.g. snowflake_database.main) to the provider, so the id together with the resource type identifies the managed object.
+	ResourceId string `json:"resource_id,omitempty"`
+}
+
+// TerraformContext holds the Terraform context set in the provider configuration, which is added to the metadata of every resource and data source.
+type TerraformContext struct {
+	Workspace     string
+	ModuleAddress string
+}
+
+// WithTerraformContext returns the copy of the metadata with the given Terraform context and the resource id.
+// The resource id is left empty for data sources and for resources without the id (e.g. before they are created).
+func (m Metadata) WithTerraformContext(terraformContext TerraformContext, resourceId string) Metadata {
+	m.Workspace = terraformContext.Workspace
+	m.ModuleAddress = terraformContext.ModuleAddress
+	if m.Resource != "" {
+		m.ResourceId = resourceId
+	}
+	return m
 }
 
 func (m Metadata) validate() error {
@@ -45,3 +45,29 @@ func Test_Context(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, newMetadata, retrievedMetadata)
 }
+
+func Test_Metadata_WithTerraformContext(t *testing.T) {
+	terraformContext := TerraformContext{Workspace: "prod", ModuleAddress: "module.warehouses"}
+
+	t.Run("resource with id", func(t *testing.T) {
+		metadata := newTestMetadata("123", resources.Database, ReadOperation).WithTerraformContext(terraformContext, `"DB"`)
+
+		require.Equal(t, "prod", metadata.Workspace)
+		require.Equal(t, "module.warehouses", metadata.ModuleAddress)
+		require.Equal(t, `"DB"`, metadata.ResourceId)
+	})
+
+	t.Run("resource without id", func(t *testing.T) {
+		metadata := newTestMetadata("123", resources.Database, CreateOperation).WithTerraformContext(terraformContext, "")
+
+		require.Equal(t, "prod", metadata.Workspace)
+		require.Empty(t, metadata.ResourceId)
+	})
+
+	t.Run("data source", func(t *testing.T) {
+		metadata := NewVersionedDatasourceMetadata(datasources.Databases).WithTerraformContext(terraformContext, `"DB"`)
+
+		require.Equal(t, "module.warehouses", metadata.ModuleAddress)
+		require.Empty(t, metadata.ResourceId)
+	})
+}
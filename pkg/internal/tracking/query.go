@@ -20,7 +20,21 @@ func AppendMetadata(sql string, metadata Metadata) (string, error) {
 	}
 }
 
+// MetadataQueryTag returns the metadata in the form used for the QUERY_TAG parameter, instead of the comment appended to the query.
+func MetadataQueryTag(metadata Metadata) (string, error) {
+	bytes, err := json.Marshal(metadata)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the metadata: %w", err)
+	}
+	return fmt.Sprintf("%s %s", MetadataPrefix, string(bytes)), nil
+}
+
+// ParseMetadata parses the metadata either from the query text with the metadata comment (see AppendMetadata),
+// or from the query tag (see MetadataQueryTag).
 func ParseMetadata(sql string) (Metadata, error) {
+	if strings.HasPrefix(sql, MetadataPrefix) {
+		sql = "--" + sql
+	}
 	parts := strings.Split(sql, fmt.Sprintf("--%s", MetadataPrefix))
 	if len(parts) != 2 {
 		return Metadata{}, fmt.Errorf("failed to parse metadata from sql, incorrect number of parts, expected: 2, got: %d", len(parts))
@@ -71,6 +71,31 @@ func TestParseMetadata(t *testing.T) {
 	require.Equal(t, metadata, parsedMetadata)
 }
 
+func TestParseMetadataWithTerraformContext(t *testing.T) {
+	metadata := newTestMetadata("123", resources.Database, UpdateOperation).WithTerraformContext(TerraformContext{Workspace: "prod", ModuleAddress: "module.databases"}, `"DB"`)
+	bytes, err := json.Marshal(metadata)
+	require.NoError(t, err)
+	sql := fmt.Sprintf("ALTER DATABASE \"DB\" SET COMMENT = 'abc' --%s %s", MetadataPrefix, string(bytes))
+
+	parsedMetadata, err := ParseMetadata(sql)
+	require.NoError(t, err)
+	require.Equal(t, metadata, parsedMetadata)
+}
+
+func TestMetadataQueryTag(t *testing.T) {
+	metadata := newTestMetadata("123", resources.Account, CreateOperation)
+	bytes, err := json.Marshal(metadata)
+	require.NoError(t, err)
+
+	queryTag, err := MetadataQueryTag(metadata)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("%s %s", MetadataPrefix, string(bytes)), queryTag)
+
+	parsedMetadata, err := ParseMetadata(queryTag)
+	require.NoError(t, err)
+	require.Equal(t, metadata, parsedMetadata)
+}
+
 func TestParseInvalidMetadataKeys(t *testing.T) {
 	sql := fmt.Sprintf(`SELECT 1 --%s {"key": "value"}`, MetadataPrefix)
 
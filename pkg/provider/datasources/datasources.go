@@ -15,11 +15,13 @@ const (
 	DatabaseRoles                  datasource = "snowflake_database_roles"
 	Databases                      datasource = "snowflake_databases"
 	DynamicTables                  datasource = "snowflake_dynamic_tables"
+	EffectivePrivileges            datasource = "snowflake_effective_privileges"
 	ExternalFunctions              datasource = "snowflake_external_functions"
 	ExternalTables                 datasource = "snowflake_external_tables"
 	FailoverGroups                 datasource = "snowflake_failover_groups"
 	FileFormats                    datasource = "snowflake_file_formats"
 	Functions                      datasource = "snowflake_functions"
+	GrantImports                   datasource = "snowflake_grant_imports"
 	Grants                         datasource = "snowflake_grants"
 	MaskingPolicies                datasource = "snowflake_masking_policies"
 	MaterializedViews              datasource = "snowflake_materialized_views"
@@ -28,6 +30,7 @@ const (
 	Pipes                          datasource = "snowflake_pipes"
 	Procedures                     datasource = "snowflake_procedures"
 	ResourceMonitors               datasource = "snowflake_resource_monitors"
+	RoleGraph                      datasource = "snowflake_role_graph"
 	RowAccessPolicies              datasource = "snowflake_row_access_policies"
 	Schemas                        datasource = "snowflake_schemas"
 	Secrets                        datasource = "snowflake_secrets"
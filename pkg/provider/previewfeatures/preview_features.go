@@ -22,6 +22,7 @@ const (
 	DatabaseRoleDatasource                        feature = "snowflake_database_role_datasource"
 	DynamicTableResource                          feature = "snowflake_dynamic_table_resource"
 	DynamicTablesDatasource                       feature = "snowflake_dynamic_tables_datasource"
+	EffectivePrivilegesDatasource                 feature = "snowflake_effective_privileges_datasource"
 	ExternalFunctionResource                      feature = "snowflake_external_function_resource"
 	ExternalFunctionsDatasource                   feature = "snowflake_external_functions_datasource"
 	ExternalTableResource                         feature = "snowflake_external_table_resource"
@@ -37,6 +38,11 @@ const (
 	FunctionScalaResource                         feature = "snowflake_function_scala_resource"
 	FunctionSqlResource                           feature = "snowflake_function_sql_resource"
 	FunctionsDatasource                           feature = "snowflake_functions_datasource"
+	GrantCallerPrivilegesResource                 feature = "snowflake_grant_caller_privileges_resource"
+	GrantImportsDatasource                        feature = "snowflake_grant_imports_datasource"
+	GrantFuturePrivilegesInDatabaseResource       feature = "snowflake_grant_future_privileges_in_database_resource"
+	GrantPrivilegesInBulkResource                 feature = "snowflake_grant_privileges_in_bulk_resource"
+	GrantPrivilegesToApplicationRoleResource      feature = "snowflake_grant_privileges_to_application_role_resource"
 	ManagedAccountResource                        feature = "snowflake_managed_account_resource"
 	MaterializedViewResource                      feature = "snowflake_materialized_view_resource"
 	MaterializedViewsDatasource                   feature = "snowflake_materialized_views_datasource"
@@ -54,6 +60,8 @@ const (
 	ProcedureScalaResource                        feature = "snowflake_procedure_scala_resource"
 	ProcedureSqlResource                          feature = "snowflake_procedure_sql_resource"
 	ProceduresDatasource                          feature = "snowflake_procedures_datasource"
+	RoleGraphDatasource                           feature = "snowflake_role_graph_datasource"
+	RolePrivilegesResource                        feature = "snowflake_role_privileges_resource"
 	CurrentRoleDatasource                         feature = "snowflake_current_role_datasource"
 	SequenceResource                              feature = "snowflake_sequence_resource"
 	SequencesDatasource                           feature = "snowflake_sequences_datasource"
@@ -91,6 +99,7 @@ var allPreviewFeatures = []feature{
 	DatabaseRoleDatasource,
 	DynamicTableResource,
 	DynamicTablesDatasource,
+	EffectivePrivilegesDatasource,
 	ExternalFunctionResource,
 	ExternalFunctionsDatasource,
 	ExternalTableResource,
@@ -106,6 +115,11 @@ var allPreviewFeatures = []feature{
 	FunctionScalaResource,
 	FunctionSqlResource,
 	FunctionsDatasource,
+	GrantCallerPrivilegesResource,
+	GrantImportsDatasource,
+	GrantFuturePrivilegesInDatabaseResource,
+	GrantPrivilegesInBulkResource,
+	GrantPrivilegesToApplicationRoleResource,
 	ManagedAccountResource,
 	MaterializedViewResource,
 	MaterializedViewsDatasource,
@@ -129,6 +143,8 @@ var allPreviewFeatures = []feature{
 	ProcedureScalaResource,
 	ProcedureSqlResource,
 	ProceduresDatasource,
+	RoleGraphDatasource,
+	RolePrivilegesResource,
 	StageResource,
 	StagesDatasource,
 	StorageIntegrationResource,
@@ -14,6 +14,8 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider/docs"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider/validators"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/tracing"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/tracking"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -344,6 +346,13 @@ func Provider() *schema.Provider {
 				DefaultFunc:      schema.EnvDefaultFunc(snowflakeenvs.MaxRetryCount, nil),
 				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
 			},
+			"transient_errors_max_retries": {
+				Type:             schema.TypeInt,
+				Description:      envNameFieldDescription("Specifies how many times the provider retries the statements that failed with a transient error (lock contention on the same object, partially executed grant, or the service being temporarily unavailable after the driver retries). The retries are delayed with exponential backoff with jitter. Set to 0 to disable the retries. The default is 3.", snowflakeenvs.TransientErrorsMaxRetries),
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc(snowflakeenvs.TransientErrorsMaxRetries, sdk.DefaultRetryPolicy.MaxRetries),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
 			"driver_tracing": {
 				Type:             schema.TypeString,
 				Description:      envNameFieldDescription(fmt.Sprintf("Specifies the logging level to be used by the driver. Valid options are: %v.", docs.PossibleValuesListed(sdk.AllDriverLogLevels)), snowflakeenvs.DriverTracing),
@@ -351,6 +360,25 @@ func Provider() *schema.Provider {
 				DefaultFunc:      schema.EnvDefaultFunc(snowflakeenvs.DriverTracing, nil),
 				ValidateDiagFunc: validators.NormalizeValidation(sdk.ToDriverLogLevel),
 			},
+			"tracing_exporter": {
+				Type:             schema.TypeString,
+				Description:      envNameFieldDescription(fmt.Sprintf("Enables the OpenTelemetry tracing of the statements run by the provider. Every statement is recorded as a span with the statement kind, object type, Snowflake query id, error class, and the resource and operation that ran it (the statement text is not recorded). Valid options are: %v. The `otlp` exporter sends the spans to `tracing_otlp_endpoint` over HTTP, and the `file` exporter appends them as JSON to `tracing_file_path`. The tracing is disabled by default.", docs.PossibleValuesListed(tracing.AllExporters)), snowflakeenvs.TracingExporter),
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc(snowflakeenvs.TracingExporter, nil),
+				ValidateDiagFunc: validators.NormalizeValidation(tracing.ToExporter),
+			},
+			"tracing_file_path": {
+				Type:        schema.TypeString,
+				Description: envNameFieldDescription("Specifies the path of the file to which the spans are appended when `tracing_exporter` is set to `file`.", snowflakeenvs.TracingFilePath),
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(snowflakeenvs.TracingFilePath, nil),
+			},
+			"tracing_otlp_endpoint": {
+				Type:        schema.TypeString,
+				Description: envNameFieldDescription("Specifies the URL of the OTLP endpoint (e.g. `http://localhost:4318/v1/traces`) to which the spans are sent when `tracing_exporter` is set to `otlp`. If not set, the standard `OTEL_EXPORTER_OTLP_ENDPOINT` and `OTEL_EXPORTER_OTLP_TRACES_ENDPOINT` environment variables are used.", snowflakeenvs.TracingOtlpEndpoint),
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(snowflakeenvs.TracingOtlpEndpoint, nil),
+			},
 			"tmp_directory_path": {
 				Type:        schema.TypeString,
 				Description: envNameFieldDescription("Sets temporary directory used by the driver for operations like encrypting, compressing etc.", snowflakeenvs.TmpDirectoryPath),
@@ -388,6 +416,36 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc(snowflakeenvs.SkipTomlFilePermissionVerification, false),
 			},
+			"sql_preview": {
+				Type:        schema.TypeBool,
+				Description: envNameFieldDescription("False by default. When this is set to true, the SQL statements that will be executed during the apply are generated during the plan (using the client that does not connect to Snowflake) and shown in the plan in the computed `sql_preview` field of the resources. The statements are generated for the created, updated, and replaced resources. The preview may be incomplete for statements that depend on the results of other queries.", snowflakeenvs.SqlPreview),
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(snowflakeenvs.SqlPreview, false),
+			},
+			"cache_show_results": {
+				Type:        schema.TypeBool,
+				Description: envNameFieldDescription("False by default. When this is set to true, the provider lists the objects in a database or schema once per run (e.g. `SHOW TABLES IN SCHEMA`) and serves the reads of the objects in the same container from the cached result, instead of running a separate `SHOW ... LIKE` for every object. The cached results are discarded after every statement that references the container. The cache is used for schemas, database roles, tables, views, streams, and tasks. It can speed up the plans with many objects in the same containers, but it can return stale results when the objects are changed outside of Terraform during the run.", snowflakeenvs.CacheShowResults),
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(snowflakeenvs.CacheShowResults, false),
+			},
+			"usage_tracking_workspace": {
+				Type:        schema.TypeString,
+				Description: envNameFieldDescription("Specifies the Terraform workspace added to the usage tracking metadata of every query run by the provider (e.g. `terraform.workspace`), so the queries can be attributed in the query history.", snowflakeenvs.UsageTrackingWorkspace),
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(snowflakeenvs.UsageTrackingWorkspace, nil),
+			},
+			"usage_tracking_module_address": {
+				Type:        schema.TypeString,
+				Description: envNameFieldDescription("Specifies the address of the Terraform module (e.g. `module.warehouses`) added to the usage tracking metadata of every query run by the provider. The metadata also contains the resource id (Terraform does not pass the address of the resource from the configuration to the provider).", snowflakeenvs.UsageTrackingModuleAddress),
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(snowflakeenvs.UsageTrackingModuleAddress, nil),
+			},
+			"usage_tracking_as_query_tag": {
+				Type:        schema.TypeBool,
+				Description: envNameFieldDescription("False by default. When this is set to true, the usage tracking metadata is sent as the `QUERY_TAG` of the queries, instead of the comment appended to the query text. Keep in mind that it overrides the `QUERY_TAG` set for the user or the session.", snowflakeenvs.UsageTrackingAsQueryTag),
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(snowflakeenvs.UsageTrackingAsQueryTag, false),
+			},
 			"use_legacy_toml_file": {
 				Type:        schema.TypeBool,
 				Description: envNameFieldDescription("False by default. When this is set to true, the provider expects the legacy TOML format. Otherwise, it expects the new format. See more in [the section below](#examples)", snowflakeenvs.UseLegacyTomlFile),
@@ -395,7 +453,7 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc(snowflakeenvs.UseLegacyTomlFile, false),
 			},
 		},
-		ResourcesMap:         getResources(),
+		ResourcesMap:         withSqlPreview(getResources()),
 		DataSourcesMap:       getDataSources(),
 		ConfigureContextFunc: ConfigureProvider,
 		ProviderMetaSchema:   map[string]*schema.Schema{},
@@ -434,9 +492,13 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_function_sql":                                                 resources.FunctionSql(),
 		"snowflake_grant_account_role":                                           resources.GrantAccountRole(),
 		"snowflake_grant_application_role":                                       resources.GrantApplicationRole(),
+		"snowflake_grant_caller_privileges":                                      resources.GrantCallerPrivileges(),
 		"snowflake_grant_database_role":                                          resources.GrantDatabaseRole(),
+		"snowflake_grant_future_privileges_in_database":                          resources.GrantFuturePrivilegesInDatabase(),
 		"snowflake_grant_ownership":                                              resources.GrantOwnership(),
+		"snowflake_grant_privileges_in_bulk":                                     resources.GrantPrivilegesInBulk(),
 		"snowflake_grant_privileges_to_account_role":                             resources.GrantPrivilegesToAccountRole(),
+		"snowflake_grant_privileges_to_application_role":                         resources.GrantPrivilegesToApplicationRole(),
 		"snowflake_grant_privileges_to_database_role":                            resources.GrantPrivilegesToDatabaseRole(),
 		"snowflake_grant_privileges_to_share":                                    resources.GrantPrivilegesToShare(),
 		"snowflake_legacy_service_user":                                          resources.LegacyServiceUser(),
@@ -459,6 +521,7 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_procedure_scala":                                              resources.ProcedureScala(),
 		"snowflake_procedure_sql":                                                resources.ProcedureSql(),
 		"snowflake_resource_monitor":                                             resources.ResourceMonitor(),
+		"snowflake_role_privileges":                                              resources.RolePrivileges(),
 		"snowflake_row_access_policy":                                            resources.RowAccessPolicy(),
 		"snowflake_saml2_integration":                                            resources.SAML2Integration(),
 		"snowflake_schema":                                                       resources.Schema(),
@@ -509,11 +572,13 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_database_roles":                     datasources.DatabaseRoles(),
 		"snowflake_databases":                          datasources.Databases(),
 		"snowflake_dynamic_tables":                     datasources.DynamicTables(),
+		"snowflake_effective_privileges":               datasources.EffectivePrivileges(),
 		"snowflake_external_functions":                 datasources.ExternalFunctions(),
 		"snowflake_external_tables":                    datasources.ExternalTables(),
 		"snowflake_failover_groups":                    datasources.FailoverGroups(),
 		"snowflake_file_formats":                       datasources.FileFormats(),
 		"snowflake_functions":                          datasources.Functions(),
+		"snowflake_grant_imports":                      datasources.GrantImports(),
 		"snowflake_grants":                             datasources.Grants(),
 		"snowflake_masking_policies":                   datasources.MaskingPolicies(),
 		"snowflake_materialized_views":                 datasources.MaterializedViews(),
@@ -522,6 +587,7 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_pipes":                              datasources.Pipes(),
 		"snowflake_procedures":                         datasources.Procedures(),
 		"snowflake_resource_monitors":                  datasources.ResourceMonitors(),
+		"snowflake_role_graph":                         datasources.RoleGraph(),
 		"snowflake_row_access_policies":                datasources.RowAccessPolicies(),
 		"snowflake_schemas":                            datasources.Schemas(),
 		"snowflake_secrets":                            datasources.Secrets(),
@@ -546,6 +612,18 @@ func getDataSources() map[string]*schema.Resource {
 }
 
 func ConfigureProvider(ctx context.Context, s *schema.ResourceData) (any, diag.Diagnostics) {
+	return configureProvider(ctx, s, nil)
+}
+
+// ConfigureProviderWithConnector returns the provider configuration creating the client with the connector factory (see sdk.NewClientWithConnector),
+// e.g. to replay the statements from a cassette in the tests.
+func ConfigureProviderWithConnector(factory sdk.ConnectorFactory) schema.ConfigureContextFunc {
+	return func(ctx context.Context, s *schema.ResourceData) (any, diag.Diagnostics) {
+		return configureProvider(ctx, s, factory)
+	}
+}
+
+func configureProvider(ctx context.Context, s *schema.ResourceData, factory sdk.ConnectorFactory) (any, diag.Diagnostics) {
 	config, err := getDriverConfigFromTerraform(s)
 	if err != nil {
 		return nil, diag.FromErr(err)
@@ -572,7 +650,7 @@ func ConfigureProvider(ctx context.Context, s *schema.ResourceData) (any, diag.D
 		config = sdk.MergeConfig(config, tomlConfig)
 	}
 
-	client, clientErr := sdk.NewClient(config)
+	client, clientErr := sdk.NewClientWithConnector(config, factory)
 
 	providerCtx := &provider.Context{Client: client}
 
@@ -580,13 +658,61 @@ func ConfigureProvider(ctx context.Context, s *schema.ResourceData) (any, diag.D
 		providerCtx.EnabledFeatures = expandStringList(v.(*schema.Set).List())
 	}
 
+	providerCtx.SqlPreview = s.Get("sql_preview").(bool)
+
+	providerCtx.TerraformContext = tracking.TerraformContext{
+		Workspace:     s.Get("usage_tracking_workspace").(string),
+		ModuleAddress: s.Get("usage_tracking_module_address").(string),
+	}
+
 	if clientErr != nil {
 		return nil, diag.FromErr(clientErr)
 	}
 
+	if s.Get("cache_show_results").(bool) {
+		client.EnableShowCache()
+	}
+
+	retryPolicy := sdk.DefaultRetryPolicy
+	retryPolicy.MaxRetries = s.Get("transient_errors_max_retries").(int)
+	client.SetRetryPolicy(retryPolicy)
+
+	client.SetTrackingMetadataAsQueryTag(s.Get("usage_tracking_as_query_tag").(bool))
+
+	if v, ok := s.GetOk("tracing_exporter"); ok && v.(string) != "" {
+		exporter, err := tracing.ToExporter(v.(string))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		tracerProvider, err := tracing.NewTracerProvider(ctx, tracing.Config{
+			Exporter:     exporter,
+			OtlpEndpoint: s.Get("tracing_otlp_endpoint").(string),
+			FilePath:     s.Get("tracing_file_path").(string),
+		})
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		client.SetTracerProvider(tracerProvider)
+	}
+
 	return providerCtx, nil
 }
 
+// ShutdownTracing flushes the spans recorded by the clients of all the configured providers. It should be called before the provider process exits.
+func ShutdownTracing(ctx context.Context) error {
+	return tracing.Shutdown(ctx)
+}
+
+// ConfiguredClient returns the client created during the configuration of the given provider.
+// It returns nil when the provider is not configured yet.
+func ConfiguredClient(p *schema.Provider) *sdk.Client {
+	providerCtx, ok := p.Meta().(*provider.Context)
+	if !ok || providerCtx == nil {
+		return nil
+	}
+	return providerCtx.Client
+}
+
 // TODO: reuse with the function from resources package
 func expandStringList(configured []interface{}) []string {
 	vs := make([]string, 0, len(configured))
@@ -28,9 +28,13 @@ const (
 	FileFormat                                             resource = "snowflake_file_format"
 	GrantAccountRole                                       resource = "snowflake_grant_account_role"
 	GrantApplicationRole                                   resource = "snowflake_grant_application_role"
+	GrantCallerPrivileges                                  resource = "snowflake_grant_caller_privileges"
 	GrantDatabaseRole                                      resource = "snowflake_grant_database_role"
+	GrantFuturePrivilegesInDatabase                        resource = "snowflake_grant_future_privileges_in_database"
 	GrantOwnership                                         resource = "snowflake_grant_ownership"
+	GrantPrivilegesInBulk                                  resource = "snowflake_grant_privileges_in_bulk"
 	GrantPrivilegesToAccountRole                           resource = "snowflake_grant_privileges_to_account_role"
+	GrantPrivilegesToApplicationRole                       resource = "snowflake_grant_privileges_to_application_role"
 	GrantPrivilegesToDatabaseRole                          resource = "snowflake_grant_privileges_to_database_role"
 	GrantPrivilegesToShare                                 resource = "snowflake_grant_privileges_to_share"
 	FunctionJava                                           resource = "snowflake_function_java"
@@ -59,6 +63,7 @@ const (
 	ProcedureScala                                         resource = "snowflake_procedure_scala"
 	ProcedureSql                                           resource = "snowflake_procedure_sql"
 	ResourceMonitor                                        resource = "snowflake_resource_monitor"
+	RolePrivileges                                         resource = "snowflake_role_privileges"
 	RowAccessPolicy                                        resource = "snowflake_row_access_policy"
 	SamlSecurityIntegration                                resource = "snowflake_saml_integration"
 	Saml2SecurityIntegration                               resource = "snowflake_saml2_integration"
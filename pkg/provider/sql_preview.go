@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"maps"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider/sdkv2enhancements"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type sqlPreviewOperation string
+
+const (
+	sqlPreviewCreateOperation  sqlPreviewOperation = "CREATE"
+	sqlPreviewUpdateOperation  sqlPreviewOperation = "UPDATE"
+	sqlPreviewReplaceOperation sqlPreviewOperation = "REPLACE"
+)
+
+const sqlPreviewAttribute = "sql_preview"
+
+// withSqlPreview adds the SQL preview to all the given resources. When enabled in the provider configuration,
+// the create or update of the resource is run during the plan against the dry-run client, and the collected SQL statements are set
+// in the computed sql_preview attribute, so that they are shown in the plan.
+func withSqlPreview(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for resourceName, resource := range resources {
+		if resource.CreateContext == nil || resource.Schema == nil {
+			continue
+		}
+		// the schema maps can be shared with other resources or data sources
+		resource.Schema = maps.Clone(resource.Schema)
+		resource.Schema[sqlPreviewAttribute] = &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The SQL statements generated during the last plan that created, updated, or replaced the resource. Set only when `sql_preview` is enabled in the provider configuration. The preview may be incomplete for statements that depend on the results of other queries.",
+		}
+		existingCustomizeDiff := resource.CustomizeDiff
+		resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+			if existingCustomizeDiff != nil {
+				if err := existingCustomizeDiff(ctx, d, meta); err != nil {
+					return err
+				}
+			}
+			if providerCtx, ok := meta.(*provider.Context); ok && providerCtx.SqlPreview {
+				return setSqlPreview(ctx, resourceName, resource, d, providerCtx)
+			}
+			return nil
+		}
+	}
+	return resources
+}
+
+func setSqlPreview(ctx context.Context, resourceName string, resource *schema.Resource, diff *schema.ResourceDiff, providerCtx *provider.Context) error {
+	operation, ok := sqlPreviewOperationFor(resource, diff)
+	if !ok {
+		return nil
+	}
+	d, ok := sdkv2enhancements.CreateResourceDataFromResourceDiff(resource.SchemaMap(), diff)
+	if !ok {
+		log.Printf("[DEBUG] Could not generate SQL preview for %s", resourceName)
+		return nil
+	}
+	var prior *schema.ResourceData
+	if operation == sqlPreviewReplaceOperation {
+		// the object is dropped with the values from the state, e.g. the old name of the renamed object
+		if prior, ok = sdkv2enhancements.CreateResourceDataFromResourceDiffState(resource.SchemaMap(), diff); !ok {
+			log.Printf("[DEBUG] Could not generate SQL preview for %s", resourceName)
+			return nil
+		}
+	}
+	statements := dryRunStatements(ctx, resource, d, prior, operation, providerCtx)
+	log.Printf("[DEBUG] %s", formatSqlPreview(operation, resourceName, d.Id(), statements))
+	return diff.SetNew(sqlPreviewAttribute, statements)
+}
+
+// sqlPreviewOperationFor returns the operation that will be run during the apply, or false if there is nothing to preview.
+func sqlPreviewOperationFor(resource *schema.Resource, diff *schema.ResourceDiff) (sqlPreviewOperation, bool) {
+	if diff.Id() == "" {
+		return sqlPreviewCreateOperation, true
+	}
+	changedKeys := diff.GetChangedKeysPrefix("")
+	if len(changedKeys) == 0 {
+		return "", false
+	}
+	for _, key := range changedKeys {
+		attributeName, _, _ := strings.Cut(key, ".")
+		if attributeSchema, ok := resource.SchemaMap()[attributeName]; ok && attributeSchema.ForceNew {
+			return sqlPreviewReplaceOperation, true
+		}
+	}
+	if resource.UpdateContext == nil {
+		return "", false
+	}
+	return sqlPreviewUpdateOperation, true
+}
+
+// dryRunStatements runs the resource operation against the dry-run client and returns all the collected statements.
+// For the replacement, the delete is run on prior (the data built from the state) and the create on d (the data built from the plan).
+// The errors are ignored, because the dry-run client returns no results for the queries (e.g. the object won't be found after creation).
+func dryRunStatements(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, prior *schema.ResourceData, operation sqlPreviewOperation, providerCtx *provider.Context) (statements []string) {
+	client := sdk.NewDryRunClient()
+	dryRunCtx := &provider.Context{
+		Client:          client,
+		EnabledFeatures: providerCtx.EnabledFeatures,
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("[DEBUG] SQL preview stopped early: %v", r)
+		}
+		statements = client.TraceLogs()
+	}()
+
+	switch operation {
+	case sqlPreviewCreateOperation:
+		_ = resource.CreateContext(ctx, d, dryRunCtx)
+	case sqlPreviewUpdateOperation:
+		_ = resource.UpdateContext(ctx, d, dryRunCtx)
+	case sqlPreviewReplaceOperation:
+		if resource.DeleteContext != nil && prior != nil {
+			_ = resource.DeleteContext(ctx, prior, dryRunCtx)
+		}
+		_ = resource.CreateContext(ctx, d, dryRunCtx)
+	}
+	return
+}
+
+func formatSqlPreview(operation sqlPreviewOperation, resourceName string, id string, statements []string) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("[ SQL PREVIEW %s %s %s ]", operation, resourceName, id))
+	for _, statement := range statements {
+		sb.WriteString(fmt.Sprintf("\n  - %s", statement))
+	}
+	return sb.String()
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Provider_dryRunStatements(t *testing.T) {
+	resource := resources.AccountRole()
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]any{
+		"name":    "ROLE",
+		"comment": "some comment",
+	})
+
+	statements := dryRunStatements(context.Background(), resource, d, nil, sqlPreviewCreateOperation, &provider.Context{})
+
+	require.NotEmpty(t, statements)
+	assert.Equal(t, `CREATE ROLE "ROLE" COMMENT = 'some comment'`, statements[0])
+}
+
+func Test_Provider_dryRunStatements_replace(t *testing.T) {
+	resource := resources.AccountRole()
+	prior := schema.TestResourceDataRaw(t, resource.Schema, map[string]any{
+		"name": "OLD_ROLE",
+	})
+	prior.SetId(`"OLD_ROLE"`)
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]any{
+		"name": "NEW_ROLE",
+	})
+	d.SetId(`"OLD_ROLE"`)
+
+	statements := dryRunStatements(context.Background(), resource, d, prior, sqlPreviewReplaceOperation, &provider.Context{})
+
+	assert.Contains(t, statements, `DROP ROLE IF EXISTS "OLD_ROLE"`)
+	assert.Contains(t, statements, `CREATE ROLE "NEW_ROLE"`)
+	assert.NotContains(t, statements, `DROP ROLE IF EXISTS "NEW_ROLE"`)
+}
+
+func Test_Provider_withSqlPreview(t *testing.T) {
+	shared := map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Required: true},
+	}
+	resourcesMap := withSqlPreview(map[string]*schema.Resource{
+		"snowflake_with_create":    {Schema: shared, CreateContext: func(context.Context, *schema.ResourceData, any) diag.Diagnostics { return nil }},
+		"snowflake_without_create": {Schema: shared},
+	})
+
+	assert.Contains(t, resourcesMap["snowflake_with_create"].Schema, "sql_preview")
+	assert.True(t, resourcesMap["snowflake_with_create"].Schema["sql_preview"].Computed)
+	assert.NotContains(t, resourcesMap["snowflake_without_create"].Schema, "sql_preview")
+	assert.NotContains(t, shared, "sql_preview")
+}
+
+func Test_Provider_formatSqlPreview(t *testing.T) {
+	preview := formatSqlPreview(sqlPreviewUpdateOperation, "snowflake_database", "DB", []string{
+		`ALTER DATABASE "DB" RENAME TO "DB2"`,
+		`SHOW DATABASES LIKE 'DB2'`,
+	})
+
+	assert.Equal(t, "[ SQL PREVIEW UPDATE snowflake_database DB ]\n  - ALTER DATABASE \"DB\" RENAME TO \"DB2\"\n  - SHOW DATABASES LIKE 'DB2'", preview)
+}
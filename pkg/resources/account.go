@@ -144,7 +144,7 @@ var accountSchema = map[string]*schema.Schema{
 }
 
 func Account() *schema.Resource {
-	return &schema.Resource{
+	return withResourceIdentity(objectIdentity[sdk.AccountIdentifier](), &schema.Resource{
 		Description:   "The account resource allows you to create and manage Snowflake accounts. For more information, check [account documentation](https://docs.snowflake.com/en/user-guide/organizations-manage-accounts).",
 		CreateContext: TrackingCreateWrapper(resources.Account, CreateAccount),
 		ReadContext:   TrackingReadWrapper(resources.Account, ReadAccount(true)),
@@ -171,7 +171,7 @@ func Account() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportAccount(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
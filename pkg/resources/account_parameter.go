@@ -32,7 +32,7 @@ var accountParameterSchema = map[string]*schema.Schema{
 }
 
 func AccountParameter() *schema.Resource {
-	return &schema.Resource{
+	return withResourceIdentity(stringIdentity("key", "Name of the account parameter."), &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.AccountParameter, CreateAccountParameter),
 		ReadContext:   TrackingReadWrapper(resources.AccountParameter, ReadAccountParameter),
 		UpdateContext: TrackingUpdateWrapper(resources.AccountParameter, UpdateAccountParameter),
@@ -45,7 +45,7 @@ func AccountParameter() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 // CreateAccountParameter implements schema.CreateFunc.
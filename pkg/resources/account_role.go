@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
@@ -47,17 +48,18 @@ func AccountRole() *schema.Resource {
 		func(client *sdk.Client) DropSafelyFunc[sdk.AccountObjectIdentifier] { return client.Roles.DropSafely },
 	)
 
-	return &schema.Resource{
-		Schema: accountRoleSchema,
+	return withResourceIdentity(objectIdentity[sdk.AccountObjectIdentifier](), &schema.Resource{
+		Schema: collections.MergeMaps(accountRoleSchema, ownerRoleSchema),
 
-		CreateContext: TrackingCreateWrapper(resources.AccountRole, CreateAccountRole),
-		ReadContext:   TrackingReadWrapper(resources.AccountRole, ReadAccountRole),
+		CreateContext: TrackingCreateWrapper(resources.AccountRole, OwnerRoleWrapper(sdk.ObjectTypeRole, sdk.ParseAccountObjectIdentifier, ReadAccountRole, CreateAccountRole)),
+		ReadContext:   TrackingReadWrapper(resources.AccountRole, OwnerRoleReadWrapper(ReadAccountRole)),
 		DeleteContext: TrackingDeleteWrapper(resources.AccountRole, deleteFunc),
-		UpdateContext: TrackingUpdateWrapper(resources.AccountRole, UpdateAccountRole),
+		UpdateContext: TrackingUpdateWrapper(resources.AccountRole, OwnerRoleWrapper(sdk.ObjectTypeRole, sdk.ParseAccountObjectIdentifier, ReadAccountRole, UpdateAccountRole)),
 		Description:   "The resource is used for role management, where roles can be assigned privileges and, in turn, granted to users and other roles. When granted to roles they can create hierarchies of privilege structures. For more details, refer to the [official documentation](https://docs.snowflake.com/en/user-guide/security-access-control-overview).",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.AccountRole, customdiff.All(
 			ComputedIfAnyAttributeChanged(accountRoleSchema, ShowOutputAttributeName, "comment", "name"),
+			ownerRoleCustomDiff,
 			ComputedIfAnyAttributeChanged(accountRoleSchema, FullyQualifiedNameAttributeName, "name"),
 		)),
 
@@ -65,7 +67,7 @@ func AccountRole() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.AccountRole, ImportName[sdk.AccountObjectIdentifier]),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateAccountRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
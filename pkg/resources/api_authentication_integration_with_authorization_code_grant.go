@@ -34,10 +34,10 @@ var apiAuthAuthorizationCodeGrantSchema = func() map[string]*schema.Schema {
 }()
 
 func ApiAuthenticationIntegrationWithAuthorizationCodeGrant() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant, CreateContextApiAuthenticationIntegrationWithAuthorizationCodeGrant),
-		ReadContext:   TrackingReadWrapper(resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant, ReadContextApiAuthenticationIntegrationWithAuthorizationCodeGrant(true)),
-		UpdateContext: TrackingUpdateWrapper(resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant, UpdateContextApiAuthenticationIntegrationWithAuthorizationCodeGrant),
+	return withResourceIdentity(objectIdentity[sdk.AccountObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant, OwnerRoleWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextApiAuthenticationIntegrationWithAuthorizationCodeGrant(false), CreateContextApiAuthenticationIntegrationWithAuthorizationCodeGrant)),
+		ReadContext:   TrackingReadWrapper(resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant, OwnerRoleFromGrantsReadWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextApiAuthenticationIntegrationWithAuthorizationCodeGrant(true))),
+		UpdateContext: TrackingUpdateWrapper(resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant, OwnerRoleWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextApiAuthenticationIntegrationWithAuthorizationCodeGrant(false), UpdateContextApiAuthenticationIntegrationWithAuthorizationCodeGrant)),
 		DeleteContext: TrackingDeleteWrapper(resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant, DeleteSecurityIntegration),
 		Description:   "Resource used to manage api authentication security integration objects with authorization code grant. For more information, check [security integrations documentation](https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-api-auth).",
 
@@ -49,12 +49,12 @@ func ApiAuthenticationIntegrationWithAuthorizationCodeGrant() *schema.Resource {
 			ComputedIfAnyAttributeChanged(apiAuthAuthorizationCodeGrantSchema, DescribeOutputAttributeName, "enabled", "comment", "oauth_access_token_validity", "oauth_refresh_token_validity",
 				"oauth_client_auth_method", "oauth_authorization_endpoint", "oauth_token_endpoint", "oauth_allowed_scopes"),
 		)),
-		Schema: apiAuthAuthorizationCodeGrantSchema,
+		Schema: collections.MergeMaps(apiAuthAuthorizationCodeGrantSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant, ImportApiAuthenticationWithAuthorizationCodeGrant),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportApiAuthenticationWithAuthorizationCodeGrant(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
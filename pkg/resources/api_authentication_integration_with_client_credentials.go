@@ -29,14 +29,14 @@ var apiAuthClientCredentialsSchema = func() map[string]*schema.Schema {
 }()
 
 func ApiAuthenticationIntegrationWithClientCredentials() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.ApiAuthenticationIntegrationWithClientCredentials, CreateContextApiAuthenticationIntegrationWithClientCredentials),
-		ReadContext:   TrackingReadWrapper(resources.ApiAuthenticationIntegrationWithClientCredentials, ReadContextApiAuthenticationIntegrationWithClientCredentials(true)),
-		UpdateContext: TrackingUpdateWrapper(resources.ApiAuthenticationIntegrationWithClientCredentials, UpdateContextApiAuthenticationIntegrationWithClientCredentials),
+	return withResourceIdentity(objectIdentity[sdk.AccountObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.ApiAuthenticationIntegrationWithClientCredentials, OwnerRoleWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextApiAuthenticationIntegrationWithClientCredentials(false), CreateContextApiAuthenticationIntegrationWithClientCredentials)),
+		ReadContext:   TrackingReadWrapper(resources.ApiAuthenticationIntegrationWithClientCredentials, OwnerRoleFromGrantsReadWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextApiAuthenticationIntegrationWithClientCredentials(true))),
+		UpdateContext: TrackingUpdateWrapper(resources.ApiAuthenticationIntegrationWithClientCredentials, OwnerRoleWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextApiAuthenticationIntegrationWithClientCredentials(false), UpdateContextApiAuthenticationIntegrationWithClientCredentials)),
 		DeleteContext: TrackingDeleteWrapper(resources.ApiAuthenticationIntegrationWithClientCredentials, DeleteSecurityIntegration),
 		Description:   "Resource used to manage api authentication security integration objects with client credentials. For more information, check [security integrations documentation](https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-api-auth).",
 
-		Schema: apiAuthClientCredentialsSchema,
+		Schema: collections.MergeMaps(apiAuthClientCredentialsSchema, ownerRoleSchema),
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.ApiAuthenticationIntegrationWithClientCredentials, customdiff.All(
 			ForceNewIfChangeToEmptyString("oauth_token_endpoint"),
 			ForceNewIfChangeToEmptyString("oauth_client_auth_method"),
@@ -48,7 +48,7 @@ func ApiAuthenticationIntegrationWithClientCredentials() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.ApiAuthenticationIntegrationWithClientCredentials, ImportApiAuthenticationWithClientCredentials),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportApiAuthenticationWithClientCredentials(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
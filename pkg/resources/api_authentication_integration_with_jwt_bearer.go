@@ -32,14 +32,14 @@ var apiAuthJwtBearerSchema = func() map[string]*schema.Schema {
 }()
 
 func ApiAuthenticationIntegrationWithJwtBearer() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.ApiAuthenticationIntegrationWithJwtBearer, CreateContextApiAuthenticationIntegrationWithJwtBearer),
-		ReadContext:   TrackingReadWrapper(resources.ApiAuthenticationIntegrationWithJwtBearer, ReadContextApiAuthenticationIntegrationWithJwtBearer(true)),
-		UpdateContext: TrackingUpdateWrapper(resources.ApiAuthenticationIntegrationWithJwtBearer, UpdateContextApiAuthenticationIntegrationWithJwtBearer),
+	return withResourceIdentity(objectIdentity[sdk.AccountObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.ApiAuthenticationIntegrationWithJwtBearer, OwnerRoleWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextApiAuthenticationIntegrationWithJwtBearer(false), CreateContextApiAuthenticationIntegrationWithJwtBearer)),
+		ReadContext:   TrackingReadWrapper(resources.ApiAuthenticationIntegrationWithJwtBearer, OwnerRoleFromGrantsReadWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextApiAuthenticationIntegrationWithJwtBearer(true))),
+		UpdateContext: TrackingUpdateWrapper(resources.ApiAuthenticationIntegrationWithJwtBearer, OwnerRoleWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextApiAuthenticationIntegrationWithJwtBearer(false), UpdateContextApiAuthenticationIntegrationWithJwtBearer)),
 		DeleteContext: TrackingDeleteWrapper(resources.ApiAuthenticationIntegrationWithJwtBearer, DeleteSecurityIntegration),
 		Description:   "Resource used to manage api authentication security integration objects with jwt bearer. For more information, check [security integrations documentation](https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-api-auth).",
 
-		Schema: apiAuthJwtBearerSchema,
+		Schema: collections.MergeMaps(apiAuthJwtBearerSchema, ownerRoleSchema),
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.ApiAuthenticationIntegrationWithJwtBearer, customdiff.All(
 			ForceNewIfChangeToEmptyString("oauth_token_endpoint"),
 			ForceNewIfChangeToEmptyString("oauth_authorization_endpoint"),
@@ -52,7 +52,7 @@ func ApiAuthenticationIntegrationWithJwtBearer() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.ApiAuthenticationIntegrationWithJwtBearer, ImportApiAuthenticationWithJwtBearer),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportApiAuthenticationWithJwtBearer(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
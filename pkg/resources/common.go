@@ -118,44 +118,53 @@ func ImportName[T sdk.AccountObjectIdentifier | sdk.DatabaseObjectIdentifier | s
 	return []*schema.ResourceData{d}, nil
 }
 
+// newResourceMetadata returns the usage tracking metadata of the resource, with the Terraform context from the provider configuration.
+func newResourceMetadata(resourceName resources.Resource, operation tracking.Operation, id string, meta any) tracking.Metadata {
+	var terraformContext tracking.TerraformContext
+	if providerCtx, ok := meta.(*provider.Context); ok && providerCtx != nil {
+		terraformContext = providerCtx.TerraformContext
+	}
+	return tracking.NewVersionedResourceMetadata(resourceName, operation).WithTerraformContext(terraformContext, id)
+}
+
 func TrackingImportWrapper(resourceName resources.Resource, importImplementation schema.StateContextFunc) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-		ctx = tracking.NewContext(ctx, tracking.NewVersionedResourceMetadata(resourceName, tracking.ImportOperation))
+		ctx = tracking.NewContext(ctx, newResourceMetadata(resourceName, tracking.ImportOperation, d.Id(), meta))
 		return importImplementation(ctx, d, meta)
 	}
 }
 
 func TrackingCreateWrapper(resourceName resources.Resource, createImplementation schema.CreateContextFunc) schema.CreateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		ctx = tracking.NewContext(ctx, tracking.NewVersionedResourceMetadata(resourceName, tracking.CreateOperation))
+		ctx = tracking.NewContext(ctx, newResourceMetadata(resourceName, tracking.CreateOperation, d.Id(), meta))
 		return createImplementation(ctx, d, meta)
 	}
 }
 
 func TrackingReadWrapper(resourceName resources.Resource, readImplementation schema.ReadContextFunc) schema.ReadContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		ctx = tracking.NewContext(ctx, tracking.NewVersionedResourceMetadata(resourceName, tracking.ReadOperation))
+		ctx = tracking.NewContext(ctx, newResourceMetadata(resourceName, tracking.ReadOperation, d.Id(), meta))
 		return readImplementation(ctx, d, meta)
 	}
 }
 
 func TrackingUpdateWrapper(resourceName resources.Resource, updateImplementation schema.UpdateContextFunc) schema.UpdateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		ctx = tracking.NewContext(ctx, tracking.NewVersionedResourceMetadata(resourceName, tracking.UpdateOperation))
+		ctx = tracking.NewContext(ctx, newResourceMetadata(resourceName, tracking.UpdateOperation, d.Id(), meta))
 		return updateImplementation(ctx, d, meta)
 	}
 }
 
 func TrackingDeleteWrapper(resourceName resources.Resource, deleteImplementation schema.DeleteContextFunc) schema.DeleteContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-		ctx = tracking.NewContext(ctx, tracking.NewVersionedResourceMetadata(resourceName, tracking.DeleteOperation))
+		ctx = tracking.NewContext(ctx, newResourceMetadata(resourceName, tracking.DeleteOperation, d.Id(), meta))
 		return deleteImplementation(ctx, d, meta)
 	}
 }
 
 func TrackingCustomDiffWrapper(resourceName resources.Resource, customdiffImplementation schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	return func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
-		ctx = tracking.NewContext(ctx, tracking.NewVersionedResourceMetadata(resourceName, tracking.CustomDiffOperation))
+		ctx = tracking.NewContext(ctx, newResourceMetadata(resourceName, tracking.CustomDiffOperation, diff.Id(), meta))
 		return customdiffImplementation(ctx, diff, meta)
 	}
 }
@@ -100,14 +100,14 @@ func Database() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.Database, CreateDatabase),
-		UpdateContext: TrackingUpdateWrapper(resources.Database, UpdateDatabase),
-		ReadContext:   TrackingReadWrapper(resources.Database, ReadDatabase),
+	return withResourceIdentity(objectIdentity[sdk.AccountObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.Database, OwnerRoleWrapper(sdk.ObjectTypeDatabase, sdk.ParseAccountObjectIdentifier, ReadDatabase, CreateDatabase)),
+		UpdateContext: TrackingUpdateWrapper(resources.Database, OwnerRoleWrapper(sdk.ObjectTypeDatabase, sdk.ParseAccountObjectIdentifier, ReadDatabase, UpdateDatabase)),
+		ReadContext:   TrackingReadWrapper(resources.Database, OwnerRoleFromGrantsReadWrapper(sdk.ObjectTypeDatabase, sdk.ParseAccountObjectIdentifier, ReadDatabase)),
 		DeleteContext: TrackingDeleteWrapper(resources.Database, deleteFunc),
 		Description:   "Represents a standard database. If replication configuration is specified, the database is promoted to serve as a primary database for replication.",
 
-		Schema: collections.MergeMaps(databaseSchema, databaseParametersSchema),
+		Schema: collections.MergeMaps(databaseSchema, databaseParametersSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.Database, ImportName[sdk.AccountObjectIdentifier]),
 		},
@@ -127,7 +127,7 @@ func Database() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateDatabase(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
@@ -56,23 +57,24 @@ func DatabaseRole() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return withResourceIdentity(objectIdentity[sdk.DatabaseObjectIdentifier](), &schema.Resource{
 		SchemaVersion: 1,
 
-		CreateContext: TrackingCreateWrapper(resources.DatabaseRole, CreateDatabaseRole),
-		ReadContext:   TrackingReadWrapper(resources.DatabaseRole, ReadDatabaseRole),
-		UpdateContext: TrackingUpdateWrapper(resources.DatabaseRole, UpdateDatabaseRole),
+		CreateContext: TrackingCreateWrapper(resources.DatabaseRole, OwnerRoleWrapper(sdk.ObjectTypeDatabaseRole, sdk.ParseDatabaseObjectIdentifier, ReadDatabaseRole, CreateDatabaseRole)),
+		ReadContext:   TrackingReadWrapper(resources.DatabaseRole, OwnerRoleReadWrapper(ReadDatabaseRole)),
+		UpdateContext: TrackingUpdateWrapper(resources.DatabaseRole, OwnerRoleWrapper(sdk.ObjectTypeDatabaseRole, sdk.ParseDatabaseObjectIdentifier, ReadDatabaseRole, UpdateDatabaseRole)),
 		DeleteContext: TrackingDeleteWrapper(resources.DatabaseRole, deleteFunc),
 
 		Description: "Resource used to manage database roles. For more information, check [database roles documentation](https://docs.snowflake.com/en/sql-reference/sql/create-database-role).",
 
-		Schema: databaseRoleSchema,
+		Schema: collections.MergeMaps(databaseRoleSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.DatabaseRole, ImportName[sdk.DatabaseObjectIdentifier]),
 		},
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.DatabaseRole, customdiff.All(
 			ComputedIfAnyAttributeChanged(databaseRoleSchema, ShowOutputAttributeName, "comment", "name"),
+			ownerRoleCustomDiff,
 			ComputedIfAnyAttributeChanged(databaseRoleSchema, FullyQualifiedNameAttributeName, "name"),
 		)),
 
@@ -85,7 +87,7 @@ func DatabaseRole() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ReadDatabaseRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
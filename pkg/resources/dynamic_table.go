@@ -179,6 +179,8 @@ func DynamicTable() *schema.Resource {
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.DynamicTableResource), TrackingUpdateWrapper(resources.DynamicTable, UpdateDynamicTable)),
 		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.DynamicTableResource), TrackingDeleteWrapper(resources.DynamicTable, deleteFunc)),
 
+		Description: "Resource used to manage dynamic tables. The dynamic table is created asynchronously; when the creation (including the initial refresh) exceeds the `create` timeout set in the `timeouts` block, it is canceled in Snowflake.",
+
 		Schema: dynamicTableSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
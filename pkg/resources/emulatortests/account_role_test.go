@@ -0,0 +1,111 @@
+package emulatortests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeemulator"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/require"
+)
+
+// emulatedProviderFactories returns the provider factories for resource.UnitTest with the provider running its statements in the given emulator.
+func emulatedProviderFactories(emulator *snowflakeemulator.Emulator) map[string]func() (tfprotov5.ProviderServer, error) {
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"snowflake": func() (tfprotov5.ProviderServer, error) {
+			p := provider.Provider()
+			p.ConfigureContextFunc = provider.ConfigureProviderWithConnector(emulator.ConnectorFactory)
+			return p.GRPCProvider(), nil
+		},
+	}
+}
+
+// skipWithoutTerraform skips the test when the Terraform CLI used by resource.UnitTest is not available, instead of letting it download one.
+func skipWithoutTerraform(t *testing.T) {
+	t.Helper()
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" || os.Getenv("TF_ACC_TERRAFORM_VERSION") != "" {
+		return
+	}
+	if _, err := exec.LookPath("terraform"); err != nil {
+		t.Skip("Terraform CLI not found; add it to PATH or set TF_ACC_TERRAFORM_PATH")
+	}
+}
+
+func accountRoleConfig(comment string) string {
+	return fmt.Sprintf(`
+provider "snowflake" {
+  organization_name = "ORGANIZATION"
+  account_name      = "ACCOUNT"
+  user              = "USER"
+}
+
+resource "snowflake_account_role" "test" {
+  name    = "ROLE"
+  comment = "%s"
+}
+`, comment)
+}
+
+func TestAccountRole_unitTest(t *testing.T) {
+	skipWithoutTerraform(t)
+	emulator := snowflakeemulator.New()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: emulatedProviderFactories(emulator),
+		CheckDestroy: func(*terraform.State) error {
+			client, err := sdk.NewClientWithConnector(&gosnowflake.Config{Account: "account", User: "user"}, emulator.ConnectorFactory)
+			if err != nil {
+				return err
+			}
+			if _, err := client.Roles.ShowByID(context.Background(), sdk.NewAccountObjectIdentifier("ROLE")); !errors.Is(err, sdk.ErrObjectNotFound) {
+				return fmt.Errorf("role ROLE still exists, err = %w", err)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accountRoleConfig("comment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_account_role.test", "id", `"ROLE"`),
+					resource.TestCheckResourceAttr("snowflake_account_role.test", "comment", "comment"),
+					resource.TestCheckResourceAttr("snowflake_account_role.test", "show_output.0.comment", "comment"),
+				),
+			},
+			// the comment changed outside Terraform
+			{
+				PreConfig: func() {
+					require.NoError(t, emulator.Execute(`ALTER ROLE "ROLE" SET COMMENT = 'changed'`))
+				},
+				Config:             accountRoleConfig("comment"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: accountRoleConfig("comment"),
+				Check:  resource.TestCheckResourceAttr("snowflake_account_role.test", "show_output.0.comment", "comment"),
+			},
+			{
+				Config: accountRoleConfig("updated"),
+				Check:  resource.TestCheckResourceAttr("snowflake_account_role.test", "show_output.0.comment", "updated"),
+			},
+			// the role dropped outside Terraform
+			{
+				PreConfig: func() {
+					require.NoError(t, emulator.Execute(`DROP ROLE "ROLE"`))
+				},
+				Config:             accountRoleConfig("updated"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
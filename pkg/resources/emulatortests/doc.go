@@ -0,0 +1,4 @@
+// Package emulatortests contains the tests of the resources running against the in-memory emulator (see snowflakeemulator)
+// instead of a Snowflake account. They are kept outside the resources package, because its test binary links the acceptance
+// tests package, which needs the Snowflake config file to initialize.
+package emulatortests
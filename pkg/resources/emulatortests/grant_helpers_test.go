@@ -0,0 +1,64 @@
+package emulatortests
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadGrantPrivilegesToAccountRole_onAllDetectsNewObjects(t *testing.T) {
+	ctx := context.Background()
+	emulator, meta := newEmulatedProviderContext(t,
+		`CREATE ROLE "ROLE"`,
+		`CREATE DATABASE "DATABASE"`,
+		`CREATE TABLE "DATABASE"."PUBLIC"."FIRST" ("ID" NUMBER)`,
+		`GRANT SELECT, INSERT ON ALL TABLES IN SCHEMA "DATABASE"."PUBLIC" TO ROLE "ROLE"`,
+	)
+
+	d := schema.TestResourceDataRaw(t, resources.GrantPrivilegesToAccountRole().Schema, map[string]any{})
+	d.SetId(`"ROLE"|false|false|SELECT,INSERT|OnSchemaObject|OnAll|TABLES|InSchema|"DATABASE"."PUBLIC"`)
+
+	require.Empty(t, resources.ReadGrantPrivilegesToAccountRole(ctx, d, meta))
+	assert.ElementsMatch(t, []any{"SELECT", "INSERT"}, d.Get("privileges").(*schema.Set).List())
+
+	// the table created after the grant
+	require.NoError(t, emulator.Execute(`CREATE TABLE "DATABASE"."PUBLIC"."SECOND" ("ID" NUMBER)`))
+	require.NoError(t, emulator.Execute(`GRANT SELECT ON TABLE "DATABASE"."PUBLIC"."SECOND" TO ROLE "ROLE"`))
+
+	require.Empty(t, resources.ReadGrantPrivilegesToAccountRole(ctx, d, meta))
+	assert.ElementsMatch(t, []any{"SELECT"}, d.Get("privileges").(*schema.Set).List())
+}
+
+func TestReadGrantPrivilegesToAccountRole_onAllInDatabase(t *testing.T) {
+	ctx := context.Background()
+	emulator, meta := newEmulatedProviderContext(t,
+		`CREATE ROLE "ROLE"`,
+		`CREATE DATABASE "DATABASE"`,
+		`CREATE SCHEMA "DATABASE"."INFORMATION_SCHEMA"`,
+	)
+	for _, table := range []string{`"DATABASE"."PUBLIC"."FIRST"`, `"DATABASE"."PUBLIC"."SECOND"`, `"DATABASE"."INFORMATION_SCHEMA"."TABLES"`} {
+		require.NoError(t, emulator.Execute(`CREATE TABLE `+table+` ("ID" NUMBER)`))
+	}
+	require.NoError(t, emulator.Execute(`GRANT SELECT ON ALL TABLES IN SCHEMA "DATABASE"."PUBLIC" TO ROLE "ROLE"`))
+
+	d := schema.TestResourceDataRaw(t, resources.GrantPrivilegesToAccountRole().Schema, map[string]any{})
+	d.SetId(`"ROLE"|false|false|SELECT|OnSchemaObject|OnAll|TABLES|InDatabase|"DATABASE"`)
+
+	statements := len(emulator.Statements())
+	require.Empty(t, resources.ReadGrantPrivilegesToAccountRole(ctx, d, meta))
+	// the objects in INFORMATION_SCHEMA are not matched by the bulk grant
+	assert.ElementsMatch(t, []any{"SELECT"}, d.Get("privileges").(*schema.Set).List())
+	// the grants are read with a single query, regardless of the number of the objects
+	showGrants := 0
+	for _, statement := range emulator.Statements()[statements:] {
+		if strings.HasPrefix(statement, "SHOW GRANTS") {
+			showGrants++
+		}
+	}
+	assert.Equal(t, 1, showGrants)
+}
@@ -0,0 +1,172 @@
+package emulatortests
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrantPrivilegesInBulk_grantsInBatchesAndDetectsDrift(t *testing.T) {
+	ctx := context.Background()
+	emulator, meta := newEmulatedProviderContext(t,
+		`CREATE ROLE "FIRST"`,
+		`CREATE ROLE "SECOND"`,
+		`CREATE DATABASE "DATABASE"`,
+		`CREATE WAREHOUSE "WAREHOUSE"`,
+	)
+
+	d := schema.TestResourceDataRaw(t, resources.GrantPrivilegesInBulk().Schema, map[string]any{
+		"batch_size": 2,
+		"grant": []any{
+			map[string]any{
+				"account_role_name": "FIRST",
+				"object_type":       "DATABASE",
+				"object_name":       "DATABASE",
+				"privileges":        []any{"USAGE", "MONITOR"},
+			},
+			map[string]any{
+				"account_role_name": "FIRST",
+				"object_type":       "WAREHOUSE",
+				"object_name":       "WAREHOUSE",
+				"privileges":        []any{"USAGE"},
+				"with_grant_option": true,
+			},
+			map[string]any{
+				"account_role_name": "SECOND",
+				"object_type":       "ACCOUNT",
+				"privileges":        []any{"CREATE DATABASE"},
+			},
+		},
+	})
+	statementsBefore := len(emulator.Statements())
+	require.Empty(t, resources.CreateGrantPrivilegesInBulk(ctx, d, meta))
+	require.NotEmpty(t, d.Id())
+
+	// three GRANT statements in two batches, and one SHOW GRANTS TO ROLE for every role
+	statements := emulator.Statements()[statementsBefore:]
+	require.Len(t, statements, 4)
+	assert.Len(t, strings.Split(statements[0], ";\n"), 2)
+	assert.Len(t, strings.Split(statements[1], ";\n"), 1)
+	assert.Contains(t, strings.Join(statements[2:], "\n"), `SHOW GRANTS TO ROLE "FIRST"`)
+	assert.Contains(t, strings.Join(statements[2:], "\n"), `SHOW GRANTS TO ROLE "SECOND"`)
+
+	grants, err := meta.Client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: &sdk.ShowGrantsTo{Role: sdk.NewAccountObjectIdentifier("FIRST")}})
+	require.NoError(t, err)
+	assert.Len(t, grants, 3)
+
+	t.Run("privilege revoked outside Terraform", func(t *testing.T) {
+		require.NoError(t, emulator.Execute(`REVOKE MONITOR ON DATABASE "DATABASE" FROM ROLE "FIRST"`))
+
+		require.Empty(t, resources.ReadGrantPrivilegesInBulk(ctx, d, meta))
+		for _, raw := range d.Get("grant").(*schema.Set).List() {
+			grant := raw.(map[string]any)
+			if grant["object_type"] == "DATABASE" {
+				assert.Equal(t, []any{"USAGE"}, grant["privileges"].(*schema.Set).List())
+			}
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.Empty(t, resources.DeleteGrantPrivilegesInBulk(ctx, d, meta))
+		assert.Empty(t, d.Id())
+
+		for _, role := range []string{"FIRST", "SECOND"} {
+			grants, err := meta.Client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: &sdk.ShowGrantsTo{Role: sdk.NewAccountObjectIdentifier(role)}})
+			require.NoError(t, err)
+			assert.Empty(t, grants)
+		}
+	})
+}
+
+func TestGrantPrivilegesInBulk_keepsPartiallyGrantedBatchesInState(t *testing.T) {
+	ctx := context.Background()
+	emulator, meta := newEmulatedProviderContext(t,
+		`CREATE ROLE "ROLE"`,
+	)
+	grants := []any{
+		map[string]any{
+			"account_role_name": "ROLE",
+			"object_type":       "WAREHOUSE",
+			"object_name":       "DROPPED",
+			"privileges":        []any{"USAGE"},
+		},
+	}
+	// the statements follow the order of the grant set; the warehouse grant is executed after two of the database grants
+	for _, database := range []string{"FIRST", "SECOND", "THIRD"} {
+		require.NoError(t, emulator.Execute(fmt.Sprintf(`CREATE DATABASE %q`, database)))
+		grants = append(grants, map[string]any{
+			"account_role_name": "ROLE",
+			"object_type":       "DATABASE",
+			"object_name":       database,
+			"privileges":        []any{"USAGE"},
+		})
+	}
+
+	d := schema.TestResourceDataRaw(t, resources.GrantPrivilegesInBulk().Schema, map[string]any{
+		"batch_size": 1,
+		"grant":      grants,
+	})
+	require.NotEmpty(t, resources.CreateGrantPrivilegesInBulk(ctx, d, meta))
+
+	// the batches granted before the failing one have to be tracked in the state, so that they are revoked on destroy
+	require.NotEmpty(t, d.Id())
+	granted, err := meta.Client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: &sdk.ShowGrantsTo{Role: sdk.NewAccountObjectIdentifier("ROLE")}})
+	require.NoError(t, err)
+	require.NotEmpty(t, granted)
+	require.Less(t, len(granted), 3)
+	grantedDatabases := make(map[string]bool)
+	for _, grant := range granted {
+		grantedDatabases[grant.Name.Name()] = true
+	}
+	for _, raw := range d.Get("grant").(*schema.Set).List() {
+		grant := raw.(map[string]any)
+		if grantedDatabases[grant["object_name"].(string)] {
+			assert.Equal(t, []any{"USAGE"}, grant["privileges"].(*schema.Set).List())
+		} else {
+			assert.Empty(t, grant["privileges"].(*schema.Set).List())
+		}
+	}
+
+	require.Empty(t, resources.DeleteGrantPrivilegesInBulk(ctx, d, meta))
+	granted, err = meta.Client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: &sdk.ShowGrantsTo{Role: sdk.NewAccountObjectIdentifier("ROLE")}})
+	require.NoError(t, err)
+	assert.Empty(t, granted)
+}
+
+func TestGrantPrivilegesInBulk_deleteRevokesPrivilegesAfterRemovedObject(t *testing.T) {
+	ctx := context.Background()
+	emulator, meta := newEmulatedProviderContext(t,
+		`CREATE ROLE "ROLE"`,
+		`CREATE DATABASE "FIRST"`,
+		`CREATE DATABASE "SECOND"`,
+		`CREATE DATABASE "THIRD"`,
+	)
+
+	grant := func(database string) map[string]any {
+		return map[string]any{
+			"account_role_name": "ROLE",
+			"object_type":       "DATABASE",
+			"object_name":       database,
+			"privileges":        []any{"USAGE"},
+		}
+	}
+	d := schema.TestResourceDataRaw(t, resources.GrantPrivilegesInBulk().Schema, map[string]any{
+		"grant": []any{grant("FIRST"), grant("SECOND"), grant("THIRD")},
+	})
+	require.Empty(t, resources.CreateGrantPrivilegesInBulk(ctx, d, meta))
+
+	// whatever the order of the statements in the batch, the revokes of the remaining databases have to be executed
+	require.NoError(t, emulator.Execute(`DROP DATABASE "SECOND"`))
+
+	require.Empty(t, resources.DeleteGrantPrivilegesInBulk(ctx, d, meta))
+	grants, err := meta.Client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: &sdk.ShowGrantsTo{Role: sdk.NewAccountObjectIdentifier("ROLE")}})
+	require.NoError(t, err)
+	assert.Empty(t, grants)
+}
@@ -0,0 +1,26 @@
+package emulatortests
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeemulator"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/require"
+)
+
+// newEmulatedProviderContext returns the provider context with the client running its statements in a new in-memory emulator
+// (see snowflakeemulator), after running the given setup statements in it. The returned emulator is used to make the changes
+// outside Terraform. Every test gets its own emulator and the client is created with its connector, so the tests do not share
+// any state and can run in parallel.
+func newEmulatedProviderContext(t *testing.T, setup ...string) (*snowflakeemulator.Emulator, *provider.Context) {
+	t.Helper()
+	emulator := snowflakeemulator.New()
+	client, err := sdk.NewClientWithConnector(&gosnowflake.Config{Account: "account", User: "user"}, emulator.ConnectorFactory)
+	require.NoError(t, err)
+	for _, statement := range setup {
+		require.NoError(t, emulator.Execute(statement))
+	}
+	return emulator, &provider.Context{Client: client}
+}
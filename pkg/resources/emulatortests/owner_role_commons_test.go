@@ -0,0 +1,86 @@
+package emulatortests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOwnerRole_transfersOwnershipAndDetectsDrift(t *testing.T) {
+	ctx := context.Background()
+	emulator, meta := newEmulatedProviderContext(t,
+		`CREATE ROLE "OWNER"`,
+		`CREATE ROLE "OTHER"`,
+	)
+
+	create := resources.OwnerRoleWrapper(sdk.ObjectTypeRole, sdk.ParseAccountObjectIdentifier, resources.ReadAccountRole, resources.CreateAccountRole)
+	read := resources.OwnerRoleReadWrapper(resources.ReadAccountRole)
+	id := sdk.NewAccountObjectIdentifier("ROLE")
+
+	d := schema.TestResourceDataRaw(t, resources.AccountRole().Schema, map[string]any{
+		"name":       "ROLE",
+		"owner_role": "OWNER",
+	})
+	require.False(t, create(ctx, d, meta).HasError())
+	assert.Equal(t, "OWNER", d.Get("show_output.0.owner"))
+
+	role, err := meta.Client.Roles.ShowByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "OWNER", role.Owner)
+
+	t.Run("no drift", func(t *testing.T) {
+		require.False(t, read(ctx, d, meta).HasError())
+		assert.Equal(t, "OWNER", d.Get("owner_role"))
+	})
+
+	t.Run("ownership changed externally", func(t *testing.T) {
+		require.NoError(t, emulator.Execute(`GRANT OWNERSHIP ON ROLE "ROLE" TO ROLE "OTHER"`))
+
+		require.False(t, read(ctx, d, meta).HasError())
+		assert.Equal(t, `"OTHER"`, d.Get("owner_role"))
+	})
+
+	t.Run("owner role not set", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, resources.AccountRole().Schema, map[string]any{
+			"name": "ROLE",
+		})
+		d.SetId(id.FullyQualifiedName())
+
+		require.False(t, read(ctx, d, meta).HasError())
+		assert.Empty(t, d.Get("owner_role"))
+	})
+}
+
+func TestOwnerRole_detectsDriftFromGrants(t *testing.T) {
+	ctx := context.Background()
+	emulator, meta := newEmulatedProviderContext(t,
+		`CREATE ROLE "OWNER"`,
+		`CREATE ROLE "OTHER"`,
+		`CREATE DATABASE "DB"`,
+		`GRANT OWNERSHIP ON DATABASE "DB" TO ROLE "OWNER"`,
+	)
+
+	// the database has no show_output, so only the owner read from SHOW GRANTS ON is checked
+	noopRead := func(context.Context, *schema.ResourceData, any) diag.Diagnostics { return nil }
+	read := resources.OwnerRoleFromGrantsReadWrapper(sdk.ObjectTypeDatabase, sdk.ParseAccountObjectIdentifier, noopRead)
+
+	d := schema.TestResourceDataRaw(t, resources.Database().Schema, map[string]any{
+		"name":       "DB",
+		"owner_role": "OWNER",
+	})
+	d.SetId(sdk.NewAccountObjectIdentifier("DB").FullyQualifiedName())
+
+	require.False(t, read(ctx, d, meta).HasError())
+	assert.Equal(t, "OWNER", d.Get("owner_role"))
+
+	require.NoError(t, emulator.Execute(`GRANT OWNERSHIP ON DATABASE "DB" TO ROLE "OTHER"`))
+
+	require.False(t, read(ctx, d, meta).HasError())
+	assert.Equal(t, `"OTHER"`, d.Get("owner_role"))
+}
@@ -0,0 +1,52 @@
+package emulatortests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRolePrivileges_revokesUnmanagedPrivileges(t *testing.T) {
+	ctx := context.Background()
+	emulator, meta := newEmulatedProviderContext(t,
+		`CREATE ROLE "ROLE"`,
+		`CREATE DATABASE "DATABASE"`,
+		`CREATE WAREHOUSE "WAREHOUSE"`,
+		`GRANT MONITOR ON WAREHOUSE "WAREHOUSE" TO ROLE "ROLE"`,
+	)
+
+	d := schema.TestResourceDataRaw(t, resources.RolePrivileges().Schema, map[string]any{
+		"account_role_name": "ROLE",
+		"grant": []any{
+			map[string]any{
+				"object_type": "DATABASE",
+				"object_name": "DATABASE",
+				"privileges":  []any{"USAGE", "MONITOR"},
+			},
+		},
+	})
+	require.Empty(t, resources.CreateRolePrivileges(ctx, d, meta))
+	assert.Equal(t, `ACCOUNT_ROLE|"ROLE"`, d.Id())
+	assert.Empty(t, d.Get("unmanaged_privileges"))
+
+	grants, err := meta.Client.Grants.Show(ctx, &sdk.ShowGrantOptions{To: &sdk.ShowGrantsTo{Role: sdk.NewAccountObjectIdentifier("ROLE")}})
+	require.NoError(t, err)
+	require.Len(t, grants, 2)
+	for _, grant := range grants {
+		assert.Equal(t, sdk.ObjectTypeDatabase, grant.GrantedOn)
+	}
+
+	// the changes made outside Terraform
+	require.NoError(t, emulator.Execute(`GRANT USAGE ON WAREHOUSE "WAREHOUSE" TO ROLE "ROLE"`))
+	require.NoError(t, emulator.Execute(`REVOKE MONITOR ON DATABASE "DATABASE" FROM ROLE "ROLE"`))
+
+	require.Empty(t, resources.ReadRolePrivileges(ctx, d, meta))
+	assert.Equal(t, []any{`USAGE ON WAREHOUSE "WAREHOUSE"`}, d.Get("unmanaged_privileges"))
+	privileges := d.Get("grant").(*schema.Set).List()[0].(map[string]any)["privileges"].(*schema.Set).List()
+	assert.Equal(t, []any{"USAGE"}, privileges)
+}
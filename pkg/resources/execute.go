@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 var executeSchema = map[string]*schema.Schema{
@@ -30,20 +32,43 @@ var executeSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Optional SQL statement to do a read. Invoked on every resource refresh and every time it is changed.",
 	},
+	"query_statements_count": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		Description:      "Number of statements (separated by semicolons) in the `query`. When set, the query is run in the multi-statement mode, and the results of every statement are returned in `query_results_per_statement`, while `query_results` contains the results of the last statement. The query fails if it contains a different number of statements.",
+	},
 	"query_results": {
 		Type:        schema.TypeList,
 		Computed:    true,
 		Description: "List of key-value maps (text to text) retrieved after executing read query. Will be empty if the query results in an error.",
-		Elem: &schema.Schema{
-			Type: schema.TypeMap,
-			Elem: &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
+		Elem:        queryResultsElem,
+	},
+	"query_results_per_statement": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Results of every statement in the read query, in the order of the statements. Set only when `query_statements_count` is set. Will be empty if the query results in an error.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"query_results": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "List of key-value maps (text to text) returned by the statement.",
+					Elem:        queryResultsElem,
+				},
 			},
 		},
 	},
 }
 
+var queryResultsElem = &schema.Schema{
+	Type: schema.TypeMap,
+	Elem: &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+}
+
 func Execute() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.Execute, CreateExecute),
@@ -63,9 +88,11 @@ func Execute() *schema.Resource {
 				return oldValue != ""
 			}),
 			func(_ context.Context, diff *schema.ResourceDiff, _ any) error {
-				if diff.HasChange("query") {
-					err := diff.SetNewComputed("query_results")
-					if err != nil {
+				if diff.HasChange("query") || diff.HasChange("query_statements_count") {
+					if err := diff.SetNewComputed("query_results"); err != nil {
+						return err
+					}
+					if err := diff.SetNewComputed("query_results_per_statement"); err != nil {
 						return err
 					}
 				}
@@ -101,7 +128,7 @@ func CreateExecute(ctx context.Context, d *schema.ResourceData, meta any) diag.D
 }
 
 func UpdateExecute(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	if d.HasChange("query") {
+	if d.HasChange("query") || d.HasChange("query_statements_count") {
 		return ReadExecute(ctx, d, meta)
 	}
 	return nil
@@ -114,53 +141,94 @@ func ReadExecute(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 
 	setNilResults := func() diag.Diagnostics {
 		log.Printf(`[DEBUG] Clearing query_results`)
-		err := d.Set("query_results", nil)
-		if err != nil {
+		if err := errors.Join(
+			d.Set("query_results", nil),
+			d.Set("query_results_per_statement", nil),
+		); err != nil {
 			return diag.FromErr(err)
 		}
 		return nil
 	}
+	queryFailed := func(err error) diag.Diagnostics {
+		return append(setNilResults(), diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "SQL query statement failed",
+			Detail:   fmt.Sprintf("Err: %v", err),
+		})
+	}
 
 	if readStatement == "" {
 		return setNilResults()
-	} else {
-		rows, err := client.QueryUnsafe(ctx, readStatement)
+	}
+
+	if statementsCount, ok := d.GetOk("query_statements_count"); ok {
+		resultSets, err := client.QueryUnsafeMultiStatement(ctx, readStatement, statementsCount.(int))
 		if err != nil {
-			return append(setNilResults(), diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  "SQL query statement failed",
-				Detail:   fmt.Sprintf("Err: %v", err),
-			})
+			return queryFailed(err)
 		}
-		log.Printf(`[INFO] SQL query statement executed successfully, returned rows count: %d`, len(rows))
-		rowsTransformed := make([]map[string]any, len(rows))
-		for i, row := range rows {
-			t := make(map[string]any)
-			for k, v := range row {
-				if *v == nil {
-					t[k] = nil
-				} else {
-					switch (*v).(type) {
-					case fmt.Stringer:
-						t[k] = fmt.Sprintf("%v", *v)
-					case string:
-						t[k] = *v
-					default:
-						return diag.FromErr(fmt.Errorf("currently only objects convertible to String are supported by query; got %v", *v))
-					}
-				}
+		log.Printf(`[INFO] SQL query statements executed successfully, returned result sets count: %d`, len(resultSets))
+		resultsPerStatement := make([]map[string]any, len(resultSets))
+		lastResults := make([]map[string]any, 0)
+		for i, rows := range resultSets {
+			rowsTransformed, err := transformQueryRows(rows)
+			if err != nil {
+				return diag.FromErr(err)
 			}
-			rowsTransformed[i] = t
+			resultsPerStatement[i] = map[string]any{"query_results": rowsTransformed}
+			lastResults = rowsTransformed
 		}
-		err = d.Set("query_results", rowsTransformed)
-		if err != nil {
+		if err := errors.Join(
+			d.Set("query_results", lastResults),
+			d.Set("query_results_per_statement", resultsPerStatement),
+		); err != nil {
 			return diag.FromErr(err)
 		}
+		return nil
+	}
+
+	rows, err := client.QueryUnsafe(ctx, readStatement)
+	if err != nil {
+		return queryFailed(err)
+	}
+	log.Printf(`[INFO] SQL query statement executed successfully, returned rows count: %d`, len(rows))
+	rowsTransformed, err := transformQueryRows(rows)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := errors.Join(
+		d.Set("query_results", rowsTransformed),
+		d.Set("query_results_per_statement", nil),
+	); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
+// transformQueryRows converts the rows returned by the query to maps of strings.
+func transformQueryRows(rows []map[string]*any) ([]map[string]any, error) {
+	rowsTransformed := make([]map[string]any, len(rows))
+	for i, row := range rows {
+		t := make(map[string]any)
+		for k, v := range row {
+			if *v == nil {
+				t[k] = nil
+			} else {
+				switch (*v).(type) {
+				case fmt.Stringer:
+					t[k] = fmt.Sprintf("%v", *v)
+				case string:
+					t[k] = *v
+				default:
+					return nil, fmt.Errorf("currently only objects convertible to String are supported by query; got %v", *v)
+				}
+			}
+		}
+		rowsTransformed[i] = t
+	}
+	return rowsTransformed, nil
+}
+
 func DeleteExecute(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
 
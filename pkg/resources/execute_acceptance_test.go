@@ -965,3 +965,53 @@ func TestAcc_Execute_gh3334_longRunningCreate(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_Execute_multiStatementQuery(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.EnableAcceptance)
+	acc.TestAccPreCheck(t)
+
+	resourceName := "snowflake_execute.test"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: executeConfigMultiStatementQuery("SET V = 'a'; SELECT $V AS A; SELECT 'b' AS B", 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "query_statements_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "query_results_per_statement.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "query_results_per_statement.0.query_results.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "query_results_per_statement.1.query_results.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "query_results_per_statement.1.query_results.0.A", "a"),
+					resource.TestCheckResourceAttr(resourceName, "query_results_per_statement.2.query_results.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "query_results_per_statement.2.query_results.0.B", "b"),
+					resource.TestCheckResourceAttr(resourceName, "query_results.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "query_results.0.B", "b"),
+				),
+			},
+			// wrong statements count results in empty results
+			{
+				Config: executeConfigMultiStatementQuery("SET V = 'a'; SELECT $V AS A; SELECT 'b' AS B", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "query_statements_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "query_results_per_statement.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "query_results.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func executeConfigMultiStatementQuery(query string, statementsCount int) string {
+	return fmt.Sprintf(`
+resource "snowflake_execute" "test" {
+  execute                = "SELECT 18"
+  revert                 = "SELECT 36"
+  query                  = "%s"
+  query_statements_count = %d
+}
+`, query, statementsCount)
+}
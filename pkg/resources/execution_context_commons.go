@@ -0,0 +1,88 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// executionContextSchema is added to the resources that can be managed with a role or a warehouse different from the ones set in the provider configuration.
+var executionContextSchema = map[string]*schema.Schema{
+	"execution_role": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("Specifies the role used to run all the statements of this resource (`USE ROLE`) instead of the role set in the provider configuration. The statements run on a dedicated connection, whose session is restored afterwards, so the resources managed with different roles can reference each other without provider aliases. Keep in mind that the objects created by the resource are owned by this role.", resources.AccountRole),
+	},
+	"execution_secondary_roles": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: sdkValidation(sdk.ToSecondaryRoleOption),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToSecondaryRoleOption),
+		Description:      fmt.Sprintf("Specifies the secondary roles activated while running all the statements of this resource (`USE SECONDARY ROLES`) instead of the secondary roles of the provider session. Valid options are: %v.", possibleValuesListed(sdk.AllSecondaryRoleOptions)),
+	},
+	"execution_warehouse": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("Specifies the warehouse used to run all the statements of this resource (`USE WAREHOUSE`) instead of the warehouse set in the provider configuration.", resources.Warehouse),
+	},
+}
+
+// executionContextOverrides returns the session overrides from the execution_role, execution_secondary_roles, and execution_warehouse attributes.
+func executionContextOverrides(d *schema.ResourceData) (sdk.SessionOverrides, error) {
+	var overrides sdk.SessionOverrides
+	if v := d.Get("execution_role").(string); v != "" {
+		role, err := sdk.ParseAccountObjectIdentifier(v)
+		if err != nil {
+			return sdk.SessionOverrides{}, err
+		}
+		overrides.Role = &role
+	}
+	if v := d.Get("execution_secondary_roles").(string); v != "" {
+		secondaryRoles, err := sdk.ToSecondaryRoleOption(v)
+		if err != nil {
+			return sdk.SessionOverrides{}, err
+		}
+		overrides.SecondaryRoles = &secondaryRoles
+	}
+	if v := d.Get("execution_warehouse").(string); v != "" {
+		warehouse, err := sdk.ParseAccountObjectIdentifier(v)
+		if err != nil {
+			return sdk.SessionOverrides{}, err
+		}
+		overrides.Warehouse = &warehouse
+	}
+	return overrides, nil
+}
+
+// ExecutionContextWrapper runs the given operation with the client in which the session uses the execution_role, execution_secondary_roles, and execution_warehouse of the resource.
+// The resource has to include executionContextSchema.
+func ExecutionContextWrapper[T ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](operation T) T {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		overrides, err := executionContextOverrides(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		providerCtx := meta.(*provider.Context)
+
+		var diags diag.Diagnostics
+		err = providerCtx.Client.WithSessionOverrides(ctx, overrides, func(client *sdk.Client) error {
+			overriddenProviderCtx := *providerCtx
+			overriddenProviderCtx.Client = client
+			diags = operation(ctx, d, &overriddenProviderCtx)
+			return nil
+		})
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
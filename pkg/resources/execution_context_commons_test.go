@@ -0,0 +1,48 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionContextOverrides(t *testing.T) {
+	t.Run("no overrides", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, executionContextSchema, map[string]any{})
+
+		overrides, err := executionContextOverrides(d)
+
+		require.NoError(t, err)
+		assert.Equal(t, sdk.SessionOverrides{}, overrides)
+	})
+
+	t.Run("all overrides", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, executionContextSchema, map[string]any{
+			"execution_role":            "ROLE",
+			"execution_secondary_roles": "none",
+			"execution_warehouse":       "WAREHOUSE",
+		})
+
+		overrides, err := executionContextOverrides(d)
+
+		require.NoError(t, err)
+		assert.Equal(t, sdk.SessionOverrides{
+			Role:           sdk.Pointer(sdk.NewAccountObjectIdentifier("ROLE")),
+			SecondaryRoles: sdk.Pointer(sdk.SecondaryRolesNone),
+			Warehouse:      sdk.Pointer(sdk.NewAccountObjectIdentifier("WAREHOUSE")),
+		}, overrides)
+	})
+
+	t.Run("invalid secondary roles", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, executionContextSchema, map[string]any{
+			"execution_secondary_roles": "ROLE",
+		})
+
+		_, err := executionContextOverrides(d)
+
+		require.ErrorContains(t, err, "invalid SecondaryRoleOption: ROLE")
+	})
+}
@@ -155,14 +155,14 @@ var externalOauthIntegrationSchema = map[string]*schema.Schema{
 }
 
 func ExternalOauthIntegration() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.ExternalOauthSecurityIntegration, CreateContextExternalOauthIntegration),
-		ReadContext:   TrackingReadWrapper(resources.ExternalOauthSecurityIntegration, ReadContextExternalOauthIntegration(true)),
-		UpdateContext: TrackingUpdateWrapper(resources.ExternalOauthSecurityIntegration, UpdateContextExternalOauthIntegration),
+	return withResourceIdentity(objectIdentity[sdk.AccountObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.ExternalOauthSecurityIntegration, OwnerRoleWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextExternalOauthIntegration(false), CreateContextExternalOauthIntegration)),
+		ReadContext:   TrackingReadWrapper(resources.ExternalOauthSecurityIntegration, OwnerRoleFromGrantsReadWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextExternalOauthIntegration(true))),
+		UpdateContext: TrackingUpdateWrapper(resources.ExternalOauthSecurityIntegration, OwnerRoleWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextExternalOauthIntegration(false), UpdateContextExternalOauthIntegration)),
 		DeleteContext: TrackingDeleteWrapper(resources.ExternalOauthSecurityIntegration, DeleteSecurityIntegration),
 		Description:   "Resource used to manage external oauth security integration objects. For more information, check [security integrations documentation](https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-oauth-external).",
 
-		Schema: externalOauthIntegrationSchema,
+		Schema: collections.MergeMaps(externalOauthIntegrationSchema, ownerRoleSchema),
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.ExternalOauthSecurityIntegration, customdiff.All(
 			ForceNewIfChangeToEmptyString("external_oauth_rsa_public_key"),
 			ForceNewIfChangeToEmptyString("external_oauth_rsa_public_key_2"),
@@ -189,7 +189,7 @@ func ExternalOauthIntegration() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportExternalOauthIntegration(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -50,7 +50,7 @@ var grantAccountRoleSchema = map[string]*schema.Schema{
 }
 
 func GrantAccountRole() *schema.Resource {
-	return &schema.Resource{
+	return withResourceIdentity(grantAccountRoleIdentity, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.GrantAccountRole, CreateGrantAccountRole),
 		ReadContext:   TrackingReadWrapper(resources.GrantAccountRole, ReadGrantAccountRole),
 		DeleteContext: TrackingDeleteWrapper(resources.GrantAccountRole, DeleteGrantAccountRole),
@@ -81,7 +81,7 @@ func GrantAccountRole() *schema.Resource {
 			}),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 // CreateGrantAccountRole implements schema.CreateFunc.
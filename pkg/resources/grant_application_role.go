@@ -52,7 +52,7 @@ var grantApplicationRoleSchema = map[string]*schema.Schema{
 }
 
 func GrantApplicationRole() *schema.Resource {
-	return &schema.Resource{
+	return withResourceIdentity(grantApplicationRoleIdentity, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.GrantApplicationRole, CreateContextGrantApplicationRole),
 		ReadContext:   TrackingReadWrapper(resources.GrantApplicationRole, ReadContextGrantApplicationRole),
 		DeleteContext: TrackingDeleteWrapper(resources.GrantApplicationRole, DeleteContextGrantApplicationRole),
@@ -91,7 +91,7 @@ func GrantApplicationRole() *schema.Resource {
 			}),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateContextGrantApplicationRole(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
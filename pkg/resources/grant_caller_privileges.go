@@ -0,0 +1,609 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// grantCallerPrivilegesObjectTypes are the object types on which the caller privileges can be granted; the account is not an object here.
+// The objects with arguments (e.g. functions) are not supported.
+var grantCallerPrivilegesObjectTypes = slices.DeleteFunc(slices.Clone(rolePrivilegesNonAccountObjectTypes), func(objectType string) bool {
+	return sdk.ObjectType(objectType).IsWithArguments()
+})
+
+var grantCallerPrivilegesPluralObjectTypes = func() []string {
+	pluralObjectTypes := make([]string, len(grantCallerPrivilegesObjectTypes))
+	for i, objectType := range grantCallerPrivilegesObjectTypes {
+		pluralObjectTypes[i] = sdk.ObjectType(objectType).Plural().String()
+	}
+	return pluralObjectTypes
+}()
+
+var grantCallerPrivilegesSchema = map[string]*schema.Schema{
+	"account_role_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      relatedResourceDescription("The fully qualified name of the account role to which the caller privileges are granted.", resources.AccountRole),
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf:     []string{"account_role_name", "database_role_name"},
+	},
+	"database_role_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      relatedResourceDescription("The fully qualified name of the database role to which the caller privileges are granted.", resources.DatabaseRole),
+		ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf:     []string{"account_role_name", "database_role_name"},
+	},
+	"privileges": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "The privileges the restricted caller's rights executables can use on behalf of the caller. This field is case-sensitive; use only upper-case privileges.",
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: isNotOwnershipGrant(),
+		},
+		ExactlyOneOf: []string{"privileges", "all_privileges"},
+	},
+	"all_privileges": {
+		Type:         schema.TypeBool,
+		Optional:     true,
+		Default:      false,
+		ForceNew:     true,
+		Description:  "Grant all the caller privileges on the objects.",
+		ExactlyOneOf: []string{"privileges", "all_privileges"},
+	},
+	"on_object": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: "Grants the caller privileges on a single object (GRANT CALLER).",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Description:      fmt.Sprintf("The type of the object. Valid values are: %s.", possibleValuesListed(grantCallerPrivilegesObjectTypes)),
+					ValidateDiagFunc: StringInSlice(grantCallerPrivilegesObjectTypes, false),
+				},
+				"object_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "The fully qualified name of the object.",
+				},
+			},
+		},
+		ExactlyOneOf: []string{"on_object", "on_all"},
+	},
+	"on_all": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: "Grants the caller privileges on all the objects of the given type in the account, database, or schema, including the ones created in the future (GRANT INHERITED CALLER).",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_type_plural": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Description:      fmt.Sprintf("The plural object type of the objects. Valid values are: %s.", possibleValuesListed(grantCallerPrivilegesPluralObjectTypes)),
+					ValidateDiagFunc: StringInSlice(grantCallerPrivilegesPluralObjectTypes, false),
+				},
+				"in_account": {
+					Type:         schema.TypeBool,
+					Optional:     true,
+					ForceNew:     true,
+					Description:  "Grants the caller privileges on all the objects in the account.",
+					ExactlyOneOf: []string{"on_all.0.in_account", "on_all.0.in_database", "on_all.0.in_schema"},
+				},
+				"in_database": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					Description:      "The fully qualified name of the database.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ExactlyOneOf:     []string{"on_all.0.in_account", "on_all.0.in_database", "on_all.0.in_schema"},
+				},
+				"in_schema": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					Description:      "The fully qualified name of the schema.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ExactlyOneOf:     []string{"on_all.0.in_account", "on_all.0.in_database", "on_all.0.in_schema"},
+				},
+			},
+		},
+		ExactlyOneOf: []string{"on_object", "on_all"},
+	},
+}
+
+func GrantCallerPrivileges() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.GrantCallerPrivilegesResource), TrackingCreateWrapper(resources.GrantCallerPrivileges, CreateGrantCallerPrivileges)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.GrantCallerPrivilegesResource), TrackingReadWrapper(resources.GrantCallerPrivileges, ReadGrantCallerPrivileges)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.GrantCallerPrivilegesResource), TrackingUpdateWrapper(resources.GrantCallerPrivileges, UpdateGrantCallerPrivileges)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.GrantCallerPrivilegesResource), TrackingDeleteWrapper(resources.GrantCallerPrivileges, DeleteGrantCallerPrivileges)),
+		Description:   "Resource used to manage the caller grants (GRANT CALLER and GRANT INHERITED CALLER) of an account role or a database role. The caller grants restrict the caller's privileges that the restricted caller's rights procedures can use. For more information, check [restricted caller's rights documentation](https://docs.snowflake.com/en/developer-guide/restricted-callers-rights).",
+
+		Schema: grantCallerPrivilegesSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.GrantCallerPrivileges, ImportGrantCallerPrivileges),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+const (
+	grantCallerPrivilegesOnObjectKind = "OnObject"
+	grantCallerPrivilegesOnAllKind    = "OnAll"
+
+	grantCallerPrivilegesInAccount  = "InAccount"
+	grantCallerPrivilegesInDatabase = "InDatabase"
+	grantCallerPrivilegesInSchema   = "InSchema"
+
+	grantCallerPrivilegesAll = "ALL"
+)
+
+// GrantCallerPrivilegesId is the identifier of the resource, e.g. ACCOUNT_ROLE|"role"|SELECT,INSERT|OnObject|TABLE|"database"."schema"."table"
+// or DATABASE_ROLE|"database"."role"|ALL|OnAll|TABLES|InSchema|"database"."schema".
+type GrantCallerPrivilegesId struct {
+	Grantee       rolePrivilegesGrantee
+	AllPrivileges bool
+	Privileges    []string
+	On            sdk.CallerGrantOn
+}
+
+func (id GrantCallerPrivilegesId) String() string {
+	privileges := strings.Join(id.Privileges, ",")
+	if id.AllPrivileges {
+		privileges = grantCallerPrivilegesAll
+	}
+	parts := append(helpers.ParseResourceIdentifier(id.Grantee.String()), privileges)
+	if id.On.Object != nil {
+		parts = append(parts, grantCallerPrivilegesOnObjectKind, id.On.Object.ObjectType.String(), id.On.Object.Name.FullyQualifiedName())
+	} else {
+		parts = append(parts, grantCallerPrivilegesOnAllKind, id.On.All.PluralObjectType.String())
+		switch {
+		case id.On.All.InDatabase != nil:
+			parts = append(parts, grantCallerPrivilegesInDatabase, id.On.All.InDatabase.FullyQualifiedName())
+		case id.On.All.InSchema != nil:
+			parts = append(parts, grantCallerPrivilegesInSchema, id.On.All.InSchema.FullyQualifiedName())
+		default:
+			parts = append(parts, grantCallerPrivilegesInAccount)
+		}
+	}
+	return helpers.EncodeResourceIdentifier(parts...)
+}
+
+func ParseGrantCallerPrivilegesId(idString string) (GrantCallerPrivilegesId, error) {
+	id := GrantCallerPrivilegesId{}
+	parts := helpers.ParseResourceIdentifier(idString)
+	if len(parts) < 5 {
+		return id, sdk.NewError(`grant caller privileges identifier should hold at least 5 parts "<role_kind>|<role_name>|<privileges>|OnObject|<object_type>|<object_name>" or "<role_kind>|<role_name>|<privileges>|OnAll|<object_type_plural>|In[Account, Database or Schema]|<container_name>"`)
+	}
+
+	grantee, err := parseRolePrivilegesGrantee(helpers.EncodeResourceIdentifier(parts[0], parts[1]))
+	if err != nil {
+		return id, err
+	}
+	id.Grantee = grantee
+
+	if parts[2] == grantCallerPrivilegesAll {
+		id.AllPrivileges = true
+	} else if parts[2] != "" {
+		id.Privileges = strings.Split(parts[2], ",")
+	}
+
+	switch parts[3] {
+	case grantCallerPrivilegesOnObjectKind:
+		if len(parts) != 6 {
+			return id, sdk.NewError(`grant caller privileges identifier should hold 6 parts "<role_kind>|<role_name>|<privileges>|OnObject|<object_type>|<object_name>"`)
+		}
+		objectType, err := sdk.ToObjectType(parts[4])
+		if err != nil {
+			return id, err
+		}
+		objectName, err := grantCallerPrivilegesObjectIdentifier(objectType, parts[5])
+		if err != nil {
+			return id, err
+		}
+		id.On.Object = &sdk.Object{ObjectType: objectType, Name: objectName}
+	case grantCallerPrivilegesOnAllKind:
+		onAll := &sdk.CallerGrantOnAll{PluralObjectType: sdk.PluralObjectType(parts[4])}
+		switch {
+		case len(parts) == 6 && parts[5] == grantCallerPrivilegesInAccount:
+			onAll.InAccount = sdk.Bool(true)
+		case len(parts) == 7 && parts[5] == grantCallerPrivilegesInDatabase:
+			databaseId, err := sdk.ParseAccountObjectIdentifier(parts[6])
+			if err != nil {
+				return id, err
+			}
+			onAll.InDatabase = &databaseId
+		case len(parts) == 7 && parts[5] == grantCallerPrivilegesInSchema:
+			schemaId, err := sdk.ParseDatabaseObjectIdentifier(parts[6])
+			if err != nil {
+				return id, err
+			}
+			onAll.InSchema = &schemaId
+		default:
+			return id, sdk.NewError(`grant caller privileges identifier should end with "OnAll|<object_type_plural>|InAccount" or "OnAll|<object_type_plural>|In[Database or Schema]|<container_name>"`)
+		}
+		id.On.All = onAll
+	default:
+		return id, sdk.NewError(fmt.Sprintf("invalid grant caller privileges kind: %s, expected %s or %s", parts[3], grantCallerPrivilegesOnObjectKind, grantCallerPrivilegesOnAllKind))
+	}
+
+	return id, nil
+}
+
+// grantCallerPrivilegesObjectIdentifier parses the object name with the number of parts expected for the object type.
+func grantCallerPrivilegesObjectIdentifier(objectType sdk.ObjectType, objectName string) (sdk.ObjectIdentifier, error) {
+	switch {
+	case slices.Contains(sdk.ValidGrantToAccountObjectTypes, objectType):
+		return sdk.ParseAccountObjectIdentifier(objectName)
+	case objectType == sdk.ObjectTypeSchema:
+		return sdk.ParseDatabaseObjectIdentifier(objectName)
+	default:
+		return sdk.ParseSchemaObjectIdentifier(objectName)
+	}
+}
+
+func (g rolePrivilegesGrantee) callerGrantee() *sdk.CallerGrantee {
+	return &sdk.CallerGrantee{AccountRole: g.AccountRole, DatabaseRole: g.DatabaseRole}
+}
+
+func (id GrantCallerPrivilegesId) callerGrantPrivileges(privileges []string) *sdk.CallerGrantPrivileges {
+	if id.AllPrivileges {
+		return &sdk.CallerGrantPrivileges{AllPrivileges: sdk.Bool(true)}
+	}
+	return &sdk.CallerGrantPrivileges{Privileges: privileges}
+}
+
+func createGrantCallerPrivilegesIdFromSchema(d *schema.ResourceData) (GrantCallerPrivilegesId, error) {
+	id := GrantCallerPrivilegesId{
+		AllPrivileges: d.Get("all_privileges").(bool),
+		Privileges:    expandStringList(d.Get("privileges").(*schema.Set).List()),
+	}
+	slices.Sort(id.Privileges)
+
+	grantee, err := rolePrivilegesGranteeFromSchema(d)
+	if err != nil {
+		return id, err
+	}
+	id.Grantee = grantee
+
+	if v, ok := d.GetOk("on_object"); ok && len(v.([]any)) > 0 {
+		onObject := v.([]any)[0].(map[string]any)
+		objectType := sdk.ObjectType(strings.ToUpper(onObject["object_type"].(string)))
+		objectName, err := grantCallerPrivilegesObjectIdentifier(objectType, onObject["object_name"].(string))
+		if err != nil {
+			return id, err
+		}
+		id.On.Object = &sdk.Object{ObjectType: objectType, Name: objectName}
+		return id, nil
+	}
+
+	onAll := d.Get("on_all").([]any)[0].(map[string]any)
+	id.On.All = &sdk.CallerGrantOnAll{PluralObjectType: sdk.PluralObjectType(strings.ToUpper(onAll["object_type_plural"].(string)))}
+	switch {
+	case onAll["in_database"].(string) != "":
+		databaseId, err := sdk.ParseAccountObjectIdentifier(onAll["in_database"].(string))
+		if err != nil {
+			return id, err
+		}
+		id.On.All.InDatabase = &databaseId
+	case onAll["in_schema"].(string) != "":
+		schemaId, err := sdk.ParseDatabaseObjectIdentifier(onAll["in_schema"].(string))
+		if err != nil {
+			return id, err
+		}
+		id.On.All.InSchema = &schemaId
+	default:
+		id.On.All.InAccount = sdk.Bool(true)
+	}
+	return id, nil
+}
+
+func ImportGrantCallerPrivileges(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	id, err := ParseGrantCallerPrivilegesId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if id.Grantee.AccountRole != nil {
+		err = d.Set("account_role_name", id.Grantee.AccountRole.FullyQualifiedName())
+	} else {
+		err = d.Set("database_role_name", id.Grantee.DatabaseRole.FullyQualifiedName())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("all_privileges", id.AllPrivileges); err != nil {
+		return nil, err
+	}
+	if err := d.Set("privileges", id.Privileges); err != nil {
+		return nil, err
+	}
+
+	if id.On.Object != nil {
+		if err := d.Set("on_object", []any{
+			map[string]any{
+				"object_type": id.On.Object.ObjectType.String(),
+				"object_name": id.On.Object.Name.FullyQualifiedName(),
+			},
+		}); err != nil {
+			return nil, err
+		}
+	} else {
+		onAll := map[string]any{
+			"object_type_plural": id.On.All.PluralObjectType.String(),
+			"in_account":         id.On.All.InAccount != nil && *id.On.All.InAccount,
+		}
+		if id.On.All.InDatabase != nil {
+			onAll["in_database"] = id.On.All.InDatabase.FullyQualifiedName()
+		}
+		if id.On.All.InSchema != nil {
+			onAll["in_schema"] = id.On.All.InSchema.FullyQualifiedName()
+		}
+		if err := d.Set("on_all", []any{onAll}); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateGrantCallerPrivileges(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := createGrantCallerPrivilegesIdFromSchema(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[DEBUG] created identifier from schema: %s", id.String())
+
+	if err := client.CallerGrants.Grant(ctx, id.callerGrantPrivileges(id.Privileges), &id.On, id.Grantee.callerGrantee()); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when granting caller privileges",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", id.String(), err),
+			},
+		}
+	}
+
+	d.SetId(id.String())
+
+	return ReadGrantCallerPrivileges(ctx, d, meta)
+}
+
+func UpdateGrantCallerPrivileges(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := ParseGrantCallerPrivilegesId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("privileges") {
+		before, after := d.GetChange("privileges")
+		privilegesBeforeChange := expandStringList(before.(*schema.Set).List())
+		privilegesAfterChange := expandStringList(after.(*schema.Set).List())
+
+		var privilegesToAdd, privilegesToRemove []string
+		for _, privilege := range privilegesBeforeChange {
+			if !slices.Contains(privilegesAfterChange, privilege) {
+				privilegesToRemove = append(privilegesToRemove, privilege)
+			}
+		}
+		for _, privilege := range privilegesAfterChange {
+			if !slices.Contains(privilegesBeforeChange, privilege) {
+				privilegesToAdd = append(privilegesToAdd, privilege)
+			}
+		}
+
+		if len(privilegesToAdd) > 0 {
+			if err := client.CallerGrants.Grant(ctx, &sdk.CallerGrantPrivileges{Privileges: privilegesToAdd}, &id.On, id.Grantee.callerGrantee()); err != nil {
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "Failed to grant added caller privileges",
+						Detail:   fmt.Sprintf("Id: %s\nPrivileges to add: %v\nError: %s", d.Id(), privilegesToAdd, err),
+					},
+				}
+			}
+		}
+		if len(privilegesToRemove) > 0 {
+			if err := client.CallerGrants.Revoke(ctx, &sdk.CallerGrantPrivileges{Privileges: privilegesToRemove}, &id.On, id.Grantee.callerGrantee()); err != nil {
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "Failed to revoke removed caller privileges",
+						Detail:   fmt.Sprintf("Id: %s\nPrivileges to remove: %v\nError: %s", d.Id(), privilegesToRemove, err),
+					},
+				}
+			}
+		}
+
+		id.Privileges = privilegesAfterChange
+		slices.Sort(id.Privileges)
+		d.SetId(id.String())
+	}
+
+	return ReadGrantCallerPrivileges(ctx, d, meta)
+}
+
+func ReadGrantCallerPrivileges(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	id, err := ParseGrantCallerPrivilegesId(d.Id())
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to parse internal identifier",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+			},
+		}
+	}
+
+	if id.AllPrivileges {
+		log.Printf("[INFO] Show with all_privileges option is skipped. No changes in caller privileges in Snowflake will be detected. Consider specifying all privileges in 'privileges' block.")
+		return nil
+	}
+
+	client := meta.(*provider.Context).Client
+
+	if id.Grantee.AccountRole != nil {
+		_, err = client.Roles.ShowByIDSafely(ctx, *id.Grantee.AccountRole)
+	} else {
+		_, err = client.DatabaseRoles.ShowByIDSafely(ctx, *id.Grantee.DatabaseRole)
+	}
+	if err != nil && errors.Is(err, sdk.ErrObjectNotFound) {
+		d.SetId("")
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Failed to retrieve role. Marking the resource as removed.",
+				Detail:   fmt.Sprintf("Id: %s", d.Id()),
+			},
+		}
+	}
+
+	// the inherited caller grants are not returned for the single objects, so they are read from all the caller grants of the grantee
+	opts := &sdk.ShowCallerGrantsOptions{On: id.On.Object}
+	var accountName string
+	if id.On.All != nil {
+		opts = &sdk.ShowCallerGrantsOptions{To: id.Grantee.callerGrantee()}
+		if id.On.All.InAccount != nil && *id.On.All.InAccount {
+			if accountName, err = client.ContextFunctions.CurrentAccountName(ctx); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	callerGrants, err := client.CallerGrants.Show(ctx, opts)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve caller grants. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to retrieve caller grants",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+			},
+		}
+	}
+
+	privileges := grantedCallerPrivileges(callerGrants, id, accountName)
+	if err := d.Set("privileges", privileges); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Error setting caller privileges",
+				Detail:   fmt.Sprintf("Id: %s\nPrivileges: %v\nError: %s", d.Id(), privileges, err),
+			},
+		}
+	}
+
+	return nil
+}
+
+// grantedCallerPrivileges returns the privileges from the identifier that are granted to the grantee. Only the privileges
+// present in the identifier are considered, so that the caller privileges managed by other resources are not revoked.
+// The account name is used to match the inherited caller grants in the account.
+func grantedCallerPrivileges(callerGrants []sdk.CallerGrant, id GrantCallerPrivilegesId, accountName string) []string {
+	granteeType, granteeName := sdk.ObjectTypeRole, ""
+	if id.Grantee.AccountRole != nil {
+		granteeName = id.Grantee.AccountRole.Name()
+	} else {
+		granteeType, granteeName = sdk.ObjectTypeDatabaseRole, id.Grantee.DatabaseRole.Name()
+	}
+
+	privileges := make([]string, 0)
+	for _, callerGrant := range callerGrants {
+		if callerGrant.GrantedTo != granteeType || !isCallerGrantOn(callerGrant, id.On, accountName) || !slices.Contains(id.Privileges, callerGrant.Privilege) {
+			continue
+		}
+		// the database roles are returned with the database name
+		parts := strings.Split(callerGrant.GranteeName, ".")
+		if parts[len(parts)-1] != granteeName || slices.Contains(privileges, callerGrant.Privilege) {
+			continue
+		}
+		privileges = append(privileges, callerGrant.Privilege)
+	}
+	return privileges
+}
+
+// isCallerGrantOn checks if the caller grant is made on the object, or on all the objects of the type in the container (for the inherited
+// caller grants, the name is the container).
+func isCallerGrantOn(callerGrant sdk.CallerGrant, on sdk.CallerGrantOn, accountName string) bool {
+	if on.Object != nil {
+		return callerGrant.GrantedOn == on.Object.ObjectType
+	}
+	if callerGrant.GrantedOn != on.All.PluralObjectType.Singular() {
+		return false
+	}
+	name := sdk.NewObjectIdentifierFromFullyQualifiedName(callerGrant.Name).FullyQualifiedName()
+	switch {
+	case on.All.InDatabase != nil:
+		return name == on.All.InDatabase.FullyQualifiedName()
+	case on.All.InSchema != nil:
+		return name == on.All.InSchema.FullyQualifiedName()
+	default:
+		return name == sdk.NewAccountObjectIdentifier(accountName).FullyQualifiedName()
+	}
+}
+
+func DeleteGrantCallerPrivileges(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := ParseGrantCallerPrivilegesId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.CallerGrants.Revoke(ctx, id.callerGrantPrivileges(id.Privileges), &id.On, id.Grantee.callerGrantee()); err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when revoking caller privileges",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+			},
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGrantCallerPrivilegesId(t *testing.T) {
+	accountRole := sdk.NewAccountObjectIdentifier("role")
+	databaseRole := sdk.NewDatabaseObjectIdentifier("database", "role")
+	database := sdk.NewAccountObjectIdentifier("database")
+	schema := sdk.NewDatabaseObjectIdentifier("database", "schema")
+
+	testCases := []struct {
+		Name     string
+		Id       string
+		Expected GrantCallerPrivilegesId
+		Error    string
+	}{
+		{
+			Name: "on object",
+			Id:   `ACCOUNT_ROLE|"role"|INSERT,SELECT|OnObject|TABLE|"database"."schema"."table"`,
+			Expected: GrantCallerPrivilegesId{
+				Grantee:    rolePrivilegesGrantee{AccountRole: &accountRole},
+				Privileges: []string{"INSERT", "SELECT"},
+				On: sdk.CallerGrantOn{
+					Object: &sdk.Object{ObjectType: sdk.ObjectTypeTable, Name: sdk.NewSchemaObjectIdentifier("database", "schema", "table")},
+				},
+			},
+		},
+		{
+			Name: "on account object",
+			Id:   `ACCOUNT_ROLE|"role"|USAGE|OnObject|WAREHOUSE|"warehouse"`,
+			Expected: GrantCallerPrivilegesId{
+				Grantee:    rolePrivilegesGrantee{AccountRole: &accountRole},
+				Privileges: []string{"USAGE"},
+				On: sdk.CallerGrantOn{
+					Object: &sdk.Object{ObjectType: sdk.ObjectTypeWarehouse, Name: sdk.NewAccountObjectIdentifier("warehouse")},
+				},
+			},
+		},
+		{
+			Name: "on all in account",
+			Id:   `ACCOUNT_ROLE|"role"|ALL|OnAll|WAREHOUSES|InAccount`,
+			Expected: GrantCallerPrivilegesId{
+				Grantee:       rolePrivilegesGrantee{AccountRole: &accountRole},
+				AllPrivileges: true,
+				On: sdk.CallerGrantOn{
+					All: &sdk.CallerGrantOnAll{PluralObjectType: sdk.PluralObjectTypeWarehouses, InAccount: sdk.Bool(true)},
+				},
+			},
+		},
+		{
+			Name: "on all in database",
+			Id:   `DATABASE_ROLE|"database"."role"|SELECT|OnAll|TABLES|InDatabase|"database"`,
+			Expected: GrantCallerPrivilegesId{
+				Grantee:    rolePrivilegesGrantee{DatabaseRole: &databaseRole},
+				Privileges: []string{"SELECT"},
+				On: sdk.CallerGrantOn{
+					All: &sdk.CallerGrantOnAll{PluralObjectType: sdk.PluralObjectTypeTables, InDatabase: &database},
+				},
+			},
+		},
+		{
+			Name: "on all in schema",
+			Id:   `DATABASE_ROLE|"database"."role"|SELECT|OnAll|VIEWS|InSchema|"database"."schema"`,
+			Expected: GrantCallerPrivilegesId{
+				Grantee:    rolePrivilegesGrantee{DatabaseRole: &databaseRole},
+				Privileges: []string{"SELECT"},
+				On: sdk.CallerGrantOn{
+					All: &sdk.CallerGrantOnAll{PluralObjectType: sdk.PluralObjectTypeViews, InSchema: &schema},
+				},
+			},
+		},
+		{
+			Name:  "validation: too few parts",
+			Id:    `ACCOUNT_ROLE|"role"|SELECT|OnObject`,
+			Error: "grant caller privileges identifier should hold at least 5 parts",
+		},
+		{
+			Name:  "validation: invalid kind",
+			Id:    `ACCOUNT_ROLE|"role"|SELECT|OnFuture|TABLES|InAccount`,
+			Error: "invalid grant caller privileges kind: OnFuture",
+		},
+		{
+			Name:  "validation: missing container name",
+			Id:    `ACCOUNT_ROLE|"role"|SELECT|OnAll|TABLES|InDatabase`,
+			Error: `grant caller privileges identifier should end with`,
+		},
+		{
+			Name:  "validation: invalid object name",
+			Id:    `ACCOUNT_ROLE|"role"|SELECT|OnObject|TABLE|"database"."schema"`,
+			Error: "unexpected number of parts",
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			id, err := ParseGrantCallerPrivilegesId(tt.Id)
+			if tt.Error != "" {
+				require.ErrorContains(t, err, tt.Error)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.Expected, id)
+			assert.Equal(t, tt.Id, id.String())
+		})
+	}
+}
+
+func TestGrantedCallerPrivileges(t *testing.T) {
+	accountRole := sdk.NewAccountObjectIdentifier("ROLE")
+	databaseRole := sdk.NewDatabaseObjectIdentifier("DATABASE", "ROLE")
+	table := &sdk.Object{ObjectType: sdk.ObjectTypeTable, Name: sdk.NewSchemaObjectIdentifier("DATABASE", "SCHEMA", "TABLE")}
+	callerGrant := func(privilege string, grantedTo sdk.ObjectType, granteeName string) sdk.CallerGrant {
+		return sdk.CallerGrant{Privilege: privilege, GrantedOn: sdk.ObjectTypeTable, Name: "DATABASE.SCHEMA.TABLE", GrantedTo: grantedTo, GranteeName: granteeName}
+	}
+	callerGrants := []sdk.CallerGrant{
+		callerGrant("SELECT", sdk.ObjectTypeRole, "ROLE"),
+		callerGrant("UPDATE", sdk.ObjectTypeRole, "ROLE"),
+		callerGrant("INSERT", sdk.ObjectTypeRole, "OTHER_ROLE"),
+		callerGrant("INSERT", sdk.ObjectTypeDatabaseRole, "DATABASE.ROLE"),
+	}
+
+	t.Run("account role", func(t *testing.T) {
+		id := GrantCallerPrivilegesId{
+			Grantee:    rolePrivilegesGrantee{AccountRole: &accountRole},
+			Privileges: []string{"INSERT", "SELECT"},
+			On:         sdk.CallerGrantOn{Object: table},
+		}
+		assert.Equal(t, []string{"SELECT"}, grantedCallerPrivileges(callerGrants, id, ""))
+	})
+
+	t.Run("database role", func(t *testing.T) {
+		id := GrantCallerPrivilegesId{
+			Grantee:    rolePrivilegesGrantee{DatabaseRole: &databaseRole},
+			Privileges: []string{"INSERT", "SELECT"},
+			On:         sdk.CallerGrantOn{Object: table},
+		}
+		assert.Equal(t, []string{"INSERT"}, grantedCallerPrivileges(callerGrants, id, ""))
+	})
+
+	t.Run("on all", func(t *testing.T) {
+		schema := sdk.NewDatabaseObjectIdentifier("DATABASE", "SCHEMA")
+		database := sdk.NewAccountObjectIdentifier("DATABASE")
+		inheritedGrants := []sdk.CallerGrant{
+			{Privilege: "SELECT", GrantedOn: sdk.ObjectTypeTable, Name: "DATABASE.SCHEMA", GrantedTo: sdk.ObjectTypeRole, GranteeName: "ROLE"},
+			{Privilege: "INSERT", GrantedOn: sdk.ObjectTypeTable, Name: "DATABASE", GrantedTo: sdk.ObjectTypeRole, GranteeName: "ROLE"},
+			{Privilege: "UPDATE", GrantedOn: sdk.ObjectTypeTable, Name: "ACCOUNT", GrantedTo: sdk.ObjectTypeRole, GranteeName: "ROLE"},
+			{Privilege: "UPDATE", GrantedOn: sdk.ObjectTypeView, Name: "DATABASE.SCHEMA", GrantedTo: sdk.ObjectTypeRole, GranteeName: "ROLE"},
+		}
+		privileges := []string{"INSERT", "SELECT", "UPDATE"}
+
+		id := GrantCallerPrivilegesId{
+			Grantee:    rolePrivilegesGrantee{AccountRole: &accountRole},
+			Privileges: privileges,
+			On:         sdk.CallerGrantOn{All: &sdk.CallerGrantOnAll{PluralObjectType: sdk.PluralObjectTypeTables, InSchema: &schema}},
+		}
+		assert.Equal(t, []string{"SELECT"}, grantedCallerPrivileges(inheritedGrants, id, ""))
+
+		id.On.All = &sdk.CallerGrantOnAll{PluralObjectType: sdk.PluralObjectTypeTables, InDatabase: &database}
+		assert.Equal(t, []string{"INSERT"}, grantedCallerPrivileges(inheritedGrants, id, ""))
+
+		id.On.All = &sdk.CallerGrantOnAll{PluralObjectType: sdk.PluralObjectTypeTables, InAccount: sdk.Bool(true)}
+		assert.Equal(t, []string{"UPDATE"}, grantedCallerPrivileges(inheritedGrants, id, "ACCOUNT"))
+	})
+}
@@ -67,7 +67,7 @@ var grantDatabaseRoleSchema = map[string]*schema.Schema{
 }
 
 func GrantDatabaseRole() *schema.Resource {
-	return &schema.Resource{
+	return withResourceIdentity(grantDatabaseRoleIdentity, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.GrantDatabaseRole, CreateGrantDatabaseRole),
 		ReadContext:   TrackingReadWrapper(resources.GrantDatabaseRole, ReadGrantDatabaseRole),
 		DeleteContext: TrackingDeleteWrapper(resources.GrantDatabaseRole, DeleteGrantDatabaseRole),
@@ -120,7 +120,7 @@ func GrantDatabaseRole() *schema.Resource {
 			}),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 // CreateGrantDatabaseRole implements schema.CreateFunc.
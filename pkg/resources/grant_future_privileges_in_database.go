@@ -0,0 +1,557 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var grantFuturePrivilegesInDatabaseSchema = map[string]*schema.Schema{
+	"account_role_name":  rolePrivilegesSchema["account_role_name"],
+	"database_role_name": rolePrivilegesSchema["database_role_name"],
+	"in_database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      relatedResourceDescription("The fully qualified name of the database in which the future privileges are granted.", resources.Database),
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"object_type_plural": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      fmt.Sprintf("The plural object type of the future objects on which the privileges are granted. Valid values are: %s.", possibleValuesListed(sdk.ValidGrantToFuturePluralObjectTypesString)),
+		ValidateDiagFunc: StringInSlice(sdk.ValidGrantToFuturePluralObjectTypesString, false),
+	},
+	"privileges": {
+		Type:        schema.TypeSet,
+		Required:    true,
+		MinItems:    1,
+		Description: "The privileges to grant on the future objects. This field is case-sensitive; use only upper-case privileges.",
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: isNotOwnershipGrant(),
+		},
+	},
+	"with_grant_option": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "Specifies whether the grantee can grant the privileges to other roles.",
+	},
+	"schemas": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The schemas of the database in which the privileges are granted too, because they have the schema-level future grants on the object type to other roles. Snowflake ignores the database-level future grants in these schemas. When the future grants to other roles are removed from the schema, the privileges are revoked from it during the next apply, so that they do not shadow the database-level future grants of other roles.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+}
+
+func GrantFuturePrivilegesInDatabase() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.GrantFuturePrivilegesInDatabaseResource), TrackingCreateWrapper(resources.GrantFuturePrivilegesInDatabase, CreateGrantFuturePrivilegesInDatabase)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.GrantFuturePrivilegesInDatabaseResource), TrackingReadWrapper(resources.GrantFuturePrivilegesInDatabase, ReadGrantFuturePrivilegesInDatabase)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.GrantFuturePrivilegesInDatabaseResource), TrackingUpdateWrapper(resources.GrantFuturePrivilegesInDatabase, UpdateGrantFuturePrivilegesInDatabase)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.GrantFuturePrivilegesInDatabaseResource), TrackingDeleteWrapper(resources.GrantFuturePrivilegesInDatabase, DeleteGrantFuturePrivilegesInDatabase)),
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.GrantFuturePrivilegesInDatabase, grantFuturePrivilegesInDatabaseSchemasCustomDiff),
+		Description:   "Resource used to manage the future privileges in the database granted to an account role or a database role. Snowflake ignores the database-level future grants on the object type in the schemas that have their own future grants on the same object type, so the resource grants the privileges in such schemas too, keeping both levels consistent. The schemas with the schema-level future grants created outside Terraform are detected during the refresh, and the privileges are granted in them during the next apply. The future grants to shares are not supported by Snowflake.",
+
+		Schema: grantFuturePrivilegesInDatabaseSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.GrantFuturePrivilegesInDatabase, ImportGrantFuturePrivilegesInDatabase),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+// grantFuturePrivilegesInDatabaseId identifies the future grants on the object type in the database to the role.
+type grantFuturePrivilegesInDatabaseId struct {
+	Grantee          rolePrivilegesGrantee
+	Database         sdk.AccountObjectIdentifier
+	ObjectTypePlural sdk.PluralObjectType
+	WithGrantOption  bool
+}
+
+func (id grantFuturePrivilegesInDatabaseId) String() string {
+	return helpers.EncodeResourceIdentifier(id.Grantee.String(), id.Database.FullyQualifiedName(), id.ObjectTypePlural.String(), strconv.FormatBool(id.WithGrantOption))
+}
+
+func parseGrantFuturePrivilegesInDatabaseId(id string) (grantFuturePrivilegesInDatabaseId, error) {
+	parts := helpers.ParseResourceIdentifier(id)
+	if len(parts) != 5 {
+		return grantFuturePrivilegesInDatabaseId{}, fmt.Errorf(`invalid future privileges identifier %s, expected <ACCOUNT_ROLE|DATABASE_ROLE>|<role_name>|<database_name>|<object_type_plural>|<with_grant_option>`, id)
+	}
+	grantee, err := parseRolePrivilegesGrantee(helpers.EncodeResourceIdentifier(parts[0], parts[1]))
+	if err != nil {
+		return grantFuturePrivilegesInDatabaseId{}, err
+	}
+	database, err := sdk.ParseAccountObjectIdentifier(parts[2])
+	if err != nil {
+		return grantFuturePrivilegesInDatabaseId{}, err
+	}
+	objectTypePlural := sdk.PluralObjectType(parts[3])
+	if !slices.Contains(sdk.ValidGrantToFuturePluralObjectTypesString, objectTypePlural.String()) {
+		return grantFuturePrivilegesInDatabaseId{}, fmt.Errorf("invalid object type plural %s in the future privileges identifier", parts[3])
+	}
+	withGrantOption, err := strconv.ParseBool(parts[4])
+	if err != nil {
+		return grantFuturePrivilegesInDatabaseId{}, fmt.Errorf("invalid with_grant_option %s in the future privileges identifier, expected true or false", parts[4])
+	}
+	return grantFuturePrivilegesInDatabaseId{
+		Grantee:          grantee,
+		Database:         database,
+		ObjectTypePlural: objectTypePlural,
+		WithGrantOption:  withGrantOption,
+	}, nil
+}
+
+// futureGrantLevels returns the database and the schemas in which the future privileges are granted.
+func (id grantFuturePrivilegesInDatabaseId) futureGrantLevels(schemas []sdk.DatabaseObjectIdentifier) []*sdk.GrantOnSchemaObjectIn {
+	return append([]*sdk.GrantOnSchemaObjectIn{{PluralObjectType: id.ObjectTypePlural, InDatabase: sdk.Pointer(id.Database)}}, id.schemaGrantLevels(schemas)...)
+}
+
+// schemaGrantLevels returns the given schemas as the levels of the future privileges.
+func (id grantFuturePrivilegesInDatabaseId) schemaGrantLevels(schemas []sdk.DatabaseObjectIdentifier) []*sdk.GrantOnSchemaObjectIn {
+	levels := make([]*sdk.GrantOnSchemaObjectIn, 0, len(schemas))
+	for _, schemaId := range schemas {
+		levels = append(levels, &sdk.GrantOnSchemaObjectIn{PluralObjectType: id.ObjectTypePlural, InSchema: sdk.Pointer(schemaId)})
+	}
+	return levels
+}
+
+// shadowingSchemas returns the schemas of the database with the schema-level future grants on the object type to other roles. The resource
+// grants the privileges in them too; its own schema-level grants are not taken into account, as they would keep the schemas on the list forever.
+func (id grantFuturePrivilegesInDatabaseId) shadowingSchemas(ctx context.Context, client *sdk.Client) ([]sdk.DatabaseObjectIdentifier, error) {
+	return schemasWithFutureGrantsOn(ctx, client, id.Database, id.ObjectTypePlural.Singular(), id.isGrantee)
+}
+
+// schemasNoLongerShadowing returns the schemas in which the privileges were granted, but which no longer have the future grants to other roles.
+func schemasNoLongerShadowing(grantedSchemas []sdk.DatabaseObjectIdentifier, shadowingSchemas []sdk.DatabaseObjectIdentifier) []sdk.DatabaseObjectIdentifier {
+	stale := make([]sdk.DatabaseObjectIdentifier, 0)
+	for _, schemaId := range grantedSchemas {
+		if !slices.ContainsFunc(shadowingSchemas, func(s sdk.DatabaseObjectIdentifier) bool {
+			return s.FullyQualifiedName() == schemaId.FullyQualifiedName()
+		}) {
+			stale = append(stale, schemaId)
+		}
+	}
+	return stale
+}
+
+// grantedSchemas returns the schemas in which the privileges were granted, as saved in the state.
+func grantedSchemas(schemas any) ([]sdk.DatabaseObjectIdentifier, error) {
+	return collections.MapErr(expandStringList(schemas.([]any)), sdk.ParseDatabaseObjectIdentifier)
+}
+
+func (g rolePrivilegesGrantee) grantFuture(ctx context.Context, client *sdk.Client, in *sdk.GrantOnSchemaObjectIn, privileges []string, withGrantOption bool) error {
+	on := &sdk.GrantOnSchemaObject{Future: in}
+	if g.AccountRole != nil {
+		return client.Grants.GrantPrivilegesToAccountRole(ctx, getAccountRolePrivileges(false, privileges, false, false, false, true), &sdk.AccountRoleGrantOn{SchemaObject: on}, *g.AccountRole, &sdk.GrantPrivilegesToAccountRoleOptions{
+			WithGrantOption: sdk.Bool(withGrantOption),
+		})
+	}
+	return client.Grants.GrantPrivilegesToDatabaseRole(ctx, getDatabaseRolePrivileges(false, privileges, false, false, true), &sdk.DatabaseRoleGrantOn{SchemaObject: on}, *g.DatabaseRole, &sdk.GrantPrivilegesToDatabaseRoleOptions{
+		WithGrantOption: sdk.Bool(withGrantOption),
+	})
+}
+
+func (g rolePrivilegesGrantee) revokeFuture(ctx context.Context, client *sdk.Client, in *sdk.GrantOnSchemaObjectIn, privileges []string) error {
+	on := &sdk.GrantOnSchemaObject{Future: in}
+	if g.AccountRole != nil {
+		return client.Grants.RevokePrivilegesFromAccountRole(ctx, getAccountRolePrivileges(false, privileges, false, false, false, true), &sdk.AccountRoleGrantOn{SchemaObject: on}, *g.AccountRole, &sdk.RevokePrivilegesFromAccountRoleOptions{})
+	}
+	return client.Grants.RevokePrivilegesFromDatabaseRole(ctx, getDatabaseRolePrivileges(false, privileges, false, false, true), &sdk.DatabaseRoleGrantOn{SchemaObject: on}, *g.DatabaseRole, &sdk.RevokePrivilegesFromDatabaseRoleOptions{})
+}
+
+// isGrantedTo checks if the future grant is made to the role with the given grant option.
+func (id grantFuturePrivilegesInDatabaseId) isGrantedTo(grant sdk.Grant) bool {
+	return grant.GrantOption == id.WithGrantOption && id.isGrantee(grant)
+}
+
+// isGrantee checks if the future grant on the object type is made to the role, with or without the grant option.
+func (id grantFuturePrivilegesInDatabaseId) isGrantee(grant sdk.Grant) bool {
+	if grant.GrantOn != id.ObjectTypePlural.Singular() {
+		return false
+	}
+	if id.Grantee.AccountRole != nil {
+		return grant.GrantTo == sdk.ObjectTypeRole && grant.GranteeName.Name() == id.Grantee.AccountRole.Name()
+	}
+	return grant.GrantTo == sdk.ObjectTypeDatabaseRole && grant.GranteeName.Name() == id.Grantee.DatabaseRole.Name()
+}
+
+// showFuturePrivileges returns the future privileges granted to the role at the given level.
+func showFuturePrivileges(ctx context.Context, client *sdk.Client, id grantFuturePrivilegesInDatabaseId, in *sdk.GrantOnSchemaObjectIn) ([]string, error) {
+	opts := &sdk.ShowGrantOptions{
+		Future: sdk.Bool(true),
+		In: &sdk.ShowGrantsIn{
+			Database: in.InDatabase,
+			Schema:   in.InSchema,
+		},
+	}
+	grants, err := client.Grants.Show(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	privileges := make([]string, 0)
+	for _, grant := range grants {
+		if id.isGrantedTo(grant) {
+			privileges = append(privileges, grant.Privilege)
+		}
+	}
+	return privileges, nil
+}
+
+// privilegesGrantedOnAllLevels returns the expected privileges granted at every level. The privileges missing at any level
+// (e.g. in a schema with the schema-level future grants created outside Terraform) are left out, so that the plan grants them again.
+func privilegesGrantedOnAllLevels(grantedPerLevel [][]string, expectedPrivileges []string) []string {
+	privileges := make([]string, 0)
+	for _, privilege := range expectedPrivileges {
+		if !slices.ContainsFunc(grantedPerLevel, func(granted []string) bool { return !slices.Contains(granted, privilege) }) {
+			privileges = append(privileges, privilege)
+		}
+	}
+	return privileges
+}
+
+func grantFuturePrivileges(ctx context.Context, client *sdk.Client, id grantFuturePrivilegesInDatabaseId, levels []*sdk.GrantOnSchemaObjectIn, privileges []string) error {
+	for _, in := range levels {
+		if err := id.Grantee.grantFuture(ctx, client, in, privileges, id.WithGrantOption); err != nil {
+			return fmt.Errorf("granting %s on future %s in %s: %w", strings.Join(privileges, ", "), id.ObjectTypePlural, futureGrantLevelName(in), err)
+		}
+	}
+	return nil
+}
+
+func revokeFuturePrivileges(ctx context.Context, client *sdk.Client, id grantFuturePrivilegesInDatabaseId, levels []*sdk.GrantOnSchemaObjectIn, privileges []string) error {
+	log.Printf("[INFO] Revoking future privileges on %s from %s in %v: %v", id.ObjectTypePlural, id.Grantee, collections.Map(levels, futureGrantLevelName), privileges)
+	for _, in := range levels {
+		if err := id.Grantee.revokeFuture(ctx, client, in, privileges); err != nil {
+			return fmt.Errorf("revoking %s on future %s in %s: %w", strings.Join(privileges, ", "), id.ObjectTypePlural, futureGrantLevelName(in), err)
+		}
+	}
+	return nil
+}
+
+func futureGrantLevelName(in *sdk.GrantOnSchemaObjectIn) string {
+	if in.InSchema != nil {
+		return fmt.Sprintf("schema %s", in.InSchema.FullyQualifiedName())
+	}
+	return fmt.Sprintf("database %s", in.InDatabase.FullyQualifiedName())
+}
+
+// grantFuturePrivilegesInDatabaseSchemasCustomDiff plans the update when the schemas with the future grants to other roles differ from the schemas
+// in which the privileges are granted, e.g. when the future grants to other roles were removed from the schema outside Terraform.
+func grantFuturePrivilegesInDatabaseSchemasCustomDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" {
+		return nil
+	}
+	id, err := parseGrantFuturePrivilegesInDatabaseId(d.Id())
+	if err != nil {
+		return err
+	}
+	schemas, err := id.shadowingSchemas(ctx, meta.(*provider.Context).Client)
+	if err != nil {
+		return err
+	}
+	granted, err := grantedSchemas(d.Get("schemas"))
+	if err != nil {
+		return err
+	}
+	if len(granted) != len(schemas) || len(schemasNoLongerShadowing(granted, schemas)) > 0 {
+		return d.SetNewComputed("schemas")
+	}
+	return nil
+}
+
+func ImportGrantFuturePrivilegesInDatabase(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+
+	id, err := parseGrantFuturePrivilegesInDatabaseId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if id.Grantee.AccountRole != nil {
+		if err := d.Set("account_role_name", id.Grantee.AccountRole.FullyQualifiedName()); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := d.Set("database_role_name", id.Grantee.DatabaseRole.FullyQualifiedName()); err != nil {
+			return nil, err
+		}
+	}
+	if err := d.Set("in_database", id.Database.FullyQualifiedName()); err != nil {
+		return nil, err
+	}
+	if err := d.Set("object_type_plural", id.ObjectTypePlural.String()); err != nil {
+		return nil, err
+	}
+	if err := d.Set("with_grant_option", id.WithGrantOption); err != nil {
+		return nil, err
+	}
+
+	// the privileges granted at the database level are imported; the read leaves out the ones missing in the schemas
+	privileges, err := showFuturePrivileges(ctx, client, id, id.futureGrantLevels(nil)[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("privileges", privileges); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateGrantFuturePrivilegesInDatabase(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	grantee, err := rolePrivilegesGranteeFromSchema(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	database, err := sdk.ParseAccountObjectIdentifier(d.Get("in_database").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := grantFuturePrivilegesInDatabaseId{
+		Grantee:          grantee,
+		Database:         database,
+		ObjectTypePlural: sdk.PluralObjectType(d.Get("object_type_plural").(string)),
+		WithGrantOption:  d.Get("with_grant_option").(bool),
+	}
+
+	privileges := expandStringList(d.Get("privileges").(*schema.Set).List())
+	schemas, err := id.shadowingSchemas(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := grantFuturePrivileges(ctx, client, id, id.futureGrantLevels(schemas), privileges); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when granting future privileges",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", id, err),
+			},
+		}
+	}
+
+	d.SetId(id.String())
+
+	return ReadGrantFuturePrivilegesInDatabase(ctx, d, meta)
+}
+
+func UpdateGrantFuturePrivilegesInDatabase(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := parseGrantFuturePrivilegesInDatabaseId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	before, after := d.GetChange("privileges")
+	privilegesBeforeChange := expandStringList(before.(*schema.Set).List())
+	privilegesAfterChange := expandStringList(after.(*schema.Set).List())
+
+	var privilegesToRevoke []string
+	for _, privilege := range privilegesBeforeChange {
+		if !slices.Contains(privilegesAfterChange, privilege) {
+			privilegesToRevoke = append(privilegesToRevoke, privilege)
+		}
+	}
+
+	// the schemas saved in the state before the change are the ones in which the privileges were granted
+	schemasBefore, _ := d.GetChange("schemas")
+	granted, err := grantedSchemas(schemasBefore)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	schemas, err := id.shadowingSchemas(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	stale := schemasNoLongerShadowing(granted, schemas)
+
+	if len(privilegesToRevoke) > 0 {
+		if err := revokeFuturePrivileges(ctx, client, id, id.futureGrantLevels(append(schemas, stale...)), privilegesToRevoke); err != nil {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "An error occurred when revoking future privileges",
+					Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+				},
+			}
+		}
+	}
+
+	// the schema-level grants left in the schemas without the future grants to other roles would shadow the database-level future grants of other roles
+	if len(stale) > 0 {
+		if err := revokeFuturePrivileges(ctx, client, id, id.schemaGrantLevels(stale), privilegesAfterChange); err != nil {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "An error occurred when revoking future privileges",
+					Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+				},
+			}
+		}
+	}
+
+	// all the privileges are granted again, so that the schemas with the new schema-level future grants get them too
+	if err := grantFuturePrivileges(ctx, client, id, id.futureGrantLevels(schemas), privilegesAfterChange); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when granting future privileges",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+			},
+		}
+	}
+
+	return ReadGrantFuturePrivilegesInDatabase(ctx, d, meta)
+}
+
+func ReadGrantFuturePrivilegesInDatabase(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := parseGrantFuturePrivilegesInDatabaseId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if id.Grantee.AccountRole != nil {
+		_, err = client.Roles.ShowByIDSafely(ctx, *id.Grantee.AccountRole)
+	} else {
+		_, err = client.DatabaseRoles.ShowByIDSafely(ctx, *id.Grantee.DatabaseRole)
+	}
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve role. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	schemas, err := id.shadowingSchemas(ctx, client)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve grants. Target database not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to retrieve grants",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+			},
+		}
+	}
+
+	levels := id.futureGrantLevels(schemas)
+	grantedPerLevel := make([][]string, len(levels))
+	for i, in := range levels {
+		if grantedPerLevel[i], err = showFuturePrivileges(ctx, client, id, in); err != nil {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to retrieve grants",
+					Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+				},
+			}
+		}
+	}
+	privileges := privilegesGrantedOnAllLevels(grantedPerLevel, expandStringList(d.Get("privileges").(*schema.Set).List()))
+
+	// the schemas that no longer have the future grants to other roles are kept while the privileges are still granted in them,
+	// so that the custom diff plans revoking them
+	granted, err := grantedSchemas(d.Get("schemas"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for _, in := range id.schemaGrantLevels(schemasNoLongerShadowing(granted, schemas)) {
+		stalePrivileges, err := showFuturePrivileges(ctx, client, id, in)
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			continue
+		}
+		if err != nil {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to retrieve grants",
+					Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+				},
+			}
+		}
+		if len(stalePrivileges) > 0 {
+			schemas = append(schemas, *in.InSchema)
+		}
+	}
+
+	if err := d.Set("privileges", privileges); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("schemas", collections.Map(schemas, sdk.DatabaseObjectIdentifier.FullyQualifiedName)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func DeleteGrantFuturePrivilegesInDatabase(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := parseGrantFuturePrivilegesInDatabaseId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	privileges := expandStringList(d.Get("privileges").(*schema.Set).List())
+	if len(privileges) > 0 {
+		granted, err := grantedSchemas(d.Get("schemas"))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		schemas, err := id.shadowingSchemas(ctx, client)
+		if err != nil && !errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			return diag.FromErr(err)
+		}
+		if err := revokeFuturePrivileges(ctx, client, id, id.futureGrantLevels(append(schemas, schemasNoLongerShadowing(granted, schemas)...)), privileges); err != nil && !errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "An error occurred when revoking future privileges",
+					Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+				},
+			}
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGrantFuturePrivilegesInDatabaseId(t *testing.T) {
+	accountRole := sdk.NewAccountObjectIdentifier("role")
+	databaseRole := sdk.NewDatabaseObjectIdentifier("database", "role")
+
+	testCases := []struct {
+		Name     string
+		Id       string
+		Expected grantFuturePrivilegesInDatabaseId
+		Error    string
+	}{
+		{
+			Name: "account role",
+			Id:   `ACCOUNT_ROLE|"role"|"database"|TABLES|false`,
+			Expected: grantFuturePrivilegesInDatabaseId{
+				Grantee:          rolePrivilegesGrantee{AccountRole: &accountRole},
+				Database:         sdk.NewAccountObjectIdentifier("database"),
+				ObjectTypePlural: sdk.PluralObjectTypeTables,
+			},
+		},
+		{
+			Name: "database role with grant option",
+			Id:   `DATABASE_ROLE|"database"."role"|"database"|VIEWS|true`,
+			Expected: grantFuturePrivilegesInDatabaseId{
+				Grantee:          rolePrivilegesGrantee{DatabaseRole: &databaseRole},
+				Database:         sdk.NewAccountObjectIdentifier("database"),
+				ObjectTypePlural: sdk.PluralObjectTypeViews,
+				WithGrantOption:  true,
+			},
+		},
+		{
+			Name:  "validation: missing parts",
+			Id:    `ACCOUNT_ROLE|"role"|"database"|TABLES`,
+			Error: "invalid future privileges identifier",
+		},
+		{
+			Name:  "validation: invalid role kind",
+			Id:    `APPLICATION_ROLE|"role"|"database"|TABLES|false`,
+			Error: "invalid role kind APPLICATION_ROLE",
+		},
+		{
+			Name:  "validation: invalid object type plural",
+			Id:    `ACCOUNT_ROLE|"role"|"database"|TABLE|false`,
+			Error: "invalid object type plural TABLE",
+		},
+		{
+			Name:  "validation: invalid with grant option",
+			Id:    `ACCOUNT_ROLE|"role"|"database"|TABLES|yes`,
+			Error: "invalid with_grant_option yes",
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			id, err := parseGrantFuturePrivilegesInDatabaseId(tt.Id)
+			if tt.Error != "" {
+				require.ErrorContains(t, err, tt.Error)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.Expected, id)
+			assert.Equal(t, tt.Id, id.String())
+		})
+	}
+}
+
+func TestPrivilegesGrantedOnAllLevels(t *testing.T) {
+	testCases := []struct {
+		Name            string
+		GrantedPerLevel [][]string
+		Expected        []string
+	}{
+		{
+			Name:            "database level only",
+			GrantedPerLevel: [][]string{{"SELECT", "INSERT"}},
+			Expected:        []string{"SELECT", "INSERT"},
+		},
+		{
+			Name:            "privileges granted in all the schemas",
+			GrantedPerLevel: [][]string{{"SELECT", "INSERT"}, {"INSERT", "SELECT", "UPDATE"}},
+			Expected:        []string{"SELECT", "INSERT"},
+		},
+		{
+			Name:            "privilege missing in one of the schemas",
+			GrantedPerLevel: [][]string{{"SELECT", "INSERT"}, {"SELECT"}},
+			Expected:        []string{"SELECT"},
+		},
+		{
+			Name:            "schema with the future grants to other roles only",
+			GrantedPerLevel: [][]string{{"SELECT", "INSERT"}, {}},
+			Expected:        []string{},
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, privilegesGrantedOnAllLevels(tt.GrantedPerLevel, []string{"SELECT", "INSERT"}))
+		})
+	}
+}
+
+func TestSchemasNoLongerShadowing(t *testing.T) {
+	first := sdk.NewDatabaseObjectIdentifier("database", "first")
+	second := sdk.NewDatabaseObjectIdentifier("database", "second")
+
+	assert.Empty(t, schemasNoLongerShadowing(nil, []sdk.DatabaseObjectIdentifier{first}))
+	assert.Empty(t, schemasNoLongerShadowing([]sdk.DatabaseObjectIdentifier{first}, []sdk.DatabaseObjectIdentifier{first, second}))
+	assert.Equal(t, []sdk.DatabaseObjectIdentifier{second}, schemasNoLongerShadowing([]sdk.DatabaseObjectIdentifier{first, second}, []sdk.DatabaseObjectIdentifier{first}))
+}
+
+func TestGrantFuturePrivilegesInDatabaseId_isGrantee(t *testing.T) {
+	accountRole := sdk.NewAccountObjectIdentifier("role")
+	id := grantFuturePrivilegesInDatabaseId{
+		Grantee:          rolePrivilegesGrantee{AccountRole: &accountRole},
+		Database:         sdk.NewAccountObjectIdentifier("database"),
+		ObjectTypePlural: sdk.PluralObjectTypeTables,
+	}
+	grant := func(grantOn sdk.ObjectType, grantee string, grantOption bool) sdk.Grant {
+		return sdk.Grant{GrantOn: grantOn, GrantTo: sdk.ObjectTypeRole, GranteeName: sdk.NewAccountObjectIdentifier(grantee), GrantOption: grantOption}
+	}
+
+	// the own grants are ignored when looking for the schemas with the future grants, regardless of the grant option
+	assert.True(t, id.isGrantee(grant(sdk.ObjectTypeTable, "role", false)))
+	assert.True(t, id.isGrantee(grant(sdk.ObjectTypeTable, "role", true)))
+	assert.False(t, id.isGrantee(grant(sdk.ObjectTypeTable, "other", false)))
+	assert.False(t, id.isGrantee(grant(sdk.ObjectTypeView, "role", false)))
+
+	assert.True(t, id.isGrantedTo(grant(sdk.ObjectTypeTable, "role", false)))
+	assert.False(t, id.isGrantedTo(grant(sdk.ObjectTypeTable, "role", true)))
+}
@@ -1,10 +1,20 @@
 package resources
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func isNotOwnershipGrant() func(value any, path cty.Path) diag.Diagnostics {
@@ -21,3 +31,163 @@ func isNotOwnershipGrant() func(value any, path cty.Path) diag.Diagnostics {
 		return diags
 	}
 }
+
+// onAllSchemaObjectsGrantData returns the bulk operation data of the on_schema_object.on_all grant, or nil for the other grants.
+// The grants on objects with arguments (e.g. functions) are not returned, because they cannot be shown object by object.
+func onAllSchemaObjectsGrantData(data any) *BulkOperationGrantData {
+	onSchemaObject, ok := data.(*OnSchemaObjectGrantData)
+	if !ok || onSchemaObject.Kind != OnAllSchemaObjectGrantKind || onSchemaObject.OnAllOrFuture.ObjectNamePlural.Singular().IsWithArguments() {
+		return nil
+	}
+	return onSchemaObject.OnAllOrFuture
+}
+
+// privilegesGrantedOnAllObjects returns the expected privileges granted on every object matched by the bulk grant.
+// The privileges missing on any of the objects (e.g. created after the grant) are left out, so that the plan grants them again.
+func privilegesGrantedOnAllObjects(objects []sdk.ObjectGrants, expectedPrivileges []string, isGranted func(sdk.Grant) bool) []string {
+	missingPrivileges := make(map[string]bool)
+	for _, object := range objects {
+		grantedPrivileges := make(map[string]bool)
+		for _, grant := range object.Grants {
+			if isGranted(grant) {
+				grantedPrivileges[grant.Privilege] = true
+			}
+		}
+		for _, privilege := range expectedPrivileges {
+			if !grantedPrivileges[privilege] {
+				log.Printf("[DEBUG] Privilege %s is not granted on %s, it will be granted again", privilege, object.Object.FullyQualifiedName())
+				missingPrivileges[privilege] = true
+			}
+		}
+	}
+
+	privileges := make([]string, 0)
+	for _, privilege := range expectedPrivileges {
+		if !missingPrivileges[privilege] {
+			privileges = append(privileges, privilege)
+		}
+	}
+	return privileges
+}
+
+// readGrantPrivilegesOnAllSchemaObjects sets the privileges granted to the grantee on every existing object matched by the on_schema_object.on_all grant.
+func readGrantPrivilegesOnAllSchemaObjects(ctx context.Context, d *schema.ResourceData, client *sdk.Client, onAll *BulkOperationGrantData, to *sdk.ShowGrantsTo, expectedPrivileges []string, withGrantOption bool) diag.Diagnostics {
+	objects, err := client.Grants.ShowOnAll(ctx, &sdk.GrantOnSchemaObjectIn{
+		PluralObjectType: onAll.ObjectNamePlural,
+		InDatabase:       onAll.Database,
+		InSchema:         onAll.Schema,
+	}, to)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve grants. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to retrieve grants",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+			},
+		}
+	}
+
+	grantedOn := onAll.ObjectNamePlural.Singular()
+	privileges := privilegesGrantedOnAllObjects(objects, expectedPrivileges, func(grant sdk.Grant) bool {
+		// only the current grants made by a role are considered, as in the read of the single object
+		return grant.GrantedOn == grantedOn && grant.GrantedBy.Name() != "" && grant.GrantOption == withGrantOption
+	})
+	if err := d.Set("privileges", privileges); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Error setting privileges",
+				Detail:   fmt.Sprintf("Id: %s\nPrivileges: %v\nError: %s", d.Id(), privileges, err),
+			},
+		}
+	}
+	return nil
+}
+
+// schemasWithFutureGrantsOn returns the schemas of the database in which the schema-level future grants on the given object type are defined.
+// Snowflake ignores the database-level future grants on the object type in these schemas, because the schema-level ones take precedence.
+// The grants matched by ignored (if set) are not taken into account, e.g. the ones made in the schemas by the resource itself.
+func schemasWithFutureGrantsOn(ctx context.Context, client *sdk.Client, database sdk.AccountObjectIdentifier, objectType sdk.ObjectType, ignored func(sdk.Grant) bool) ([]sdk.DatabaseObjectIdentifier, error) {
+	schemaGrants, err := client.Grants.ShowFutureInSchemas(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+
+	schemaIds := make([]sdk.DatabaseObjectIdentifier, 0)
+	for _, schemaGrant := range schemaGrants {
+		if slices.ContainsFunc(schemaGrant.Grants, func(grant sdk.Grant) bool { return grant.GrantOn == objectType && (ignored == nil || !ignored(grant)) }) {
+			schemaIds = append(schemaIds, schemaGrant.Schema)
+		}
+	}
+	return schemaIds, nil
+}
+
+// setShadowedInSchemas sets shadowed_in_schemas to the schemas of the database in which the on_schema_object.future grant in the database is ignored,
+// because they have their own schema-level future grants on the same object type, and warns about them. It is called from the read,
+// so that the warning is shown during the refresh. The failures of the check are only logged, as they should not prevent reading the grant.
+func setShadowedInSchemas(ctx context.Context, client *sdk.Client, d *schema.ResourceData, data any) diag.Diagnostics {
+	schemas := make([]string, 0)
+	onSchemaObject, ok := data.(*OnSchemaObjectGrantData)
+	if ok && onSchemaObject.Kind == OnFutureSchemaObjectGrantKind && onSchemaObject.OnAllOrFuture.Kind == InDatabaseBulkOperationGrantKind {
+		database, objectTypePlural := *onSchemaObject.OnAllOrFuture.Database, onSchemaObject.OnAllOrFuture.ObjectNamePlural
+		shadowing, err := schemasWithFutureGrantsOn(ctx, client, database, objectTypePlural.Singular(), nil)
+		if err != nil {
+			log.Printf("[DEBUG] Failed to check the schema-level future grants on %s in database %s, err: %s", objectTypePlural, database.FullyQualifiedName(), err)
+		} else {
+			schemas = collections.Map(shadowing, sdk.DatabaseObjectIdentifier.FullyQualifiedName)
+		}
+	}
+	if err := d.Set("shadowed_in_schemas", schemas); err != nil {
+		return diag.FromErr(err)
+	}
+	if len(schemas) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Database-level future grants are shadowed by schema-level future grants",
+			Detail: fmt.Sprintf("The future grants on %s in database %s are ignored in the following schemas, because they have their own future grants on %s that take precedence: %s. "+
+				"Grant the privileges in these schemas too, or use the snowflake_grant_future_privileges_in_database resource that manages both levels.",
+				onSchemaObject.OnAllOrFuture.ObjectNamePlural, onSchemaObject.OnAllOrFuture.Database.FullyQualifiedName(), onSchemaObject.OnAllOrFuture.ObjectNamePlural, strings.Join(schemas, ", ")),
+		},
+	}
+}
+
+// shadowedFutureGrantsCustomDiff computes shadowed_in_schemas during the plan, so that the schemas in which the on_schema_object.future grant
+// in the database is ignored are shown in the plan, also for the new grants that were not read yet. The value of the other grants is always empty.
+func shadowedFutureGrantsCustomDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	// the values are not known yet, e.g. when the database is created in the same apply
+	if !d.NewValueKnown("on_schema_object.0.future.0.in_database") || !d.NewValueKnown("on_schema_object.0.future.0.object_type_plural") {
+		return nil
+	}
+	schemas := make([]string, 0)
+	database := d.Get("on_schema_object.0.future.0.in_database").(string)
+	objectTypePlural := d.Get("on_schema_object.0.future.0.object_type_plural").(string)
+	if database != "" && objectTypePlural != "" {
+		databaseId, err := sdk.ParseAccountObjectIdentifier(database)
+		if err != nil {
+			return nil
+		}
+		shadowing, err := schemasWithFutureGrantsOn(ctx, meta.(*provider.Context).Client, databaseId, sdk.PluralObjectType(strings.ToUpper(objectTypePlural)).Singular(), nil)
+		if err != nil {
+			log.Printf("[DEBUG] Failed to check the schema-level future grants on %s in database %s, err: %s", objectTypePlural, databaseId.FullyQualifiedName(), err)
+			return nil
+		}
+		schemas = collections.Map(shadowing, sdk.DatabaseObjectIdentifier.FullyQualifiedName)
+	}
+	if d.Id() == "" || !slices.Equal(expandStringList(d.Get("shadowed_in_schemas").([]any)), schemas) {
+		return d.SetNew("shadowed_in_schemas", schemas)
+	}
+	return nil
+}
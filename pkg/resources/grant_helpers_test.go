@@ -0,0 +1,61 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrivilegesGrantedOnAllObjects(t *testing.T) {
+	grant := func(privilege string) sdk.Grant {
+		return sdk.Grant{Privilege: privilege}
+	}
+	objectGrants := func(name string, grants ...sdk.Grant) sdk.ObjectGrants {
+		return sdk.ObjectGrants{Object: sdk.NewSchemaObjectIdentifier("database", "schema", name), Grants: grants}
+	}
+	isGranted := func(grant sdk.Grant) bool { return true }
+
+	testCases := []struct {
+		Name     string
+		Objects  []sdk.ObjectGrants
+		Expected []string
+	}{
+		{
+			Name:     "no objects",
+			Objects:  nil,
+			Expected: []string{"SELECT", "INSERT"},
+		},
+		{
+			Name: "privileges granted on all the objects",
+			Objects: []sdk.ObjectGrants{
+				objectGrants("first", grant("SELECT"), grant("INSERT")),
+				objectGrants("second", grant("INSERT"), grant("SELECT"), grant("UPDATE")),
+			},
+			Expected: []string{"SELECT", "INSERT"},
+		},
+		{
+			Name: "privilege missing on one of the objects",
+			Objects: []sdk.ObjectGrants{
+				objectGrants("first", grant("SELECT"), grant("INSERT")),
+				objectGrants("second", grant("SELECT")),
+			},
+			Expected: []string{"SELECT"},
+		},
+		{
+			Name: "object without privileges",
+			Objects: []sdk.ObjectGrants{
+				objectGrants("first", grant("SELECT"), grant("INSERT")),
+				objectGrants("second"),
+			},
+			Expected: []string{},
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, privilegesGrantedOnAllObjects(tt.Objects, []string{"SELECT", "INSERT"}, isGranted))
+		})
+	}
+}
@@ -0,0 +1,684 @@
+package resources
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Grant resources use identities built from the same grant id structs that are used for their import ids
+// (e.g. GrantPrivilegesToAccountRoleId). The attribute names follow the flattened resource schema, e.g. on_schema.schema_name
+// becomes on_schema_name in the identity.
+
+var grantPrivilegesCommonIdentitySchema = map[string]*schema.Schema{
+	"privileges":        identityStringListSchema("The privileges granted. Conflicts with all_privileges.", false),
+	"all_privileges":    identityBoolSchema("Grant all privileges on the object."),
+	"with_grant_option": identityBoolSchema("Specifies whether the grantee can grant the privileges to other roles."),
+}
+
+var onSchemaGrantIdentitySchema = map[string]*schema.Schema{
+	"on_schema_name":                identityStringSchema("Fully qualified name of the schema on which privileges are granted.", false),
+	"on_all_schemas_in_database":    identityStringSchema("Fully qualified name of the database in which privileges are granted on all schemas.", false),
+	"on_future_schemas_in_database": identityStringSchema("Fully qualified name of the database in which privileges are granted on future schemas.", false),
+}
+
+var onSchemaObjectGrantIdentitySchema = map[string]*schema.Schema{
+	"on_schema_object_type":        identityStringSchema("Type of the schema object on which privileges are granted.", false),
+	"on_schema_object_name":        identityStringSchema("Fully qualified name of the schema object on which privileges are granted.", false),
+	"on_all_object_type_plural":    identityStringSchema("Plural object type of all the schema objects on which privileges are granted.", false),
+	"on_future_object_type_plural": identityStringSchema("Plural object type of the future schema objects on which privileges are granted.", false),
+	"in_database":                  identityStringSchema("Fully qualified name of the database for the all/future grants.", false),
+	"in_schema":                    identityStringSchema("Fully qualified name of the schema for the all/future grants.", false),
+}
+
+func mergeIdentitySchemas(schemas ...map[string]*schema.Schema) func() map[string]*schema.Schema {
+	return func() map[string]*schema.Schema {
+		result := make(map[string]*schema.Schema)
+		for _, s := range schemas {
+			for k, v := range s {
+				result[k] = v
+			}
+		}
+		return result
+	}
+}
+
+func optionalIdentityString(identity *schema.IdentityData, key string) (string, bool) {
+	v, ok := identity.GetOk(key)
+	if !ok {
+		return "", false
+	}
+	return v.(string), true
+}
+
+func optionalIdentityBool(identity *schema.IdentityData, key string) bool {
+	v, ok := identity.GetOk(key)
+	return ok && v.(bool)
+}
+
+func identityStringList(identity *schema.IdentityData, key string) []string {
+	raw, ok := identity.GetOk(key)
+	if !ok {
+		return nil
+	}
+	result := make([]string, 0)
+	for _, v := range raw.([]any) {
+		result = append(result, v.(string))
+	}
+	return result
+}
+
+// grantPrivilegesCommonToIdentity skips always_apply, because it doesn't identify the grant.
+func grantPrivilegesCommonToIdentity(allPrivileges bool, privileges []string, withGrantOption bool) map[string]any {
+	return map[string]any{
+		"privileges":        privileges,
+		"all_privileges":    allPrivileges,
+		"with_grant_option": withGrantOption,
+	}
+}
+
+func onSchemaGrantDataToIdentity(data *OnSchemaGrantData, attributes map[string]any) {
+	switch data.Kind {
+	case OnSchemaSchemaGrantKind:
+		attributes["on_schema_name"] = data.SchemaName.FullyQualifiedName()
+	case OnAllSchemasInDatabaseSchemaGrantKind:
+		attributes["on_all_schemas_in_database"] = data.DatabaseName.FullyQualifiedName()
+	case OnFutureSchemasInDatabaseSchemaGrantKind:
+		attributes["on_future_schemas_in_database"] = data.DatabaseName.FullyQualifiedName()
+	}
+}
+
+// onSchemaGrantDataFromIdentity returns nil data when none of the on schema attributes were set.
+func onSchemaGrantDataFromIdentity(identity *schema.IdentityData) (*OnSchemaGrantData, error) {
+	if schemaName, ok := optionalIdentityString(identity, "on_schema_name"); ok {
+		schemaId, err := sdk.ParseDatabaseObjectIdentifier(schemaName)
+		if err != nil {
+			return nil, err
+		}
+		return &OnSchemaGrantData{Kind: OnSchemaSchemaGrantKind, SchemaName: sdk.Pointer(schemaId)}, nil
+	}
+	for attribute, kind := range map[string]OnSchemaGrantKind{
+		"on_all_schemas_in_database":    OnAllSchemasInDatabaseSchemaGrantKind,
+		"on_future_schemas_in_database": OnFutureSchemasInDatabaseSchemaGrantKind,
+	} {
+		if databaseName, ok := optionalIdentityString(identity, attribute); ok {
+			databaseId, err := sdk.ParseAccountObjectIdentifier(databaseName)
+			if err != nil {
+				return nil, err
+			}
+			return &OnSchemaGrantData{Kind: kind, DatabaseName: sdk.Pointer(databaseId)}, nil
+		}
+	}
+	return nil, nil
+}
+
+func onSchemaObjectGrantDataToIdentity(data *OnSchemaObjectGrantData, attributes map[string]any) {
+	switch data.Kind {
+	case OnObjectSchemaObjectGrantKind:
+		attributes["on_schema_object_type"] = data.Object.ObjectType.String()
+		attributes["on_schema_object_name"] = data.Object.Name.FullyQualifiedName()
+	case OnAllSchemaObjectGrantKind, OnFutureSchemaObjectGrantKind:
+		bulkOperationGrantDataToIdentity(data.Kind, data.OnAllOrFuture, attributes)
+	}
+}
+
+func bulkOperationGrantDataToIdentity(kind OnSchemaObjectGrantKind, data *BulkOperationGrantData, attributes map[string]any) {
+	if kind == OnAllSchemaObjectGrantKind {
+		attributes["on_all_object_type_plural"] = data.ObjectNamePlural.String()
+	} else {
+		attributes["on_future_object_type_plural"] = data.ObjectNamePlural.String()
+	}
+	switch data.Kind {
+	case InDatabaseBulkOperationGrantKind:
+		attributes["in_database"] = data.Database.FullyQualifiedName()
+	case InSchemaBulkOperationGrantKind:
+		attributes["in_schema"] = data.Schema.FullyQualifiedName()
+	}
+}
+
+func bulkOperationGrantDataFromIdentity(identity *schema.IdentityData, pluralObjectType string) (*BulkOperationGrantData, error) {
+	data := &BulkOperationGrantData{
+		ObjectNamePlural: sdk.PluralObjectType(strings.ToUpper(pluralObjectType)),
+	}
+	if inDatabase, ok := optionalIdentityString(identity, "in_database"); ok {
+		databaseId, err := sdk.ParseAccountObjectIdentifier(inDatabase)
+		if err != nil {
+			return nil, err
+		}
+		data.Kind = InDatabaseBulkOperationGrantKind
+		data.Database = sdk.Pointer(databaseId)
+	}
+	if inSchema, ok := optionalIdentityString(identity, "in_schema"); ok {
+		schemaId, err := sdk.ParseDatabaseObjectIdentifier(inSchema)
+		if err != nil {
+			return nil, err
+		}
+		data.Kind = InSchemaBulkOperationGrantKind
+		data.Schema = sdk.Pointer(schemaId)
+	}
+	return data, nil
+}
+
+func schemaObjectIdentifierForObjectType(objectType sdk.ObjectType, name string) (sdk.ObjectIdentifier, error) {
+	// TODO(SNOW-1569535): use a mapper from object type to parsing function
+	if objectType.IsWithArguments() {
+		return sdk.ParseSchemaObjectIdentifierWithArguments(name)
+	}
+	return sdk.ParseSchemaObjectIdentifier(name)
+}
+
+// onSchemaObjectGrantDataFromIdentity returns nil data when none of the on schema object attributes were set.
+func onSchemaObjectGrantDataFromIdentity(identity *schema.IdentityData) (*OnSchemaObjectGrantData, error) {
+	if objectTypeRaw, ok := optionalIdentityString(identity, "on_schema_object_type"); ok {
+		objectName, err := requiredIdentityString(identity, "on_schema_object_name")
+		if err != nil {
+			return nil, err
+		}
+		objectType, err := sdk.ToObjectType(objectTypeRaw)
+		if err != nil {
+			return nil, err
+		}
+		objectId, err := schemaObjectIdentifierForObjectType(objectType, objectName)
+		if err != nil {
+			return nil, err
+		}
+		return &OnSchemaObjectGrantData{
+			Kind:   OnObjectSchemaObjectGrantKind,
+			Object: &sdk.Object{ObjectType: objectType, Name: objectId},
+		}, nil
+	}
+	for attribute, kind := range map[string]OnSchemaObjectGrantKind{
+		"on_all_object_type_plural":    OnAllSchemaObjectGrantKind,
+		"on_future_object_type_plural": OnFutureSchemaObjectGrantKind,
+	} {
+		if pluralObjectType, ok := optionalIdentityString(identity, attribute); ok {
+			bulkOperationGrantData, err := bulkOperationGrantDataFromIdentity(identity, pluralObjectType)
+			if err != nil {
+				return nil, err
+			}
+			return &OnSchemaObjectGrantData{Kind: kind, OnAllOrFuture: bulkOperationGrantData}, nil
+		}
+	}
+	return nil, nil
+}
+
+var grantPrivilegesToAccountRoleIdentity = resourceIdentity{
+	schemaFunc: mergeIdentitySchemas(
+		map[string]*schema.Schema{
+			"account_role_name":      identityStringSchema("Name of the account role to which privileges are granted.", true),
+			"on_account":             identityBoolSchema("Privileges are granted on the account."),
+			"on_account_object_type": identityStringSchema("Type of the account object on which privileges are granted.", false),
+			"on_account_object_name": identityStringSchema("Name of the account object on which privileges are granted.", false),
+		},
+		grantPrivilegesCommonIdentitySchema,
+		onSchemaGrantIdentitySchema,
+		onSchemaObjectGrantIdentitySchema,
+	),
+	fromId: func(rawId string, identity *schema.IdentityData) error {
+		id, err := ParseGrantPrivilegesToAccountRoleId(rawId)
+		if err != nil {
+			return err
+		}
+		attributes := grantPrivilegesCommonToIdentity(id.AllPrivileges, id.Privileges, id.WithGrantOption)
+		attributes["account_role_name"] = id.RoleName.Name()
+		switch data := id.Data.(type) {
+		case *OnAccountGrantData:
+			attributes["on_account"] = true
+		case *OnAccountObjectGrantData:
+			attributes["on_account_object_type"] = data.ObjectType.String()
+			attributes["on_account_object_name"] = data.ObjectName.Name()
+		case *OnSchemaGrantData:
+			onSchemaGrantDataToIdentity(data, attributes)
+		case *OnSchemaObjectGrantData:
+			onSchemaObjectGrantDataToIdentity(data, attributes)
+		}
+		return setIdentityAttributes(identity, attributes)
+	},
+	toId: func(identity *schema.IdentityData) (string, error) {
+		roleName, err := requiredIdentityString(identity, "account_role_name")
+		if err != nil {
+			return "", err
+		}
+		id := GrantPrivilegesToAccountRoleId{
+			RoleName:        sdk.NewAccountObjectIdentifier(roleName),
+			WithGrantOption: optionalIdentityBool(identity, "with_grant_option"),
+			AllPrivileges:   optionalIdentityBool(identity, "all_privileges"),
+			Privileges:      identityStringList(identity, "privileges"),
+		}
+		if !id.AllPrivileges && len(id.Privileges) == 0 {
+			return "", errors.New("either privileges or all_privileges has to be set in the identity")
+		}
+
+		onSchemaGrantData, onSchemaErr := onSchemaGrantDataFromIdentity(identity)
+		onSchemaObjectGrantData, onSchemaObjectErr := onSchemaObjectGrantDataFromIdentity(identity)
+		if err := errors.Join(onSchemaErr, onSchemaObjectErr); err != nil {
+			return "", err
+		}
+		objectType, hasAccountObject := optionalIdentityString(identity, "on_account_object_type")
+		switch {
+		case optionalIdentityBool(identity, "on_account"):
+			id.Kind = OnAccountAccountRoleGrantKind
+			id.Data = new(OnAccountGrantData)
+		case hasAccountObject:
+			objectName, err := requiredIdentityString(identity, "on_account_object_name")
+			if err != nil {
+				return "", err
+			}
+			id.Kind = OnAccountObjectAccountRoleGrantKind
+			id.Data = &OnAccountObjectGrantData{
+				ObjectType: sdk.ObjectType(strings.ToUpper(objectType)),
+				ObjectName: sdk.NewAccountObjectIdentifier(objectName),
+			}
+		case onSchemaGrantData != nil:
+			id.Kind = OnSchemaAccountRoleGrantKind
+			id.Data = onSchemaGrantData
+		case onSchemaObjectGrantData != nil:
+			id.Kind = OnSchemaObjectAccountRoleGrantKind
+			id.Data = onSchemaObjectGrantData
+		default:
+			return "", errors.New("the identity does not specify on what the privileges are granted")
+		}
+		return id.String(), nil
+	},
+}
+
+var grantPrivilegesToDatabaseRoleIdentity = resourceIdentity{
+	schemaFunc: mergeIdentitySchemas(
+		map[string]*schema.Schema{
+			"database_role_name": identityStringSchema("Fully qualified name of the database role to which privileges are granted.", true),
+			"on_database":        identityStringSchema("Name of the database on which privileges are granted.", false),
+		},
+		grantPrivilegesCommonIdentitySchema,
+		onSchemaGrantIdentitySchema,
+		onSchemaObjectGrantIdentitySchema,
+	),
+	fromId: func(rawId string, identity *schema.IdentityData) error {
+		id, err := ParseGrantPrivilegesToDatabaseRoleId(rawId)
+		if err != nil {
+			return err
+		}
+		attributes := grantPrivilegesCommonToIdentity(id.AllPrivileges, id.Privileges, id.WithGrantOption)
+		attributes["database_role_name"] = id.DatabaseRoleName.FullyQualifiedName()
+		switch data := id.Data.(type) {
+		case *OnDatabaseGrantData:
+			attributes["on_database"] = data.DatabaseName.Name()
+		case *OnSchemaGrantData:
+			onSchemaGrantDataToIdentity(data, attributes)
+		case *OnSchemaObjectGrantData:
+			onSchemaObjectGrantDataToIdentity(data, attributes)
+		}
+		return setIdentityAttributes(identity, attributes)
+	},
+	toId: func(identity *schema.IdentityData) (string, error) {
+		roleName, err := requiredIdentityString(identity, "database_role_name")
+		if err != nil {
+			return "", err
+		}
+		roleId, err := sdk.ParseDatabaseObjectIdentifier(roleName)
+		if err != nil {
+			return "", err
+		}
+		id := GrantPrivilegesToDatabaseRoleId{
+			DatabaseRoleName: roleId,
+			WithGrantOption:  optionalIdentityBool(identity, "with_grant_option"),
+			AllPrivileges:    optionalIdentityBool(identity, "all_privileges"),
+			Privileges:       identityStringList(identity, "privileges"),
+		}
+		if !id.AllPrivileges && len(id.Privileges) == 0 {
+			return "", errors.New("either privileges or all_privileges has to be set in the identity")
+		}
+
+		onSchemaGrantData, onSchemaErr := onSchemaGrantDataFromIdentity(identity)
+		onSchemaObjectGrantData, onSchemaObjectErr := onSchemaObjectGrantDataFromIdentity(identity)
+		if err := errors.Join(onSchemaErr, onSchemaObjectErr); err != nil {
+			return "", err
+		}
+		databaseName, hasDatabase := optionalIdentityString(identity, "on_database")
+		switch {
+		case hasDatabase:
+			id.Kind = OnDatabaseDatabaseRoleGrantKind
+			id.Data = &OnDatabaseGrantData{DatabaseName: sdk.NewAccountObjectIdentifier(databaseName)}
+		case onSchemaGrantData != nil:
+			id.Kind = OnSchemaDatabaseRoleGrantKind
+			id.Data = onSchemaGrantData
+		case onSchemaObjectGrantData != nil:
+			id.Kind = OnSchemaObjectDatabaseRoleGrantKind
+			id.Data = onSchemaObjectGrantData
+		default:
+			return "", errors.New("the identity does not specify on what the privileges are granted")
+		}
+		return id.String(), nil
+	},
+}
+
+var grantPrivilegesToShareIdentityAttributes = map[ShareGrantKind]string{
+	OnDatabaseShareGrantKind:          "on_database",
+	OnSchemaShareGrantKind:            "on_schema",
+	OnFunctionShareGrantKind:          "on_function",
+	OnTableShareGrantKind:             "on_table",
+	OnAllTablesInSchemaShareGrantKind: "on_all_tables_in_schema",
+	OnTagShareGrantKind:               "on_tag",
+	OnViewShareGrantKind:              "on_view",
+}
+
+var grantPrivilegesToShareIdentity = resourceIdentity{
+	schemaFunc: func() map[string]*schema.Schema {
+		identitySchema := map[string]*schema.Schema{
+			"to_share":   identityStringSchema("Name of the share to which privileges are granted.", true),
+			"privileges": identityStringListSchema("The privileges granted.", true),
+		}
+		for kind, attribute := range grantPrivilegesToShareIdentityAttributes {
+			identitySchema[attribute] = identityStringSchema(fmt.Sprintf("Fully qualified name of the object on which privileges are granted (%s).", kind), false)
+		}
+		return identitySchema
+	},
+	fromId: func(rawId string, identity *schema.IdentityData) error {
+		id, err := ParseGrantPrivilegesToShareId(rawId)
+		if err != nil {
+			return err
+		}
+		return setIdentityAttributes(identity, map[string]any{
+			"to_share":   id.ShareName.Name(),
+			"privileges": id.Privileges,
+			grantPrivilegesToShareIdentityAttributes[id.Kind]: id.Identifier.FullyQualifiedName(),
+		})
+	},
+	toId: func(identity *schema.IdentityData) (string, error) {
+		shareName, err := requiredIdentityString(identity, "to_share")
+		if err != nil {
+			return "", err
+		}
+		privileges := identityStringList(identity, "privileges")
+		if len(privileges) == 0 {
+			return "", errors.New("privileges have to be set in the identity")
+		}
+		for kind, attribute := range grantPrivilegesToShareIdentityAttributes {
+			if identifier, ok := optionalIdentityString(identity, attribute); ok {
+				// the id is parsed to validate the identifier against the grant kind
+				id, err := ParseGrantPrivilegesToShareId(helpers.EncodeResourceIdentifier(
+					sdk.NewAccountObjectIdentifier(shareName).FullyQualifiedName(),
+					strings.Join(privileges, ","),
+					string(kind),
+					identifier,
+				))
+				if err != nil {
+					return "", err
+				}
+				return id.String(), nil
+			}
+		}
+		return "", errors.New("the identity does not specify on what the privileges are granted")
+	},
+}
+
+var grantOwnershipIdentity = resourceIdentity{
+	schemaFunc: func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"account_role_name":            identityStringSchema("Name of the account role to which ownership is granted.", false),
+			"database_role_name":           identityStringSchema("Fully qualified name of the database role to which ownership is granted.", false),
+			"outbound_privileges":          identityStringSchema("Specifies whether to remove or transfer all existing outbound privileges on the object.", false),
+			"on_object_type":               identityStringSchema("Type of the object on which ownership is granted.", false),
+			"on_object_name":               identityStringSchema("Fully qualified name of the object on which ownership is granted.", false),
+			"on_all_object_type_plural":    identityStringSchema("Plural object type of all the objects on which ownership is granted.", false),
+			"on_future_object_type_plural": identityStringSchema("Plural object type of the future objects on which ownership is granted.", false),
+			"in_database":                  identityStringSchema("Fully qualified name of the database for the all/future grants.", false),
+			"in_schema":                    identityStringSchema("Fully qualified name of the schema for the all/future grants.", false),
+		}
+	},
+	fromId: func(rawId string, identity *schema.IdentityData) error {
+		id, err := ParseGrantOwnershipId(rawId)
+		if err != nil {
+			return err
+		}
+		attributes := make(map[string]any)
+		switch id.GrantOwnershipTargetRoleKind {
+		case ToAccountGrantOwnershipTargetRoleKind:
+			attributes["account_role_name"] = id.AccountRoleName.Name()
+		case ToDatabaseGrantOwnershipTargetRoleKind:
+			attributes["database_role_name"] = id.DatabaseRoleName.FullyQualifiedName()
+		}
+		if id.OutboundPrivilegesBehavior != nil {
+			attributes["outbound_privileges"] = string(*id.OutboundPrivilegesBehavior)
+		}
+		switch data := id.Data.(type) {
+		case *OnObjectGrantOwnershipData:
+			attributes["on_object_type"] = data.ObjectType.String()
+			attributes["on_object_name"] = data.ObjectName.FullyQualifiedName()
+		case *BulkOperationGrantData:
+			if id.Kind == OnAllGrantOwnershipKind {
+				bulkOperationGrantDataToIdentity(OnAllSchemaObjectGrantKind, data, attributes)
+			} else {
+				bulkOperationGrantDataToIdentity(OnFutureSchemaObjectGrantKind, data, attributes)
+			}
+		}
+		return setIdentityAttributes(identity, attributes)
+	},
+	toId: func(identity *schema.IdentityData) (string, error) {
+		id := new(GrantOwnershipId)
+		accountRoleName, hasAccountRole := optionalIdentityString(identity, "account_role_name")
+		databaseRoleName, hasDatabaseRole := optionalIdentityString(identity, "database_role_name")
+		switch {
+		case hasAccountRole && !hasDatabaseRole:
+			id.GrantOwnershipTargetRoleKind = ToAccountGrantOwnershipTargetRoleKind
+			id.AccountRoleName = sdk.NewAccountObjectIdentifier(accountRoleName)
+		case hasDatabaseRole && !hasAccountRole:
+			databaseRoleId, err := sdk.ParseDatabaseObjectIdentifier(databaseRoleName)
+			if err != nil {
+				return "", err
+			}
+			id.GrantOwnershipTargetRoleKind = ToDatabaseGrantOwnershipTargetRoleKind
+			id.DatabaseRoleName = databaseRoleId
+		default:
+			return "", errors.New("exactly one of account_role_name or database_role_name has to be set in the identity")
+		}
+		if outboundPrivileges, ok := optionalIdentityString(identity, "outbound_privileges"); ok {
+			id.OutboundPrivilegesBehavior = sdk.Pointer(OutboundPrivilegesBehavior(strings.ToUpper(outboundPrivileges)))
+		}
+
+		onSchemaObjectGrantData, err := onSchemaObjectGrantDataFromIdentity(identity)
+		if err != nil {
+			return "", err
+		}
+		if objectTypeRaw, ok := optionalIdentityString(identity, "on_object_type"); ok {
+			objectName, err := requiredIdentityString(identity, "on_object_name")
+			if err != nil {
+				return "", err
+			}
+			objectType := sdk.ObjectType(strings.ToUpper(objectTypeRaw))
+			objectId, err := GetOnObjectIdentifier(objectType, objectName)
+			if err != nil {
+				return "", err
+			}
+			id.Kind = OnObjectGrantOwnershipKind
+			id.Data = &OnObjectGrantOwnershipData{
+				ObjectType: objectType,
+				ObjectName: objectId,
+			}
+		} else if onSchemaObjectGrantData != nil && onSchemaObjectGrantData.OnAllOrFuture != nil {
+			if onSchemaObjectGrantData.Kind == OnAllSchemaObjectGrantKind {
+				id.Kind = OnAllGrantOwnershipKind
+			} else {
+				id.Kind = OnFutureGrantOwnershipKind
+			}
+			id.Data = onSchemaObjectGrantData.OnAllOrFuture
+		} else {
+			return "", errors.New("the identity does not specify on what the ownership is granted")
+		}
+		return id.String(), nil
+	},
+}
+
+var grantAccountRoleIdentity = resourceIdentity{
+	schemaFunc: func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"role_name":        identityStringSchema("Name of the granted role.", true),
+			"user_name":        identityStringSchema("Name of the user to which the role is granted.", false),
+			"parent_role_name": identityStringSchema("Name of the parent role to which the role is granted.", false),
+		}
+	},
+	fromId: func(rawId string, identity *schema.IdentityData) error {
+		parts := helpers.ParseResourceIdentifier(rawId)
+		if len(parts) != 3 {
+			return fmt.Errorf("invalid ID specified: %v, expected <role_name>|<grantee_object_type>|<grantee_identifier>", rawId)
+		}
+		roleId, roleErr := sdk.ParseAccountObjectIdentifier(parts[0])
+		granteeId, granteeErr := sdk.ParseAccountObjectIdentifier(parts[2])
+		if err := errors.Join(roleErr, granteeErr); err != nil {
+			return err
+		}
+		attributes := map[string]any{
+			"role_name": roleId.Name(),
+		}
+		switch sdk.ObjectType(parts[1]) {
+		case sdk.ObjectTypeRole:
+			attributes["parent_role_name"] = granteeId.Name()
+		case sdk.ObjectTypeUser:
+			attributes["user_name"] = granteeId.Name()
+		default:
+			return fmt.Errorf("invalid object type specified: %v, expected ROLE or USER", parts[1])
+		}
+		return setIdentityAttributes(identity, attributes)
+	},
+	toId: func(identity *schema.IdentityData) (string, error) {
+		roleName, err := requiredIdentityString(identity, "role_name")
+		if err != nil {
+			return "", err
+		}
+		roleId := sdk.NewAccountObjectIdentifier(roleName)
+		parentRoleName, hasParentRole := optionalIdentityString(identity, "parent_role_name")
+		userName, hasUser := optionalIdentityString(identity, "user_name")
+		switch {
+		case hasParentRole && !hasUser:
+			return helpers.EncodeSnowflakeID(roleId.FullyQualifiedName(), sdk.ObjectTypeRole.String(), sdk.NewAccountObjectIdentifier(parentRoleName).FullyQualifiedName()), nil
+		case hasUser && !hasParentRole:
+			return helpers.EncodeSnowflakeID(roleId.FullyQualifiedName(), sdk.ObjectTypeUser.String(), sdk.NewAccountObjectIdentifier(userName).FullyQualifiedName()), nil
+		default:
+			return "", errors.New("exactly one of parent_role_name or user_name has to be set in the identity")
+		}
+	},
+}
+
+var grantDatabaseRoleIdentity = resourceIdentity{
+	schemaFunc: func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"database_role_name":        identityStringSchema("Fully qualified name of the granted database role.", true),
+			"parent_role_name":          identityStringSchema("Name of the parent account role to which the database role is granted.", false),
+			"parent_database_role_name": identityStringSchema("Fully qualified name of the parent database role to which the database role is granted.", false),
+			"share_name":                identityStringSchema("Name of the share to which the database role is granted.", false),
+		}
+	},
+	fromId: func(rawId string, identity *schema.IdentityData) error {
+		parts := helpers.ParseResourceIdentifier(rawId)
+		if len(parts) != 3 {
+			return fmt.Errorf("invalid ID specified: %v, expected <database_role_name>|<object_type>|<target_identifier>", rawId)
+		}
+		databaseRoleId, err := sdk.ParseDatabaseObjectIdentifier(parts[0])
+		if err != nil {
+			return err
+		}
+		attributes := map[string]any{
+			"database_role_name": databaseRoleId.FullyQualifiedName(),
+		}
+		switch sdk.ObjectType(parts[1]) {
+		case sdk.ObjectTypeRole, sdk.ObjectTypeShare:
+			granteeId, err := sdk.ParseAccountObjectIdentifier(parts[2])
+			if err != nil {
+				return err
+			}
+			if sdk.ObjectType(parts[1]) == sdk.ObjectTypeRole {
+				attributes["parent_role_name"] = granteeId.Name()
+			} else {
+				attributes["share_name"] = granteeId.Name()
+			}
+		case sdk.ObjectTypeDatabaseRole:
+			granteeId, err := sdk.ParseDatabaseObjectIdentifier(parts[2])
+			if err != nil {
+				return err
+			}
+			attributes["parent_database_role_name"] = granteeId.FullyQualifiedName()
+		default:
+			return fmt.Errorf("invalid object type specified: %v, expected ROLE, DATABASE ROLE, or SHARE", parts[1])
+		}
+		return setIdentityAttributes(identity, attributes)
+	},
+	toId: func(identity *schema.IdentityData) (string, error) {
+		databaseRoleName, err := requiredIdentityString(identity, "database_role_name")
+		if err != nil {
+			return "", err
+		}
+		databaseRoleId, err := sdk.ParseDatabaseObjectIdentifier(databaseRoleName)
+		if err != nil {
+			return "", err
+		}
+		if parentRoleName, ok := optionalIdentityString(identity, "parent_role_name"); ok {
+			return helpers.EncodeResourceIdentifier(databaseRoleId.FullyQualifiedName(), sdk.ObjectTypeRole.String(), sdk.NewAccountObjectIdentifier(parentRoleName).FullyQualifiedName()), nil
+		}
+		if parentDatabaseRoleName, ok := optionalIdentityString(identity, "parent_database_role_name"); ok {
+			parentDatabaseRoleId, err := sdk.ParseDatabaseObjectIdentifier(parentDatabaseRoleName)
+			if err != nil {
+				return "", err
+			}
+			return helpers.EncodeResourceIdentifier(databaseRoleId.FullyQualifiedName(), sdk.ObjectTypeDatabaseRole.String(), parentDatabaseRoleId.FullyQualifiedName()), nil
+		}
+		if shareName, ok := optionalIdentityString(identity, "share_name"); ok {
+			return helpers.EncodeResourceIdentifier(databaseRoleId.FullyQualifiedName(), sdk.ObjectTypeShare.String(), sdk.NewAccountObjectIdentifier(shareName).FullyQualifiedName()), nil
+		}
+		return "", errors.New("one of parent_role_name, parent_database_role_name, or share_name has to be set in the identity")
+	},
+}
+
+var grantApplicationRoleIdentity = resourceIdentity{
+	schemaFunc: func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"application_role_name":    identityStringSchema("Fully qualified name of the granted application role.", true),
+			"parent_account_role_name": identityStringSchema("Name of the account role to which the application role is granted.", false),
+			"application_name":         identityStringSchema("Name of the application to which the application role is granted.", false),
+		}
+	},
+	fromId: func(rawId string, identity *schema.IdentityData) error {
+		parts := helpers.ParseResourceIdentifier(rawId)
+		if len(parts) != 3 {
+			return fmt.Errorf("invalid ID specified: %v, expected <application_role_name>|<object_type>|<target_identifier>", rawId)
+		}
+		granteeId, err := sdk.ParseAccountObjectIdentifier(parts[2])
+		if err != nil {
+			return err
+		}
+		attributes := map[string]any{
+			"application_role_name": parts[0],
+		}
+		switch parts[1] {
+		case "ACCOUNT_ROLE":
+			attributes["parent_account_role_name"] = granteeId.Name()
+		case sdk.ObjectTypeApplication.String():
+			attributes["application_name"] = granteeId.Name()
+		default:
+			return fmt.Errorf("invalid object type specified: %v, expected ACCOUNT_ROLE or APPLICATION", parts[1])
+		}
+		return setIdentityAttributes(identity, attributes)
+	},
+	toId: func(identity *schema.IdentityData) (string, error) {
+		applicationRoleName, err := requiredIdentityString(identity, "application_role_name")
+		if err != nil {
+			return "", err
+		}
+		applicationRoleId, err := sdk.ParseDatabaseObjectIdentifier(applicationRoleName)
+		if err != nil {
+			return "", err
+		}
+		parentRoleName, hasParentRole := optionalIdentityString(identity, "parent_account_role_name")
+		applicationName, hasApplication := optionalIdentityString(identity, "application_name")
+		switch {
+		case hasParentRole && !hasApplication:
+			return helpers.EncodeResourceIdentifier(applicationRoleId.FullyQualifiedName(), "ACCOUNT_ROLE", sdk.NewAccountObjectIdentifier(parentRoleName).FullyQualifiedName()), nil
+		case hasApplication && !hasParentRole:
+			return helpers.EncodeResourceIdentifier(applicationRoleId.FullyQualifiedName(), sdk.ObjectTypeApplication.String(), sdk.NewAccountObjectIdentifier(applicationName).FullyQualifiedName()), nil
+		default:
+			return "", errors.New("exactly one of parent_account_role_name or application_name has to be set in the identity")
+		}
+	},
+}
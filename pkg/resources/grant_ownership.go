@@ -154,7 +154,7 @@ func grantOwnershipBulkOperationSchema(branchName string) map[string]*schema.Sch
 }
 
 func GrantOwnership() *schema.Resource {
-	return &schema.Resource{
+	return withResourceIdentity(grantOwnershipIdentity, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.GrantOwnership, CreateGrantOwnership),
 		// There's no Update, because every field is marked as ForceNew
 		DeleteContext: TrackingDeleteWrapper(resources.GrantOwnership, DeleteGrantOwnership),
@@ -165,7 +165,7 @@ func GrantOwnership() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.GrantOwnership, ImportGrantOwnership()),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportGrantOwnership() schema.StateContextFunc {
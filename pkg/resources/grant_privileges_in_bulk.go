@@ -0,0 +1,386 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var grantPrivilegesInBulkSchema = map[string]*schema.Schema{
+	"grant": {
+		Type:        schema.TypeSet,
+		Required:    true,
+		MinItems:    1,
+		Description: "The privileges granted on the objects to the account roles. Only the declared privileges are managed; the other privileges granted to the roles are left untouched.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"account_role_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      relatedResourceDescription("The fully qualified name of the account role to which the privileges are granted.", resources.AccountRole),
+					ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+				},
+				"privileges": {
+					Type:        schema.TypeSet,
+					Required:    true,
+					MinItems:    1,
+					Description: "The privileges to grant on the object. This field is case-sensitive; use only upper-case privileges.",
+					Elem: &schema.Schema{
+						Type:             schema.TypeString,
+						ValidateDiagFunc: isNotOwnershipGrant(),
+					},
+				},
+				"object_type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      fmt.Sprintf("The type of the object on which the privileges are granted. Use ACCOUNT to grant the global privileges. Valid values are: %s.", possibleValuesListed(rolePrivilegesObjectTypes)),
+					ValidateDiagFunc: StringInSlice(rolePrivilegesObjectTypes, false),
+				},
+				"object_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The fully qualified name of the object on which the privileges are granted. It has to be set for all the object types other than ACCOUNT. For functions and procedures, include the argument types, e.g. `\"database\".\"schema\".\"function\"(NUMBER, VARCHAR)`.",
+				},
+				"with_grant_option": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Specifies whether the grantee can grant the privileges to other roles.",
+				},
+			},
+		},
+	},
+	"batch_size": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          100,
+		Description:      "The maximal number of the GRANT or REVOKE statements executed in a single multi-statement call.",
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+	},
+}
+
+func GrantPrivilegesInBulk() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.GrantPrivilegesInBulkResource), TrackingCreateWrapper(resources.GrantPrivilegesInBulk, CreateGrantPrivilegesInBulk)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.GrantPrivilegesInBulkResource), TrackingReadWrapper(resources.GrantPrivilegesInBulk, ReadGrantPrivilegesInBulk)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.GrantPrivilegesInBulkResource), TrackingUpdateWrapper(resources.GrantPrivilegesInBulk, UpdateGrantPrivilegesInBulk)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.GrantPrivilegesInBulkResource), TrackingDeleteWrapper(resources.GrantPrivilegesInBulk, DeleteGrantPrivilegesInBulk)),
+		Description:   "Resource used to manage many privileges granted to account roles at once. Contrary to snowflake_grant_privileges_to_account_role, which runs its own GRANT and SHOW GRANTS for every grant, the grants are executed in batched multi-statement calls, and the privileges are read with one SHOW GRANTS TO ROLE for every role. The future grants and the grants on all the objects in the database or schema are not supported.",
+
+		Schema:   grantPrivilegesInBulkSchema,
+		Timeouts: defaultTimeouts,
+	}
+}
+
+// bulkGrant is a single privilege on the object granted to the account role.
+type bulkGrant struct {
+	Role sdk.AccountObjectIdentifier
+	rolePrivilege
+}
+
+func (g bulkGrant) String() string {
+	return fmt.Sprintf("%s TO ROLE %s", g.rolePrivilege, g.Role.FullyQualifiedName())
+}
+
+func (g bulkGrant) key() string {
+	return fmt.Sprintf("%s|%s", g.Role.FullyQualifiedName(), g.rolePrivilege.key())
+}
+
+// expandBulkGrants returns the privileges declared in the grant blocks.
+func expandBulkGrants(grants []any) ([]bulkGrant, error) {
+	bulkGrants := make([]bulkGrant, 0)
+	for _, raw := range grants {
+		grant := raw.(map[string]any)
+		role, err := sdk.ParseAccountObjectIdentifier(grant["account_role_name"].(string))
+		if err != nil {
+			return nil, err
+		}
+		privileges, err := expandRolePrivileges([]any{grant})
+		if err != nil {
+			return nil, err
+		}
+		for _, privilege := range privileges {
+			bulkGrants = append(bulkGrants, bulkGrant{Role: role, rolePrivilege: privilege})
+		}
+	}
+	return bulkGrants, nil
+}
+
+// diffBulkGrants returns the privileges that have to be granted and revoked to get from the old to the new grants.
+// The privilege granted with a different grant option is revoked and granted again.
+func diffBulkGrants(newGrants []bulkGrant, oldGrants []bulkGrant) (toGrant []bulkGrant, toRevoke []bulkGrant) {
+	newKeys := make(map[string]bool)
+	for _, grant := range newGrants {
+		newKeys[grant.key()] = true
+	}
+	oldKeys := make(map[string]bool)
+	for _, grant := range oldGrants {
+		oldKeys[grant.key()] = true
+		if !newKeys[grant.key()] {
+			toRevoke = append(toRevoke, grant)
+		}
+	}
+	for _, grant := range newGrants {
+		if !oldKeys[grant.key()] {
+			toGrant = append(toGrant, grant)
+		}
+	}
+	return toGrant, toRevoke
+}
+
+// bulkGrantRoles returns the roles of the grants in the order of their first occurrence, with the privileges granted to each of them.
+func bulkGrantRoles(grants []bulkGrant) ([]sdk.AccountObjectIdentifier, map[sdk.AccountObjectIdentifier][]rolePrivilege) {
+	var roles []sdk.AccountObjectIdentifier
+	privilegesByRole := make(map[sdk.AccountObjectIdentifier][]rolePrivilege)
+	for _, grant := range grants {
+		if _, ok := privilegesByRole[grant.Role]; !ok {
+			roles = append(roles, grant.Role)
+		}
+		privilegesByRole[grant.Role] = append(privilegesByRole[grant.Role], grant.rolePrivilege)
+	}
+	return roles, privilegesByRole
+}
+
+// accountRolePrivilegesGrants returns the statements for the grants; the privileges on the same object are granted in a single statement.
+func accountRolePrivilegesGrants(grants []bulkGrant) ([]sdk.AccountRolePrivilegesGrant, error) {
+	roles, privilegesByRole := bulkGrantRoles(grants)
+	result := make([]sdk.AccountRolePrivilegesGrant, 0)
+	for _, role := range roles {
+		targets, grouped := groupRolePrivilegesByTarget(privilegesByRole[role])
+		for _, target := range targets {
+			privileges, on, err := accountRoleGrantFor(target, grouped[target])
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, sdk.AccountRolePrivilegesGrant{
+				Privileges:      privileges,
+				On:              on,
+				AccountRole:     role,
+				WithGrantOption: sdk.Bool(target.WithGrantOption),
+			})
+		}
+	}
+	return result, nil
+}
+
+func grantBulkGrants(ctx context.Context, client *sdk.Client, grants []bulkGrant, batchSize int) error {
+	if len(grants) == 0 {
+		return nil
+	}
+	statements, err := accountRolePrivilegesGrants(grants)
+	if err != nil {
+		return err
+	}
+	return client.Grants.GrantPrivilegesToAccountRoleInBatches(ctx, statements, batchSize)
+}
+
+// accountRolePrivilegesRevokes returns the statements for the revokes; the grant option does not matter for the revoke, so the privileges are grouped by the object only.
+func accountRolePrivilegesRevokes(grants []bulkGrant) ([]sdk.AccountRolePrivilegesGrant, error) {
+	revokes := make([]bulkGrant, len(grants))
+	for i, grant := range grants {
+		grant.WithGrantOption = false
+		revokes[i] = grant
+	}
+	return accountRolePrivilegesGrants(revokes)
+}
+
+func revokeBulkGrants(ctx context.Context, client *sdk.Client, grants []bulkGrant, batchSize int) error {
+	if len(grants) == 0 {
+		return nil
+	}
+	log.Printf("[INFO] Revoking privileges: %v", grants)
+	statements, err := accountRolePrivilegesRevokes(grants)
+	if err != nil {
+		return err
+	}
+	return client.Grants.RevokePrivilegesFromAccountRoleInBatches(ctx, statements, batchSize)
+}
+
+// revokeBulkGrantsOneByOne revokes the privileges with a separate call for every statement, so that a removed role or object
+// does not stop the revokes that follow it; only the failures caused by the removed roles and objects are ignored.
+func revokeBulkGrantsOneByOne(ctx context.Context, client *sdk.Client, grants []bulkGrant) error {
+	statements, err := accountRolePrivilegesRevokes(grants)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, statement := range statements {
+		err := client.Grants.RevokePrivilegesFromAccountRoleInBatches(ctx, []sdk.AccountRolePrivilegesGrant{statement}, 1)
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			log.Printf("[DEBUG] Role or object not found, skipping the revoke from account role %s, err: %s", statement.AccountRole.FullyQualifiedName(), err)
+			continue
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func CreateGrantPrivilegesInBulk(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	grants, err := expandBulkGrants(d.Get("grant").(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the id is set before granting, because the batches are not rolled back; when one of them fails,
+	// the already granted privileges are kept in the state (with the privileges that were not granted removed by Read), so that destroy revokes them
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+
+	if err := grantBulkGrants(ctx, client, grants, d.Get("batch_size").(int)); err != nil {
+		return append(diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when granting privileges",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+			},
+		}, ReadGrantPrivilegesInBulk(ctx, d, meta)...)
+	}
+
+	return ReadGrantPrivilegesInBulk(ctx, d, meta)
+}
+
+func UpdateGrantPrivilegesInBulk(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	if d.HasChange("grant") {
+		before, after := d.GetChange("grant")
+		oldGrants, err := expandBulkGrants(before.(*schema.Set).List())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		newGrants, err := expandBulkGrants(after.(*schema.Set).List())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		toGrant, toRevoke := diffBulkGrants(newGrants, oldGrants)
+		batchSize := d.Get("batch_size").(int)
+
+		// like in create, the state is refreshed when one of the batches fails, so that it holds the privileges that are actually granted;
+		// when revoking fails, the previous grants are kept in the state, so that the privileges that were not revoked yet stay tracked
+		if err := revokeBulkGrants(ctx, client, toRevoke, batchSize); err != nil {
+			if setErr := d.Set("grant", before); setErr != nil {
+				return diag.FromErr(errors.Join(err, setErr))
+			}
+			return append(diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "An error occurred when revoking privileges",
+					Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+				},
+			}, ReadGrantPrivilegesInBulk(ctx, d, meta)...)
+		}
+		if err := grantBulkGrants(ctx, client, toGrant, batchSize); err != nil {
+			return append(diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "An error occurred when granting privileges",
+					Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+				},
+			}, ReadGrantPrivilegesInBulk(ctx, d, meta)...)
+		}
+	}
+
+	return ReadGrantPrivilegesInBulk(ctx, d, meta)
+}
+
+func ReadGrantPrivilegesInBulk(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	grants := d.Get("grant").(*schema.Set).List()
+	declared, err := expandBulkGrants(grants)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the privileges are read with a single SHOW GRANTS TO ROLE for every role
+	roles, _ := bulkGrantRoles(declared)
+	grantedKeys := make(map[string]bool)
+	for _, role := range roles {
+		privileges, _, err := showRolePrivileges(ctx, client, rolePrivilegesGrantee{AccountRole: sdk.Pointer(role)})
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+				log.Printf("[DEBUG] Account role %s not found, its privileges will be granted again", role.FullyQualifiedName())
+				continue
+			}
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to retrieve grants",
+					Detail:   fmt.Sprintf("Id: %s\nAccount role: %s\nError: %s", d.Id(), role.FullyQualifiedName(), err),
+				},
+			}
+		}
+		for _, privilege := range privileges {
+			grantedKeys[bulkGrant{Role: role, rolePrivilege: privilege}.key()] = true
+		}
+	}
+
+	// the declared privileges that are no longer granted are removed from the grant blocks, so that they are granted again
+	for _, raw := range grants {
+		grant := raw.(map[string]any)
+		declared, err := expandBulkGrants([]any{grant})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		granted := make([]string, 0)
+		for _, privilege := range declared {
+			if grantedKeys[privilege.key()] {
+				granted = append(granted, privilege.Privilege)
+			}
+		}
+		grant["privileges"] = granted
+	}
+
+	if err := d.Set("grant", grants); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func DeleteGrantPrivilegesInBulk(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	grants, err := expandBulkGrants(d.Get("grant").(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	batchSize := d.Get("batch_size").(int)
+	err = revokeBulkGrants(ctx, client, grants, batchSize)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		// some of the roles or objects were removed, so the batch stopped at their statement; the privileges are revoked one statement at a time instead
+		log.Printf("[DEBUG] Revoking privileges in bulk failed, revoking them one statement at a time, err: %s", err)
+		err = revokeBulkGrantsOneByOne(ctx, client, grants)
+	}
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when revoking privileges",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+			},
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
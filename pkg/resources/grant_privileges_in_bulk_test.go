@@ -0,0 +1,40 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountRolePrivilegesGrants(t *testing.T) {
+	first := sdk.NewAccountObjectIdentifier("FIRST")
+	second := sdk.NewAccountObjectIdentifier("SECOND")
+	database := sdk.NewAccountObjectIdentifier("DATABASE")
+	usage := rolePrivilege{Privilege: "USAGE", ObjectType: sdk.ObjectTypeDatabase, ObjectName: database.FullyQualifiedName()}
+	monitor := rolePrivilege{Privilege: "MONITOR", ObjectType: sdk.ObjectTypeDatabase, ObjectName: database.FullyQualifiedName()}
+	createDatabase := rolePrivilege{Privilege: "CREATE DATABASE", ObjectType: sdk.ObjectTypeAccount}
+
+	statements, err := accountRolePrivilegesGrants([]bulkGrant{
+		{Role: first, rolePrivilege: usage},
+		{Role: second, rolePrivilege: createDatabase},
+		{Role: first, rolePrivilege: monitor},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []sdk.AccountRolePrivilegesGrant{
+		{
+			Privileges:      &sdk.AccountRoleGrantPrivileges{AccountObjectPrivileges: []sdk.AccountObjectPrivilege{"USAGE", "MONITOR"}},
+			On:              &sdk.AccountRoleGrantOn{AccountObject: &sdk.GrantOnAccountObject{Database: &database}},
+			AccountRole:     first,
+			WithGrantOption: sdk.Bool(false),
+		},
+		{
+			Privileges:      &sdk.AccountRoleGrantPrivileges{GlobalPrivileges: []sdk.GlobalPrivilege{"CREATE DATABASE"}},
+			On:              &sdk.AccountRoleGrantOn{Account: sdk.Bool(true)},
+			AccountRole:     second,
+			WithGrantOption: sdk.Bool(false),
+		},
+	}, statements)
+}
@@ -72,6 +72,12 @@ var grantPrivilegesToAccountRoleSchema = map[string]*schema.Schema{
 		Default:     "",
 		Description: "This is a helper field and should not be set. Its main purpose is to help to achieve the functionality described by the always_apply field.",
 	},
+	"shadowed_in_schemas": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The schemas of the database in which the `on_schema_object.future` grant in the database is ignored, because they have their own schema-level future grants on the same object type that take precedence. Empty for the other grants.",
+	},
 	"on_account": {
 		Type:        schema.TypeBool,
 		Optional:    true,
@@ -100,21 +106,11 @@ var grantPrivilegesToAccountRoleSchema = map[string]*schema.Schema{
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"object_type": {
-					Type:        schema.TypeString,
-					Required:    true,
-					ForceNew:    true,
-					Description: "The object type of the account object on which privileges will be granted. Valid values are: USER | RESOURCE MONITOR | WAREHOUSE | COMPUTE POOL | DATABASE | INTEGRATION | FAILOVER GROUP | REPLICATION GROUP | EXTERNAL VOLUME",
-					ValidateFunc: validation.StringInSlice([]string{
-						"USER",
-						"RESOURCE MONITOR",
-						"WAREHOUSE",
-						"COMPUTE POOL",
-						"DATABASE",
-						"INTEGRATION",
-						"FAILOVER GROUP",
-						"REPLICATION GROUP",
-						"EXTERNAL VOLUME",
-					}, true),
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "The object type of the account object on which privileges will be granted. Valid values are: USER | RESOURCE MONITOR | WAREHOUSE | COMPUTE POOL | DATABASE | INTEGRATION | FAILOVER GROUP | REPLICATION GROUP | EXTERNAL VOLUME",
+					ValidateFunc: validation.StringInSlice(sdk.ValidGrantToAccountObjectTypesString, true),
 				},
 				"object_name": {
 					Type:             schema.TypeString,
@@ -294,18 +290,19 @@ func getGrantPrivilegesOnAccountRoleBulkOperationSchema(validGrantToObjectTypes
 }
 
 func GrantPrivilegesToAccountRole() *schema.Resource {
-	return &schema.Resource{
+	return withResourceIdentity(grantPrivilegesToAccountRoleIdentity, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.GrantPrivilegesToAccountRole, CreateGrantPrivilegesToAccountRole),
 		UpdateContext: TrackingUpdateWrapper(resources.GrantPrivilegesToAccountRole, UpdateGrantPrivilegesToAccountRole),
 		DeleteContext: TrackingDeleteWrapper(resources.GrantPrivilegesToAccountRole, DeleteGrantPrivilegesToAccountRole),
 		ReadContext:   TrackingReadWrapper(resources.GrantPrivilegesToAccountRole, ReadGrantPrivilegesToAccountRole),
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.GrantPrivilegesToAccountRole, shadowedFutureGrantsCustomDiff),
 
 		Schema: grantPrivilegesToAccountRoleSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.GrantPrivilegesToAccountRole, ImportGrantPrivilegesToAccountRole()),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportGrantPrivilegesToAccountRole() func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
@@ -329,120 +326,103 @@ func ImportGrantPrivilegesToAccountRole() func(ctx context.Context, d *schema.Re
 		if err := d.Set("privileges", id.Privileges); err != nil {
 			return nil, err
 		}
-		if err := d.Set("on_account", false); err != nil {
+		if err := setAccountRoleGrantOn(d, id.Kind, id.Data); err != nil {
 			return nil, err
 		}
 
-		switch id.Kind {
-		case OnAccountAccountRoleGrantKind:
-			if err := d.Set("on_account", true); err != nil {
-				return nil, err
-			}
-		case OnAccountObjectAccountRoleGrantKind:
-			data := id.Data.(*OnAccountObjectGrantData)
-			onAccountObject := make(map[string]any)
-			onAccountObject["object_type"] = data.ObjectType.String()
-			onAccountObject["object_name"] = data.ObjectName.FullyQualifiedName()
+		return []*schema.ResourceData{d}, nil
+	}
+}
 
-			if err := d.Set("on_account_object", []any{onAccountObject}); err != nil {
-				return nil, err
-			}
-		case OnSchemaAccountRoleGrantKind:
-			data := id.Data.(*OnSchemaGrantData)
-			onSchema := make(map[string]any)
-
-			switch data.Kind {
-			case OnSchemaSchemaGrantKind:
-				onSchema["schema_name"] = data.SchemaName.FullyQualifiedName()
-			case OnAllSchemasInDatabaseSchemaGrantKind:
-				onSchema["all_schemas_in_database"] = data.DatabaseName.FullyQualifiedName()
-			case OnFutureSchemasInDatabaseSchemaGrantKind:
-				onSchema["future_schemas_in_database"] = data.DatabaseName.FullyQualifiedName()
-			}
+// setAccountRoleGrantOn sets the on_* blocks shared by the resources granting privileges to account and application roles.
+func setAccountRoleGrantOn(d *schema.ResourceData, kind AccountRoleGrantKind, grantData fmt.Stringer) error {
+	if err := d.Set("on_account", false); err != nil {
+		return err
+	}
 
-			if err := d.Set("on_schema", []any{onSchema}); err != nil {
-				return nil, err
-			}
-		case OnSchemaObjectAccountRoleGrantKind:
-			data := id.Data.(*OnSchemaObjectGrantData)
-			onSchemaObject := make(map[string]any)
-
-			switch data.Kind {
-			case OnObjectSchemaObjectGrantKind:
-				onSchemaObject["object_type"] = data.Object.ObjectType.String()
-				onSchemaObject["object_name"] = data.Object.Name.FullyQualifiedName()
-			case OnAllSchemaObjectGrantKind:
-				onAll := make(map[string]any)
-
-				onAll["object_type_plural"] = data.OnAllOrFuture.ObjectNamePlural.String()
-				switch data.OnAllOrFuture.Kind {
-				case InDatabaseBulkOperationGrantKind:
-					onAll["in_database"] = data.OnAllOrFuture.Database.FullyQualifiedName()
-				case InSchemaBulkOperationGrantKind:
-					onAll["in_schema"] = data.OnAllOrFuture.Schema.FullyQualifiedName()
-				}
+	switch kind {
+	case OnAccountAccountRoleGrantKind:
+		if err := d.Set("on_account", true); err != nil {
+			return err
+		}
+	case OnAccountObjectAccountRoleGrantKind:
+		data := grantData.(*OnAccountObjectGrantData)
+		onAccountObject := make(map[string]any)
+		onAccountObject["object_type"] = data.ObjectType.String()
+		onAccountObject["object_name"] = data.ObjectName.FullyQualifiedName()
 
-				onSchemaObject["all"] = []any{onAll}
-			case OnFutureSchemaObjectGrantKind:
-				onFuture := make(map[string]any)
+		if err := d.Set("on_account_object", []any{onAccountObject}); err != nil {
+			return err
+		}
+	case OnSchemaAccountRoleGrantKind:
+		data := grantData.(*OnSchemaGrantData)
+		onSchema := make(map[string]any)
 
-				onFuture["object_type_plural"] = data.OnAllOrFuture.ObjectNamePlural.String()
-				switch data.OnAllOrFuture.Kind {
-				case InDatabaseBulkOperationGrantKind:
-					onFuture["in_database"] = data.OnAllOrFuture.Database.FullyQualifiedName()
-				case InSchemaBulkOperationGrantKind:
-					onFuture["in_schema"] = data.OnAllOrFuture.Schema.FullyQualifiedName()
-				}
+		switch data.Kind {
+		case OnSchemaSchemaGrantKind:
+			onSchema["schema_name"] = data.SchemaName.FullyQualifiedName()
+		case OnAllSchemasInDatabaseSchemaGrantKind:
+			onSchema["all_schemas_in_database"] = data.DatabaseName.FullyQualifiedName()
+		case OnFutureSchemasInDatabaseSchemaGrantKind:
+			onSchema["future_schemas_in_database"] = data.DatabaseName.FullyQualifiedName()
+		}
 
-				onSchemaObject["future"] = []any{onFuture}
+		if err := d.Set("on_schema", []any{onSchema}); err != nil {
+			return err
+		}
+	case OnSchemaObjectAccountRoleGrantKind:
+		data := grantData.(*OnSchemaObjectGrantData)
+		onSchemaObject := make(map[string]any)
+
+		switch data.Kind {
+		case OnObjectSchemaObjectGrantKind:
+			onSchemaObject["object_type"] = data.Object.ObjectType.String()
+			onSchemaObject["object_name"] = data.Object.Name.FullyQualifiedName()
+		case OnAllSchemaObjectGrantKind:
+			onAll := make(map[string]any)
+
+			onAll["object_type_plural"] = data.OnAllOrFuture.ObjectNamePlural.String()
+			switch data.OnAllOrFuture.Kind {
+			case InDatabaseBulkOperationGrantKind:
+				onAll["in_database"] = data.OnAllOrFuture.Database.FullyQualifiedName()
+			case InSchemaBulkOperationGrantKind:
+				onAll["in_schema"] = data.OnAllOrFuture.Schema.FullyQualifiedName()
 			}
 
-			if err := d.Set("on_schema_object", []any{onSchemaObject}); err != nil {
-				return nil, err
+			onSchemaObject["all"] = []any{onAll}
+		case OnFutureSchemaObjectGrantKind:
+			onFuture := make(map[string]any)
+
+			onFuture["object_type_plural"] = data.OnAllOrFuture.ObjectNamePlural.String()
+			switch data.OnAllOrFuture.Kind {
+			case InDatabaseBulkOperationGrantKind:
+				onFuture["in_database"] = data.OnAllOrFuture.Database.FullyQualifiedName()
+			case InSchemaBulkOperationGrantKind:
+				onFuture["in_schema"] = data.OnAllOrFuture.Schema.FullyQualifiedName()
 			}
+
+			onSchemaObject["future"] = []any{onFuture}
 		}
 
-		return []*schema.ResourceData{d}, nil
+		if err := d.Set("on_schema_object", []any{onSchemaObject}); err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
 func CreateGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-	diags := diag.Diagnostics{}
 
 	id, err := createGrantPrivilegesToAccountRoleIdFromSchema(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	grantOn, err := getAccountRoleGrantOn(d)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = client.Grants.GrantPrivilegesToAccountRole(
-		ctx,
-		getAccountRolePrivilegesFromSchema(d),
-		grantOn,
-		sdk.NewAccountObjectIdentifierFromFullyQualifiedName(d.Get("account_role_name").(string)),
-		&sdk.GrantPrivilegesToAccountRoleOptions{
-			WithGrantOption: sdk.Bool(d.Get("with_grant_option").(bool)),
-		},
-	)
-	if errors.Is(err, sdk.ErrGrantPartiallyExecuted) && d.Get("all_privileges").(bool) {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Warning,
-			Summary:  "An error occurred when granting all privileges to account role",
-			Detail:   fmt.Sprintf("Id: %s\nAccount role name: %s\nError: %s", id.String(), id.RoleName, err),
-		})
-	} else if err != nil {
-		return diag.Diagnostics{
-			diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "An error occurred when granting privileges to account role",
-				Detail:   fmt.Sprintf("Id: %s\nAccount role name: %s\nError: %s", id.String(), id.RoleName, err),
-			},
-		}
+	diags := accountRoleGrantee(client, id.RoleName).grantPrivilegesFromSchema(ctx, d, id.String(), id.WithGrantOption, "")
+	if diags.HasError() {
+		return diags
 	}
 
 	d.SetId(id.String())
@@ -452,7 +432,6 @@ func CreateGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceD
 
 func UpdateGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
-	diags := diag.Diagnostics{}
 
 	id, err := ParseGrantPrivilegesToAccountRoleId(d.Id())
 	if err != nil {
@@ -469,205 +448,21 @@ func UpdateGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceD
 		id.WithGrantOption = d.Get("with_grant_option").(bool)
 	}
 
-	// handle all_privileges -> privileges change (revoke all privileges)
-	if d.HasChange("all_privileges") {
-		_, allPrivileges := d.GetChange("all_privileges")
-
-		if !allPrivileges.(bool) {
-			grantOn, err := getAccountRoleGrantOn(d)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			err = client.Grants.RevokePrivilegesFromAccountRole(ctx, &sdk.AccountRoleGrantPrivileges{
-				AllPrivileges: sdk.Bool(true),
-			},
-				grantOn,
-				id.RoleName,
-				new(sdk.RevokePrivilegesFromAccountRoleOptions),
-			)
-			if err != nil {
-				return diag.Diagnostics{
-					diag.Diagnostic{
-						Severity: diag.Error,
-						Summary:  "Failed to revoke all privileges",
-						Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
-					},
-				}
-			}
-		}
-
-		id.AllPrivileges = allPrivileges.(bool)
-	}
-
-	if d.HasChange("privileges") {
-		shouldHandlePrivilegesChange := true
-
-		// Skip if all_privileges was set to true
-		if d.HasChange("all_privileges") {
-			if _, allPrivileges := d.GetChange("all_privileges"); allPrivileges.(bool) {
-				shouldHandlePrivilegesChange = false
-				id.Privileges = []string{}
-			}
-		}
-
-		if shouldHandlePrivilegesChange {
-			before, after := d.GetChange("privileges")
-			privilegesBeforeChange := expandStringList(before.(*schema.Set).List())
-			privilegesAfterChange := expandStringList(after.(*schema.Set).List())
-
-			var privilegesToAdd, privilegesToRemove []string
-
-			for _, privilegeBeforeChange := range privilegesBeforeChange {
-				if !slices.Contains(privilegesAfterChange, privilegeBeforeChange) {
-					privilegesToRemove = append(privilegesToRemove, privilegeBeforeChange)
-				}
-			}
-
-			for _, privilegeAfterChange := range privilegesAfterChange {
-				if !slices.Contains(privilegesBeforeChange, privilegeAfterChange) {
-					privilegesToAdd = append(privilegesToAdd, privilegeAfterChange)
-				}
-			}
-
-			grantOn, err := getAccountRoleGrantOn(d)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if len(privilegesToAdd) > 0 {
-				privilegesToGrant := getAccountRolePrivileges(
-					false,
-					privilegesToAdd,
-					id.Kind == OnAccountAccountRoleGrantKind,
-					id.Kind == OnAccountObjectAccountRoleGrantKind,
-					id.Kind == OnSchemaAccountRoleGrantKind,
-					id.Kind == OnSchemaObjectAccountRoleGrantKind,
-				)
-
-				if !id.WithGrantOption {
-					if err = client.Grants.RevokePrivilegesFromAccountRole(ctx, privilegesToGrant, grantOn, id.RoleName, &sdk.RevokePrivilegesFromAccountRoleOptions{
-						GrantOptionFor: sdk.Bool(true),
-					}); err != nil {
-						return diag.Diagnostics{
-							diag.Diagnostic{
-								Severity: diag.Error,
-								Summary:  "Failed to revoke privileges to add",
-								Detail:   fmt.Sprintf("Id: %s\nPrivileges to add: %v\nError: %s", d.Id(), privilegesToAdd, err.Error()),
-							},
-						}
-					}
-				}
-
-				err = client.Grants.GrantPrivilegesToAccountRole(ctx, privilegesToGrant, grantOn, id.RoleName, &sdk.GrantPrivilegesToAccountRoleOptions{WithGrantOption: sdk.Bool(id.WithGrantOption)})
-				if err != nil {
-					return diag.Diagnostics{
-						diag.Diagnostic{
-							Severity: diag.Error,
-							Summary:  "Failed to grant added privileges",
-							Detail:   fmt.Sprintf("Id: %s\nPrivileges to add: %v\nError: %s", d.Id(), privilegesToAdd, err),
-						},
-					}
-				}
-			}
-
-			if len(privilegesToRemove) > 0 {
-				err = client.Grants.RevokePrivilegesFromAccountRole(
-					ctx,
-					getAccountRolePrivileges(
-						false,
-						privilegesToRemove,
-						id.Kind == OnAccountAccountRoleGrantKind,
-						id.Kind == OnAccountObjectAccountRoleGrantKind,
-						id.Kind == OnSchemaAccountRoleGrantKind,
-						id.Kind == OnSchemaObjectAccountRoleGrantKind,
-					),
-					grantOn,
-					id.RoleName,
-					new(sdk.RevokePrivilegesFromAccountRoleOptions),
-				)
-				if err != nil {
-					return diag.Diagnostics{
-						diag.Diagnostic{
-							Severity: diag.Error,
-							Summary:  "Failed to revoke removed privileges",
-							Detail:   fmt.Sprintf("Id: %s\nPrivileges to remove: %v\nError: %s", d.Id(), privilegesToRemove, err),
-						},
-					}
-				}
-			}
-
-			id.Privileges = privilegesAfterChange
-		}
-	}
-
-	// handle privileges -> all_privileges change (grant all privileges)
-	if d.HasChange("all_privileges") {
-		_, allPrivileges := d.GetChange("all_privileges")
-
-		if allPrivileges.(bool) {
-			grantOn, err := getAccountRoleGrantOn(d)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			err = client.Grants.GrantPrivilegesToAccountRole(ctx, &sdk.AccountRoleGrantPrivileges{
-				AllPrivileges: sdk.Bool(true),
-			},
-				grantOn,
-				id.RoleName,
-				new(sdk.GrantPrivilegesToAccountRoleOptions),
-			)
-			if errors.Is(err, sdk.ErrGrantPartiallyExecuted) {
-				diags = append(diags, diag.Diagnostic{
-					Severity: diag.Warning,
-					Summary:  "An error occurred when granting all privileges to account role",
-					Detail:   fmt.Sprintf("Id: %s\nAccount role name: %s\nError: %s", id.String(), id.RoleName, err),
-				})
-			} else if err != nil {
-				return diag.Diagnostics{
-					diag.Diagnostic{
-						Severity: diag.Error,
-						Summary:  "Failed to grant all privileges",
-						Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
-					},
-				}
-			}
-		}
-
-		id.AllPrivileges = allPrivileges.(bool)
+	grantee := accountRoleGrantee(client, id.RoleName)
+	diags := grantee.updatePrivileges(ctx, d, id.Kind, id.WithGrantOption)
+	if diags.HasError() {
+		return diags
 	}
+	id.AllPrivileges, id.Privileges = updatedAccountRolePrivileges(d, id.AllPrivileges, id.Privileges)
 
 	if d.HasChange("always_apply") {
 		id.AlwaysApply = d.Get("always_apply").(bool)
 	}
 
 	if id.AlwaysApply {
-		grantOn, err := getAccountRoleGrantOn(d)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		err = client.Grants.GrantPrivilegesToAccountRole(
-			ctx,
-			getAccountRolePrivilegesFromSchema(d),
-			grantOn,
-			id.RoleName,
-			&sdk.GrantPrivilegesToAccountRoleOptions{
-				WithGrantOption: &id.WithGrantOption,
-			},
-		)
-		if errors.Is(err, sdk.ErrGrantPartiallyExecuted) && d.Get("all_privileges").(bool) {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  "An error occurred when granting all privileges to account role",
-				Detail:   fmt.Sprintf("Id: %s\nAccount role name: %s\nError: %s", id.String(), id.RoleName, err),
-			})
-		} else if err != nil {
-			return diag.Diagnostics{
-				diag.Diagnostic{
-					Severity: diag.Error,
-					Summary:  "Always apply. An error occurred when granting privileges to account role",
-					Detail:   fmt.Sprintf("Id: %s\nAccount role name: %s\nError: %s", d.Id(), id.RoleName, err),
-				},
-			}
+		diags = append(diags, grantee.grantPrivilegesFromSchema(ctx, d, id.String(), id.WithGrantOption, "Always apply. ")...)
+		if diags.HasError() {
+			return diags
 		}
 	}
 
@@ -690,26 +485,8 @@ func DeleteGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
-	grantOn, err := getAccountRoleGrantOn(d)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	err = client.Grants.RevokePrivilegesFromAccountRole(
-		ctx,
-		getAccountRolePrivilegesFromSchema(d),
-		grantOn,
-		id.RoleName,
-		&sdk.RevokePrivilegesFromAccountRoleOptions{},
-	)
-	if err != nil {
-		return diag.Diagnostics{
-			diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "An error occurred when revoking privileges from account role",
-				Detail:   fmt.Sprintf("Id: %s\nAccount role name: %s\nError: %s", d.Id(), id.RoleName.FullyQualifiedName(), err),
-			},
-		}
+	if diags := accountRoleGrantee(client, id.RoleName).revokePrivilegesFromSchema(ctx, d); diags.HasError() {
+		return diags
 	}
 
 	d.SetId("")
@@ -768,8 +545,9 @@ func ReadGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceDat
 		return nil
 	}
 
-	opts, grantedOn := prepareShowGrantsRequestForAccountRole(id)
-	if opts == nil {
+	opts, grantedOn := prepareShowGrantsRequestForAccountRole(id.Kind, id.Data)
+	onAll := onAllSchemaObjectsGrantData(id.Data)
+	if opts == nil && onAll == nil {
 		return nil
 	}
 
@@ -786,6 +564,10 @@ func ReadGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if onAll != nil {
+		return readGrantPrivilegesOnAllSchemaObjects(ctx, d, client, onAll, &sdk.ShowGrantsTo{Role: id.RoleName}, id.Privileges, id.WithGrantOption)
+	}
+
 	grants, err := client.Grants.Show(ctx, opts)
 	if err != nil {
 		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
@@ -868,21 +650,21 @@ func ReadGrantPrivilegesToAccountRole(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
-	return nil
+	return setShadowedInSchemas(ctx, client, d, id.Data)
 }
 
-func prepareShowGrantsRequestForAccountRole(id GrantPrivilegesToAccountRoleId) (*sdk.ShowGrantOptions, sdk.ObjectType) {
+func prepareShowGrantsRequestForAccountRole(kind AccountRoleGrantKind, grantData fmt.Stringer) (*sdk.ShowGrantOptions, sdk.ObjectType) {
 	opts := new(sdk.ShowGrantOptions)
 	var grantedOn sdk.ObjectType
 
-	switch id.Kind {
+	switch kind {
 	case OnAccountAccountRoleGrantKind:
 		grantedOn = sdk.ObjectTypeAccount
 		opts.On = &sdk.ShowGrantsOn{
 			Account: sdk.Bool(true),
 		}
 	case OnAccountObjectAccountRoleGrantKind:
-		data := id.Data.(*OnAccountObjectGrantData)
+		data := grantData.(*OnAccountObjectGrantData)
 		grantedOn = data.ObjectType
 		opts.On = &sdk.ShowGrantsOn{
 			Object: &sdk.Object{
@@ -892,7 +674,7 @@ func prepareShowGrantsRequestForAccountRole(id GrantPrivilegesToAccountRoleId) (
 		}
 	case OnSchemaAccountRoleGrantKind:
 		grantedOn = sdk.ObjectTypeSchema
-		data := id.Data.(*OnSchemaGrantData)
+		data := grantData.(*OnSchemaGrantData)
 
 		switch data.Kind {
 		case OnSchemaSchemaGrantKind:
@@ -912,7 +694,7 @@ func prepareShowGrantsRequestForAccountRole(id GrantPrivilegesToAccountRoleId) (
 			}
 		}
 	case OnSchemaObjectAccountRoleGrantKind:
-		data := id.Data.(*OnSchemaObjectGrantData)
+		data := grantData.(*OnSchemaObjectGrantData)
 
 		switch data.Kind {
 		case OnObjectSchemaObjectGrantKind:
@@ -921,7 +703,10 @@ func prepareShowGrantsRequestForAccountRole(id GrantPrivilegesToAccountRoleId) (
 				Object: data.Object,
 			}
 		case OnAllSchemaObjectGrantKind:
-			log.Printf("[INFO] Show with on_schema_object.on_all option is skipped. No changes in privileges in Snowflake will be detected.")
+			// the grants on all the objects are read with a single SHOW GRANTS TO (see readGrantPrivilegesOnAllSchemaObjects)
+			if data.OnAllOrFuture.ObjectNamePlural.Singular().IsWithArguments() {
+				log.Printf("[INFO] Show with on_schema_object.on_all option is skipped for %s. No changes in privileges in Snowflake will be detected.", data.OnAllOrFuture.ObjectNamePlural)
+			}
 			return nil, ""
 		case OnFutureSchemaObjectGrantKind:
 			grantedOn = data.OnAllOrFuture.ObjectNamePlural.Singular()
@@ -1010,8 +795,6 @@ func getAccountRoleGrantOn(d *schema.ResourceData) (*sdk.AccountRoleGrantOn, err
 	case onAccountObjectOk:
 		onAccountObject := onAccountObjectBlock.([]any)[0].(map[string]any)
 
-		grantOnAccountObject := new(sdk.GrantOnAccountObject)
-
 		objectType := onAccountObject["object_type"].(string)
 		objectName := onAccountObject["object_name"].(string)
 		objectIdentifier, err := sdk.ParseAccountObjectIdentifier(objectName)
@@ -1019,28 +802,7 @@ func getAccountRoleGrantOn(d *schema.ResourceData) (*sdk.AccountRoleGrantOn, err
 			return nil, err
 		}
 
-		switch sdk.ObjectType(objectType) {
-		case sdk.ObjectTypeDatabase:
-			grantOnAccountObject.Database = &objectIdentifier
-		case sdk.ObjectTypeFailoverGroup:
-			grantOnAccountObject.FailoverGroup = &objectIdentifier
-		case sdk.ObjectTypeIntegration:
-			grantOnAccountObject.Integration = &objectIdentifier
-		case sdk.ObjectTypeReplicationGroup:
-			grantOnAccountObject.ReplicationGroup = &objectIdentifier
-		case sdk.ObjectTypeResourceMonitor:
-			grantOnAccountObject.ResourceMonitor = &objectIdentifier
-		case sdk.ObjectTypeUser:
-			grantOnAccountObject.User = &objectIdentifier
-		case sdk.ObjectTypeWarehouse:
-			grantOnAccountObject.Warehouse = &objectIdentifier
-		case sdk.ObjectTypeComputePool:
-			grantOnAccountObject.ComputePool = &objectIdentifier
-		case sdk.ObjectTypeExternalVolume:
-			grantOnAccountObject.ExternalVolume = &objectIdentifier
-		}
-
-		on.AccountObject = grantOnAccountObject
+		on.AccountObject = getGrantOnAccountObject(sdk.ObjectType(objectType), objectIdentifier)
 	case onSchemaOk:
 		onSchema := onSchemaBlock.([]any)[0].(map[string]any)
 
@@ -1052,7 +814,8 @@ func getAccountRoleGrantOn(d *schema.ResourceData) (*sdk.AccountRoleGrantOn, err
 		allSchemasInDatabase := onSchema["all_schemas_in_database"].(string)
 		allSchemasInDatabaseOk := len(allSchemasInDatabase) > 0
 
-		futureSchemasInDatabase := onSchema["future_schemas_in_database"].(string)
+		// future_schemas_in_database is absent from the application role schema
+		futureSchemasInDatabase, _ := onSchema["future_schemas_in_database"].(string)
 		futureSchemasInDatabaseOk := len(futureSchemasInDatabase) > 0
 
 		switch {
@@ -1091,7 +854,8 @@ func getAccountRoleGrantOn(d *schema.ResourceData) (*sdk.AccountRoleGrantOn, err
 		all := onSchemaObject["all"].([]any)
 		allOk := len(all) > 0
 
-		future := onSchemaObject["future"].([]any)
+		// future is absent from the application role schema
+		future, _ := onSchemaObject["future"].([]any)
 		futureOk := len(future) > 0
 
 		switch {
@@ -1135,6 +899,33 @@ func getAccountRoleGrantOn(d *schema.ResourceData) (*sdk.AccountRoleGrantOn, err
 	return on, nil
 }
 
+func getGrantOnAccountObject(objectType sdk.ObjectType, objectIdentifier sdk.AccountObjectIdentifier) *sdk.GrantOnAccountObject {
+	grantOnAccountObject := new(sdk.GrantOnAccountObject)
+
+	switch objectType {
+	case sdk.ObjectTypeDatabase:
+		grantOnAccountObject.Database = &objectIdentifier
+	case sdk.ObjectTypeFailoverGroup:
+		grantOnAccountObject.FailoverGroup = &objectIdentifier
+	case sdk.ObjectTypeIntegration:
+		grantOnAccountObject.Integration = &objectIdentifier
+	case sdk.ObjectTypeReplicationGroup:
+		grantOnAccountObject.ReplicationGroup = &objectIdentifier
+	case sdk.ObjectTypeResourceMonitor:
+		grantOnAccountObject.ResourceMonitor = &objectIdentifier
+	case sdk.ObjectTypeUser:
+		grantOnAccountObject.User = &objectIdentifier
+	case sdk.ObjectTypeWarehouse:
+		grantOnAccountObject.Warehouse = &objectIdentifier
+	case sdk.ObjectTypeComputePool:
+		grantOnAccountObject.ComputePool = &objectIdentifier
+	case sdk.ObjectTypeExternalVolume:
+		grantOnAccountObject.ExternalVolume = &objectIdentifier
+	}
+
+	return grantOnAccountObject
+}
+
 func createGrantPrivilegesToAccountRoleIdFromSchema(d *schema.ResourceData) (id *GrantPrivilegesToAccountRoleId, err error) {
 	id = new(GrantPrivilegesToAccountRoleId)
 	id.RoleName, err = sdk.ParseAccountObjectIdentifier(d.Get("account_role_name").(string))
@@ -1152,10 +943,17 @@ func createGrantPrivilegesToAccountRoleIdFromSchema(d *schema.ResourceData) (id
 	if err != nil {
 		return nil, err
 	}
+	id.Kind, id.Data = getAccountRoleGrantKindAndData(on)
+
+	return id, nil
+}
+
+// getAccountRoleGrantKindAndData maps the securable to its representation in the account and application role grant identifiers.
+func getAccountRoleGrantKindAndData(on *sdk.AccountRoleGrantOn) (kind AccountRoleGrantKind, data fmt.Stringer) {
 	switch {
 	case on.Account != nil:
-		id.Kind = OnAccountAccountRoleGrantKind
-		id.Data = new(OnAccountGrantData)
+		kind = OnAccountAccountRoleGrantKind
+		data = new(OnAccountGrantData)
 	case on.AccountObject != nil:
 		onAccountObjectGrantData := new(OnAccountObjectGrantData)
 
@@ -1189,8 +987,8 @@ func createGrantPrivilegesToAccountRoleIdFromSchema(d *schema.ResourceData) (id
 			onAccountObjectGrantData.ObjectName = *on.AccountObject.ExternalVolume
 		}
 
-		id.Kind = OnAccountObjectAccountRoleGrantKind
-		id.Data = onAccountObjectGrantData
+		kind = OnAccountObjectAccountRoleGrantKind
+		data = onAccountObjectGrantData
 	case on.Schema != nil:
 		onSchemaGrantData := new(OnSchemaGrantData)
 
@@ -1206,8 +1004,8 @@ func createGrantPrivilegesToAccountRoleIdFromSchema(d *schema.ResourceData) (id
 			onSchemaGrantData.DatabaseName = on.Schema.FutureSchemasInDatabase
 		}
 
-		id.Kind = OnSchemaAccountRoleGrantKind
-		id.Data = onSchemaGrantData
+		kind = OnSchemaAccountRoleGrantKind
+		data = onSchemaGrantData
 	case on.SchemaObject != nil:
 		onSchemaObjectGrantData := new(OnSchemaObjectGrantData)
 
@@ -1223,9 +1021,224 @@ func createGrantPrivilegesToAccountRoleIdFromSchema(d *schema.ResourceData) (id
 			onSchemaObjectGrantData.OnAllOrFuture = getBulkOperationGrantData(on.SchemaObject.Future)
 		}
 
-		id.Kind = OnSchemaObjectAccountRoleGrantKind
-		id.Data = onSchemaObjectGrantData
+		kind = OnSchemaObjectAccountRoleGrantKind
+		data = onSchemaObjectGrantData
 	}
 
-	return id, nil
+	return kind, data
+}
+
+// accountRolePrivilegesGrantee grants and revokes the privileges described by the account role grant schema
+// (see getAccountRolePrivilegesFromSchema and getAccountRoleGrantOn). It lets the account role and the application role
+// resources share the create, update, and delete paths.
+type accountRolePrivilegesGrantee struct {
+	// kind is the grantee kind used in the diagnostics, e.g. "account role".
+	kind   string
+	name   sdk.ObjectIdentifier
+	grant  func(ctx context.Context, privileges *sdk.AccountRoleGrantPrivileges, on *sdk.AccountRoleGrantOn, withGrantOption bool) error
+	revoke func(ctx context.Context, privileges *sdk.AccountRoleGrantPrivileges, on *sdk.AccountRoleGrantOn, grantOptionFor bool) error
+}
+
+func accountRoleGrantee(client *sdk.Client, role sdk.AccountObjectIdentifier) accountRolePrivilegesGrantee {
+	return accountRolePrivilegesGrantee{
+		kind: "account role",
+		name: role,
+		grant: func(ctx context.Context, privileges *sdk.AccountRoleGrantPrivileges, on *sdk.AccountRoleGrantOn, withGrantOption bool) error {
+			return client.Grants.GrantPrivilegesToAccountRole(ctx, privileges, on, role, &sdk.GrantPrivilegesToAccountRoleOptions{WithGrantOption: sdk.Bool(withGrantOption)})
+		},
+		revoke: func(ctx context.Context, privileges *sdk.AccountRoleGrantPrivileges, on *sdk.AccountRoleGrantOn, grantOptionFor bool) error {
+			opts := new(sdk.RevokePrivilegesFromAccountRoleOptions)
+			if grantOptionFor {
+				opts.GrantOptionFor = sdk.Bool(true)
+			}
+			return client.Grants.RevokePrivilegesFromAccountRole(ctx, privileges, on, role, opts)
+		},
+	}
+}
+
+func (g accountRolePrivilegesGrantee) detail(id string, err error) string {
+	return fmt.Sprintf("Id: %s\n%s name: %s\nError: %s", id, strings.ToUpper(g.kind[:1])+g.kind[1:], g.name.FullyQualifiedName(), err)
+}
+
+// grantPrivilegesFromSchema grants the configured privileges. A partially executed grant of all privileges is reported as a warning.
+func (g accountRolePrivilegesGrantee) grantPrivilegesFromSchema(ctx context.Context, d *schema.ResourceData, id string, withGrantOption bool, summaryPrefix string) diag.Diagnostics {
+	grantOn, err := getAccountRoleGrantOn(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = g.grant(ctx, getAccountRolePrivilegesFromSchema(d), grantOn, withGrantOption)
+	if errors.Is(err, sdk.ErrGrantPartiallyExecuted) && d.Get("all_privileges").(bool) {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "An error occurred when granting all privileges to " + g.kind,
+				Detail:   g.detail(id, err),
+			},
+		}
+	} else if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  summaryPrefix + "An error occurred when granting privileges to " + g.kind,
+				Detail:   g.detail(id, err),
+			},
+		}
+	}
+
+	return nil
+}
+
+// updatePrivileges applies the all_privileges and privileges changes. The updated identifier values are returned by updatedAccountRolePrivileges.
+func (g accountRolePrivilegesGrantee) updatePrivileges(ctx context.Context, d *schema.ResourceData, kind AccountRoleGrantKind, withGrantOption bool) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	if !d.HasChanges("all_privileges", "privileges") {
+		return diags
+	}
+
+	grantOn, err := getAccountRoleGrantOn(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	privilegesFor := func(privileges []string) *sdk.AccountRoleGrantPrivileges {
+		return getAccountRolePrivileges(
+			false,
+			privileges,
+			kind == OnAccountAccountRoleGrantKind,
+			kind == OnAccountObjectAccountRoleGrantKind,
+			kind == OnSchemaAccountRoleGrantKind,
+			kind == OnSchemaObjectAccountRoleGrantKind,
+		)
+	}
+
+	_, allPrivileges := d.GetChange("all_privileges")
+
+	// handle all_privileges -> privileges change (revoke all privileges)
+	if d.HasChange("all_privileges") && !allPrivileges.(bool) {
+		if err := g.revoke(ctx, &sdk.AccountRoleGrantPrivileges{AllPrivileges: sdk.Bool(true)}, grantOn, false); err != nil {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to revoke all privileges",
+					Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+				},
+			}
+		}
+	}
+
+	// Skip if all_privileges was set to true
+	if d.HasChange("privileges") && !(d.HasChange("all_privileges") && allPrivileges.(bool)) {
+		before, after := d.GetChange("privileges")
+		privilegesBeforeChange := expandStringList(before.(*schema.Set).List())
+		privilegesAfterChange := expandStringList(after.(*schema.Set).List())
+
+		var privilegesToAdd, privilegesToRemove []string
+
+		for _, privilegeBeforeChange := range privilegesBeforeChange {
+			if !slices.Contains(privilegesAfterChange, privilegeBeforeChange) {
+				privilegesToRemove = append(privilegesToRemove, privilegeBeforeChange)
+			}
+		}
+
+		for _, privilegeAfterChange := range privilegesAfterChange {
+			if !slices.Contains(privilegesBeforeChange, privilegeAfterChange) {
+				privilegesToAdd = append(privilegesToAdd, privilegeAfterChange)
+			}
+		}
+
+		if len(privilegesToAdd) > 0 {
+			if !withGrantOption {
+				if err := g.revoke(ctx, privilegesFor(privilegesToAdd), grantOn, true); err != nil {
+					return diag.Diagnostics{
+						diag.Diagnostic{
+							Severity: diag.Error,
+							Summary:  "Failed to revoke privileges to add",
+							Detail:   fmt.Sprintf("Id: %s\nPrivileges to add: %v\nError: %s", d.Id(), privilegesToAdd, err),
+						},
+					}
+				}
+			}
+
+			if err := g.grant(ctx, privilegesFor(privilegesToAdd), grantOn, withGrantOption); err != nil {
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "Failed to grant added privileges",
+						Detail:   fmt.Sprintf("Id: %s\nPrivileges to add: %v\nError: %s", d.Id(), privilegesToAdd, err),
+					},
+				}
+			}
+		}
+
+		if len(privilegesToRemove) > 0 {
+			if err := g.revoke(ctx, privilegesFor(privilegesToRemove), grantOn, false); err != nil {
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "Failed to revoke removed privileges",
+						Detail:   fmt.Sprintf("Id: %s\nPrivileges to remove: %v\nError: %s", d.Id(), privilegesToRemove, err),
+					},
+				}
+			}
+		}
+	}
+
+	// handle privileges -> all_privileges change (grant all privileges)
+	if d.HasChange("all_privileges") && allPrivileges.(bool) {
+		err := g.grant(ctx, &sdk.AccountRoleGrantPrivileges{AllPrivileges: sdk.Bool(true)}, grantOn, withGrantOption)
+		if errors.Is(err, sdk.ErrGrantPartiallyExecuted) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "An error occurred when granting all privileges to " + g.kind,
+				Detail:   g.detail(d.Id(), err),
+			})
+		} else if err != nil {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to grant all privileges",
+					Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+				},
+			}
+		}
+	}
+
+	return diags
+}
+
+// updatedAccountRolePrivileges returns the all_privileges and privileges identifier values after updatePrivileges.
+func updatedAccountRolePrivileges(d *schema.ResourceData, allPrivileges bool, privileges []string) (bool, []string) {
+	if d.HasChange("all_privileges") {
+		allPrivileges = d.Get("all_privileges").(bool)
+	}
+	if d.HasChange("privileges") {
+		if d.HasChange("all_privileges") && allPrivileges {
+			privileges = []string{}
+		} else {
+			privileges = expandStringList(d.Get("privileges").(*schema.Set).List())
+		}
+	}
+	return allPrivileges, privileges
+}
+
+// revokePrivilegesFromSchema revokes the configured privileges.
+func (g accountRolePrivilegesGrantee) revokePrivilegesFromSchema(ctx context.Context, d *schema.ResourceData) diag.Diagnostics {
+	grantOn, err := getAccountRoleGrantOn(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := g.revoke(ctx, getAccountRolePrivilegesFromSchema(d), grantOn, false); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when revoking privileges from " + g.kind,
+				Detail:   g.detail(d.Id(), err),
+			},
+		}
+	}
+
+	return nil
 }
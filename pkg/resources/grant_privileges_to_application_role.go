@@ -0,0 +1,513 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var grantPrivilegesToApplicationRoleSchema = map[string]*schema.Schema{
+	"application_role_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the application role to which privileges will be granted (in the form of \"<application_name>\".\"<application_role_name>\").",
+		ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"privileges": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "The privileges to grant to the application role. This field is case-sensitive; use only upper-case privileges.",
+		ExactlyOneOf: []string{
+			"privileges",
+			"all_privileges",
+		},
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: isNotOwnershipGrant(),
+		},
+	},
+	"all_privileges": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Grant all privileges to the application role. When all privileges cannot be granted, the provider returns a warning, which is aligned with the Snowsight behavior.",
+		ExactlyOneOf: []string{
+			"privileges",
+			"all_privileges",
+		},
+	},
+	"with_grant_option": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "Specifies whether the grantee can grant the privileges to other users.",
+	},
+	"always_apply": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "If true, the resource will always produce a “plan” and on “apply” it will re-grant defined privileges. It is supposed to be used only in “grant privileges on all X’s in database / schema Y” or “grant all privileges to X” scenarios to make sure that every new object in a given database / schema is granted by the application role and every new privilege is granted to the application role. Important note: this flag is not compliant with the Terraform assumptions of the config being eventually convergent (producing an empty plan).",
+	},
+	"always_apply_trigger": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "",
+		Description: "This is a helper field and should not be set. Its main purpose is to help to achieve the functionality described by the always_apply field.",
+	},
+	"on_account": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "If true, the privileges will be granted on the account.",
+		ExactlyOneOf: []string{
+			"on_account",
+			"on_account_object",
+			"on_schema",
+			"on_schema_object",
+		},
+	},
+	"on_account_object": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the account object on which privileges will be granted ",
+		MaxItems:    1,
+		ExactlyOneOf: []string{
+			"on_account",
+			"on_account_object",
+			"on_schema",
+			"on_schema_object",
+		},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_type": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "The object type of the account object on which privileges will be granted. Valid values are: USER | RESOURCE MONITOR | WAREHOUSE | COMPUTE POOL | DATABASE | INTEGRATION | FAILOVER GROUP | REPLICATION GROUP | EXTERNAL VOLUME",
+					ValidateFunc: validation.StringInSlice([]string{
+						"USER",
+						"RESOURCE MONITOR",
+						"WAREHOUSE",
+						"COMPUTE POOL",
+						"DATABASE",
+						"INTEGRATION",
+						"FAILOVER GROUP",
+						"REPLICATION GROUP",
+						"EXTERNAL VOLUME",
+					}, true),
+				},
+				"object_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Description:      "The fully qualified name of the object on which privileges will be granted.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+				},
+			},
+		},
+	},
+	"on_schema": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the schema on which privileges will be granted.",
+		MaxItems:    1,
+		ExactlyOneOf: []string{
+			"on_account",
+			"on_account_object",
+			"on_schema",
+			"on_schema_object",
+		},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"schema_name": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					Description:      "The fully qualified name of the schema.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ExactlyOneOf: []string{
+						"on_schema.0.schema_name",
+						"on_schema.0.all_schemas_in_database",
+					},
+				},
+				"all_schemas_in_database": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					Description:      "The fully qualified name of the database.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ExactlyOneOf: []string{
+						"on_schema.0.schema_name",
+						"on_schema.0.all_schemas_in_database",
+					},
+				},
+			},
+		},
+	},
+	"on_schema_object": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the schema object on which privileges will be granted.",
+		MaxItems:    1,
+		ExactlyOneOf: []string{
+			"on_account",
+			"on_account_object",
+			"on_schema",
+			"on_schema_object",
+		},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_type": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: fmt.Sprintf("The object type of the schema object on which privileges will be granted. Valid values are: %s", strings.Join(sdk.ValidGrantToObjectTypesString, " | ")),
+					RequiredWith: []string{
+						"on_schema_object.0.object_name",
+					},
+					ConflictsWith: []string{
+						"on_schema_object.0.all",
+					},
+					ValidateDiagFunc: StringInSlice(sdk.ValidGrantToObjectTypesString, true),
+				},
+				"object_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "The fully qualified name of the object on which privileges will be granted.",
+					RequiredWith: []string{
+						"on_schema_object.0.object_type",
+					},
+					ExactlyOneOf: []string{
+						"on_schema_object.0.object_name",
+						"on_schema_object.0.all",
+					},
+					DiffSuppressFunc: suppressIdentifierQuoting,
+				},
+				"all": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Configures the privilege to be granted on all objects in either a database or schema.",
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: getGrantPrivilegesOnAccountRoleBulkOperationSchema(sdk.ValidGrantToPluralObjectTypesString),
+					},
+					ConflictsWith: []string{
+						"on_schema_object.0.object_type",
+					},
+					ExactlyOneOf: []string{
+						"on_schema_object.0.object_name",
+						"on_schema_object.0.all",
+					},
+				},
+			},
+		},
+	},
+}
+
+func GrantPrivilegesToApplicationRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.GrantPrivilegesToApplicationRoleResource), TrackingCreateWrapper(resources.GrantPrivilegesToApplicationRole, CreateGrantPrivilegesToApplicationRole)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.GrantPrivilegesToApplicationRoleResource), TrackingReadWrapper(resources.GrantPrivilegesToApplicationRole, ReadGrantPrivilegesToApplicationRole)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.GrantPrivilegesToApplicationRoleResource), TrackingUpdateWrapper(resources.GrantPrivilegesToApplicationRole, UpdateGrantPrivilegesToApplicationRole)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.GrantPrivilegesToApplicationRoleResource), TrackingDeleteWrapper(resources.GrantPrivilegesToApplicationRole, DeleteGrantPrivilegesToApplicationRole)),
+		Description:   "Resource used to manage privileges granted to an application role. Future grants are not supported for application roles.",
+
+		Schema: grantPrivilegesToApplicationRoleSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.GrantPrivilegesToApplicationRole, ImportGrantPrivilegesToApplicationRole),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportGrantPrivilegesToApplicationRole(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	id, err := ParseGrantPrivilegesToApplicationRoleId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("application_role_name", id.ApplicationRoleName.FullyQualifiedName()); err != nil {
+		return nil, err
+	}
+	if err := d.Set("with_grant_option", id.WithGrantOption); err != nil {
+		return nil, err
+	}
+	if err := d.Set("always_apply", id.AlwaysApply); err != nil {
+		return nil, err
+	}
+	if err := d.Set("all_privileges", id.AllPrivileges); err != nil {
+		return nil, err
+	}
+	if err := d.Set("privileges", id.Privileges); err != nil {
+		return nil, err
+	}
+	if err := setAccountRoleGrantOn(d, id.Kind, id.Data); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateGrantPrivilegesToApplicationRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := createGrantPrivilegesToApplicationRoleIdFromSchema(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := applicationRoleGrantee(client, id.ApplicationRoleName).grantPrivilegesFromSchema(ctx, d, id.String(), id.WithGrantOption, "")
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(id.String())
+
+	return append(diags, ReadGrantPrivilegesToApplicationRole(ctx, d, meta)...)
+}
+
+func UpdateGrantPrivilegesToApplicationRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := ParseGrantPrivilegesToApplicationRoleId(d.Id())
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to parse internal identifier",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+			},
+		}
+	}
+
+	grantee := applicationRoleGrantee(client, id.ApplicationRoleName)
+	diags := grantee.updatePrivileges(ctx, d, id.Kind, id.WithGrantOption)
+	if diags.HasError() {
+		return diags
+	}
+	id.AllPrivileges, id.Privileges = updatedAccountRolePrivileges(d, id.AllPrivileges, id.Privileges)
+
+	if d.HasChange("always_apply") {
+		id.AlwaysApply = d.Get("always_apply").(bool)
+	}
+
+	if id.AlwaysApply {
+		diags = append(diags, grantee.grantPrivilegesFromSchema(ctx, d, id.String(), id.WithGrantOption, "Always apply. ")...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	d.SetId(id.String())
+
+	return append(diags, ReadGrantPrivilegesToApplicationRole(ctx, d, meta)...)
+}
+
+func DeleteGrantPrivilegesToApplicationRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := ParseGrantPrivilegesToApplicationRoleId(d.Id())
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to parse internal identifier",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+			},
+		}
+	}
+
+	if diags := applicationRoleGrantee(client, id.ApplicationRoleName).revokePrivilegesFromSchema(ctx, d); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func ReadGrantPrivilegesToApplicationRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	id, err := ParseGrantPrivilegesToApplicationRoleId(d.Id())
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to parse internal identifier",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+			},
+		}
+	}
+
+	if id.AlwaysApply {
+		// See ReadGrantPrivilegesToAccountRole for the explanation of the trigger.
+		triggerId, err := uuid.GenerateUUID()
+		if err != nil {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to generate UUID",
+					Detail:   fmt.Sprintf("Original error: %s", err),
+				},
+			}
+		}
+
+		// Change the value of always_apply_trigger to produce a plan
+		if err := d.Set("always_apply_trigger", triggerId); err != nil {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Error setting always_apply_trigger for application role",
+					Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+				},
+			}
+		}
+	}
+
+	if id.AllPrivileges {
+		log.Printf("[INFO] Show with all_privileges option is skipped. No changes in privileges in Snowflake will be detected. Consider specifying all privileges in 'privileges' block.")
+		return nil
+	}
+
+	opts, grantedOn := prepareShowGrantsRequestForAccountRole(id.Kind, id.Data)
+	onAll := onAllSchemaObjectsGrantData(id.Data)
+	if opts == nil && onAll == nil {
+		return nil
+	}
+
+	client := meta.(*provider.Context).Client
+
+	if _, err := client.ApplicationRoles.ShowByID(ctx, id.ApplicationRoleName); err != nil && errors.Is(err, sdk.ErrObjectNotFound) {
+		d.SetId("")
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Failed to retrieve application role. Marking the resource as removed.",
+				Detail:   fmt.Sprintf("Id: %s", d.Id()),
+			},
+		}
+	}
+
+	if onAll != nil {
+		return readGrantPrivilegesOnAllSchemaObjects(ctx, d, client, onAll, &sdk.ShowGrantsTo{ApplicationRole: id.ApplicationRoleName}, id.Privileges, id.WithGrantOption)
+	}
+
+	grants, err := client.Grants.Show(ctx, opts)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve grants. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to retrieve grants",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+			},
+		}
+	}
+
+	actualPrivileges := make([]string, 0)
+	for _, grant := range grants {
+		// Only consider privileges that are already present in the ID, so we
+		// don't delete privileges managed by other resources.
+		if !slices.Contains(id.Privileges, grant.Privilege) || !isGrantedToApplicationRole(grant, id.ApplicationRoleName, id.WithGrantOption) {
+			continue
+		}
+		// Applications are treated as databases, see ReadGrantPrivilegesToAccountRole.
+		if grantedOn == sdk.ObjectTypeDatabase && grant.GrantedOn == sdk.ObjectTypeApplication {
+			actualPrivileges = append(actualPrivileges, grant.Privilege)
+		} else if grantedOn == grant.GrantedOn {
+			actualPrivileges = append(actualPrivileges, grant.Privilege)
+		}
+	}
+
+	if err := d.Set("privileges", actualPrivileges); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Error setting privileges for application role",
+				Detail:   fmt.Sprintf("Id: %s\nPrivileges: %v\nError: %s", d.Id(), actualPrivileges, err),
+			},
+		}
+	}
+
+	return nil
+}
+
+// isGrantedToApplicationRole compares the full application role identifier, so that the application roles with the same name
+// in other applications are not matched.
+func isGrantedToApplicationRole(grant sdk.Grant, applicationRole sdk.DatabaseObjectIdentifier, withGrantOption bool) bool {
+	granteeName, ok := grant.GranteeName.(sdk.DatabaseObjectIdentifier)
+	return ok &&
+		grant.GrantedTo == sdk.ObjectTypeApplicationRole &&
+		grant.GrantOption == withGrantOption &&
+		granteeName.FullyQualifiedName() == applicationRole.FullyQualifiedName()
+}
+
+func applicationRoleGrantee(client *sdk.Client, applicationRole sdk.DatabaseObjectIdentifier) accountRolePrivilegesGrantee {
+	return accountRolePrivilegesGrantee{
+		kind: "application role",
+		name: applicationRole,
+		grant: func(ctx context.Context, privileges *sdk.AccountRoleGrantPrivileges, on *sdk.AccountRoleGrantOn, withGrantOption bool) error {
+			return client.Grants.GrantPrivilegesToApplicationRole(ctx, privileges, on, applicationRole, &sdk.GrantPrivilegesToApplicationRoleOptions{WithGrantOption: sdk.Bool(withGrantOption)})
+		},
+		revoke: func(ctx context.Context, privileges *sdk.AccountRoleGrantPrivileges, on *sdk.AccountRoleGrantOn, grantOptionFor bool) error {
+			opts := new(sdk.RevokePrivilegesFromApplicationRoleOptions)
+			if grantOptionFor {
+				opts.GrantOptionFor = sdk.Bool(true)
+			}
+			return client.Grants.RevokePrivilegesFromApplicationRole(ctx, privileges, on, applicationRole, opts)
+		},
+	}
+}
+
+func createGrantPrivilegesToApplicationRoleIdFromSchema(d *schema.ResourceData) (id *GrantPrivilegesToApplicationRoleId, err error) {
+	id = new(GrantPrivilegesToApplicationRoleId)
+	id.ApplicationRoleName, err = sdk.ParseDatabaseObjectIdentifier(d.Get("application_role_name").(string))
+	if err != nil {
+		return nil, err
+	}
+	id.AllPrivileges = d.Get("all_privileges").(bool)
+	if p, ok := d.GetOk("privileges"); ok {
+		id.Privileges = expandStringList(p.(*schema.Set).List())
+	}
+	id.WithGrantOption = d.Get("with_grant_option").(bool)
+	id.AlwaysApply = d.Get("always_apply").(bool)
+
+	on, err := getAccountRoleGrantOn(d)
+	if err != nil {
+		return nil, err
+	}
+	id.Kind, id.Data = getAccountRoleGrantKindAndData(on)
+
+	return id, nil
+}
@@ -0,0 +1,181 @@
+package resources
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+// GrantPrivilegesToApplicationRoleId shares the grant kinds with GrantPrivilegesToAccountRoleId; future grants are not supported for application roles.
+type GrantPrivilegesToApplicationRoleId struct {
+	ApplicationRoleName sdk.DatabaseObjectIdentifier
+	WithGrantOption     bool
+	AlwaysApply         bool
+	AllPrivileges       bool
+	Privileges          []string
+	Kind                AccountRoleGrantKind
+	Data                fmt.Stringer
+}
+
+func (g *GrantPrivilegesToApplicationRoleId) String() string {
+	var parts []string
+	parts = append(parts, g.ApplicationRoleName.FullyQualifiedName())
+	parts = append(parts, strconv.FormatBool(g.WithGrantOption))
+	parts = append(parts, strconv.FormatBool(g.AlwaysApply))
+	if g.AllPrivileges {
+		parts = append(parts, "ALL")
+	} else {
+		parts = append(parts, strings.Join(g.Privileges, ","))
+	}
+	parts = append(parts, string(g.Kind))
+	data := g.Data.String()
+	if len(data) > 0 {
+		parts = append(parts, data)
+	}
+	return helpers.EncodeResourceIdentifier(parts...)
+}
+
+func ParseGrantPrivilegesToApplicationRoleId(id string) (GrantPrivilegesToApplicationRoleId, error) {
+	var applicationRoleId GrantPrivilegesToApplicationRoleId
+
+	parts := helpers.ParseResourceIdentifier(id)
+	if len(parts) < 5 {
+		return applicationRoleId, sdk.NewError(`application role identifier should hold at least 5 parts "<application_role_name>|<with_grant_option>|<always_apply>|<privileges>|<grant_type>"`)
+	}
+
+	roleId, err := sdk.ParseDatabaseObjectIdentifier(parts[0])
+	if err != nil {
+		return applicationRoleId, err
+	}
+	applicationRoleId.ApplicationRoleName = roleId
+
+	if parts[1] != "false" && parts[1] != "true" {
+		return applicationRoleId, sdk.NewError(fmt.Sprintf(`invalid WithGrantOption value: %s, should be either "true" or "false"`, parts[1]))
+	}
+	applicationRoleId.WithGrantOption = parts[1] == "true"
+
+	if parts[2] != "false" && parts[2] != "true" {
+		return applicationRoleId, sdk.NewError(fmt.Sprintf(`invalid AlwaysApply value: %s, should be either "true" or "false"`, parts[2]))
+	}
+	applicationRoleId.AlwaysApply = parts[2] == "true"
+
+	privileges := strings.Split(parts[3], ",")
+	if len(privileges) == 0 || (len(privileges) == 1 && privileges[0] == "") {
+		return applicationRoleId, sdk.NewError(fmt.Sprintf(`invalid Privileges value: %s, should be either a comma separated list of privileges or "ALL" / "ALL PRIVILEGES" for all privileges`, parts[3]))
+	}
+	if len(privileges) == 1 && (privileges[0] == "ALL" || privileges[0] == "ALL PRIVILEGES") {
+		applicationRoleId.AllPrivileges = true
+	} else {
+		applicationRoleId.Privileges = privileges
+	}
+
+	applicationRoleId.Kind = AccountRoleGrantKind(parts[4])
+	switch applicationRoleId.Kind {
+	case OnAccountAccountRoleGrantKind:
+		applicationRoleId.Data = new(OnAccountGrantData)
+	case OnAccountObjectAccountRoleGrantKind:
+		if len(parts) != 7 {
+			return applicationRoleId, sdk.NewError(`application role identifier should hold at least 7 parts "<application_role_name>|<with_grant_option>|<always_apply>|<privileges>|OnAccountObject|<object_type>|<object_name>"`)
+		}
+		objectId, err := sdk.ParseAccountObjectIdentifier(parts[6])
+		if err != nil {
+			return applicationRoleId, err
+		}
+		applicationRoleId.Data = &OnAccountObjectGrantData{
+			ObjectType: sdk.ObjectType(parts[5]),
+			ObjectName: objectId,
+		}
+	case OnSchemaAccountRoleGrantKind:
+		if len(parts) < 7 {
+			return applicationRoleId, sdk.NewError(`application role identifier should hold at least 7 parts "<application_role_name>|<with_grant_option>|<always_apply>|<privileges>|OnSchema|<grant_on_schema_type>|<on_schema_grant_data>..."`)
+		}
+		onSchemaGrantData := OnSchemaGrantData{
+			Kind: OnSchemaGrantKind(parts[5]),
+		}
+		switch onSchemaGrantData.Kind {
+		case OnSchemaSchemaGrantKind:
+			schemaId, err := sdk.ParseDatabaseObjectIdentifier(parts[6])
+			if err != nil {
+				return applicationRoleId, err
+			}
+			onSchemaGrantData.SchemaName = sdk.Pointer(schemaId)
+		case OnAllSchemasInDatabaseSchemaGrantKind:
+			databaseId, err := sdk.ParseAccountObjectIdentifier(parts[6])
+			if err != nil {
+				return applicationRoleId, err
+			}
+			onSchemaGrantData.DatabaseName = sdk.Pointer(databaseId)
+		default:
+			return applicationRoleId, sdk.NewError(fmt.Sprintf("invalid OnSchemaGrantKind: %s", onSchemaGrantData.Kind))
+		}
+		applicationRoleId.Data = &onSchemaGrantData
+	case OnSchemaObjectAccountRoleGrantKind:
+		if len(parts) < 7 {
+			return applicationRoleId, sdk.NewError(`application role identifier should hold at least 7 parts "<application_role_name>|<with_grant_option>|<always_apply>|<privileges>|OnSchemaObject|<grant_on_schema_object_type>|<on_schema_object_grant_data>..."`)
+		}
+		onSchemaObjectGrantData := OnSchemaObjectGrantData{
+			Kind: OnSchemaObjectGrantKind(parts[5]),
+		}
+		switch onSchemaObjectGrantData.Kind {
+		case OnObjectSchemaObjectGrantKind:
+			if len(parts) != 8 {
+				return applicationRoleId, sdk.NewError(`application role identifier should hold 8 parts "<application_role_name>|<with_grant_option>|<always_apply>|<privileges>|OnSchemaObject|OnObject|<object_type>|<object_name>"`)
+			}
+			objectType := sdk.ObjectType(parts[6])
+			var id sdk.ObjectIdentifier
+			// TODO(SNOW-1569535): use a mapper from object type to parsing function
+			if objectType.IsWithArguments() {
+				id, err = sdk.ParseSchemaObjectIdentifierWithArguments(parts[7])
+				if err != nil {
+					return applicationRoleId, err
+				}
+			} else {
+				id, err = sdk.ParseSchemaObjectIdentifier(parts[7])
+				if err != nil {
+					return applicationRoleId, err
+				}
+			}
+			onSchemaObjectGrantData.Object = &sdk.Object{
+				ObjectType: objectType,
+				Name:       id,
+			}
+		case OnAllSchemaObjectGrantKind:
+			bulkOperationGrantData := &BulkOperationGrantData{
+				ObjectNamePlural: sdk.PluralObjectType(parts[6]),
+			}
+			if len(parts) > 7 {
+				if len(parts) != 9 {
+					return applicationRoleId, sdk.NewError(`application role identifier should hold 9 parts "<application_role_name>|<with_grant_option>|<always_apply>|<privileges>|OnSchemaObject|OnAll|<object_type_plural>|In[Database or Schema]|<identifier>"`)
+				}
+				bulkOperationGrantData.Kind = BulkOperationGrantKind(parts[7])
+				switch bulkOperationGrantData.Kind {
+				case InDatabaseBulkOperationGrantKind:
+					databaseId, err := sdk.ParseAccountObjectIdentifier(parts[8])
+					if err != nil {
+						return applicationRoleId, err
+					}
+					bulkOperationGrantData.Database = sdk.Pointer(databaseId)
+				case InSchemaBulkOperationGrantKind:
+					schemaId, err := sdk.ParseDatabaseObjectIdentifier(parts[8])
+					if err != nil {
+						return applicationRoleId, err
+					}
+					bulkOperationGrantData.Schema = sdk.Pointer(schemaId)
+				default:
+					return applicationRoleId, sdk.NewError(fmt.Sprintf("invalid BulkOperationGrantKind: %s", bulkOperationGrantData.Kind))
+				}
+			}
+			onSchemaObjectGrantData.OnAllOrFuture = bulkOperationGrantData
+		default:
+			return applicationRoleId, sdk.NewError(fmt.Sprintf("invalid OnSchemaObjectGrantKind: %s", onSchemaObjectGrantData.Kind))
+		}
+		applicationRoleId.Data = &onSchemaObjectGrantData
+	default:
+		return applicationRoleId, sdk.NewError(fmt.Sprintf("invalid AccountRoleGrantKind: %s", applicationRoleId.Kind))
+	}
+
+	return applicationRoleId, nil
+}
@@ -0,0 +1,147 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGrantPrivilegesToApplicationRoleId(t *testing.T) {
+	applicationRoleId := sdk.NewDatabaseObjectIdentifier("application-name", "application-role")
+
+	testCases := []struct {
+		Name       string
+		Identifier string
+		Expected   GrantPrivilegesToApplicationRoleId
+		Error      string
+	}{
+		{
+			Name:       "grant application role on account",
+			Identifier: `"application-name"."application-role"|false|false|CREATE DATABASE,CREATE USER|OnAccount`,
+			Expected: GrantPrivilegesToApplicationRoleId{
+				ApplicationRoleName: applicationRoleId,
+				Privileges:          []string{"CREATE DATABASE", "CREATE USER"},
+				Kind:                OnAccountAccountRoleGrantKind,
+				Data:                new(OnAccountGrantData),
+			},
+		},
+		{
+			Name:       "grant application role on account object - all privileges with grant option",
+			Identifier: `"application-name"."application-role"|true|true|ALL|OnAccountObject|DATABASE|"database-name"`,
+			Expected: GrantPrivilegesToApplicationRoleId{
+				ApplicationRoleName: applicationRoleId,
+				WithGrantOption:     true,
+				AlwaysApply:         true,
+				AllPrivileges:       true,
+				Kind:                OnAccountObjectAccountRoleGrantKind,
+				Data: &OnAccountObjectGrantData{
+					ObjectType: sdk.ObjectTypeDatabase,
+					ObjectName: sdk.NewAccountObjectIdentifier("database-name"),
+				},
+			},
+		},
+		{
+			Name:       "grant application role on all schemas in database",
+			Identifier: `"application-name"."application-role"|false|false|USAGE|OnSchema|OnAllSchemasInDatabase|"database-name"`,
+			Expected: GrantPrivilegesToApplicationRoleId{
+				ApplicationRoleName: applicationRoleId,
+				Privileges:          []string{"USAGE"},
+				Kind:                OnSchemaAccountRoleGrantKind,
+				Data: &OnSchemaGrantData{
+					Kind:         OnAllSchemasInDatabaseSchemaGrantKind,
+					DatabaseName: sdk.Pointer(sdk.NewAccountObjectIdentifier("database-name")),
+				},
+			},
+		},
+		{
+			Name:       "grant application role on schema object",
+			Identifier: `"application-name"."application-role"|false|false|SELECT|OnSchemaObject|OnObject|TABLE|"database-name"."schema-name"."table-name"`,
+			Expected: GrantPrivilegesToApplicationRoleId{
+				ApplicationRoleName: applicationRoleId,
+				Privileges:          []string{"SELECT"},
+				Kind:                OnSchemaObjectAccountRoleGrantKind,
+				Data: &OnSchemaObjectGrantData{
+					Kind: OnObjectSchemaObjectGrantKind,
+					Object: &sdk.Object{
+						ObjectType: sdk.ObjectTypeTable,
+						Name:       sdk.NewSchemaObjectIdentifier("database-name", "schema-name", "table-name"),
+					},
+				},
+			},
+		},
+		{
+			Name:       "grant application role on all schema objects in schema",
+			Identifier: `"application-name"."application-role"|false|false|SELECT|OnSchemaObject|OnAll|TABLES|InSchema|"database-name"."schema-name"`,
+			Expected: GrantPrivilegesToApplicationRoleId{
+				ApplicationRoleName: applicationRoleId,
+				Privileges:          []string{"SELECT"},
+				Kind:                OnSchemaObjectAccountRoleGrantKind,
+				Data: &OnSchemaObjectGrantData{
+					Kind: OnAllSchemaObjectGrantKind,
+					OnAllOrFuture: &BulkOperationGrantData{
+						ObjectNamePlural: sdk.PluralObjectTypeTables,
+						Kind:             InSchemaBulkOperationGrantKind,
+						Schema:           sdk.Pointer(sdk.NewDatabaseObjectIdentifier("database-name", "schema-name")),
+					},
+				},
+			},
+		},
+		{
+			Name:       "validation: application role name without application",
+			Identifier: `"application-role"|false|false|ALL|OnAccount`,
+			Error:      `unexpected number of parts 1 in identifier "application-role", expected 2`,
+		},
+		{
+			Name:       "validation: application role not enough parts",
+			Identifier: `"application-name"."application-role"|false|false|ALL`,
+			Error:      "application role identifier should hold at least 5 parts",
+		},
+		{
+			Name:       "validation: application role future schemas",
+			Identifier: `"application-name"."application-role"|false|false|USAGE|OnSchema|OnFutureSchemasInDatabase|"database-name"`,
+			Error:      "invalid OnSchemaGrantKind: OnFutureSchemasInDatabase",
+		},
+		{
+			Name:       "validation: application role future schema objects",
+			Identifier: `"application-name"."application-role"|false|false|SELECT|OnSchemaObject|OnFuture|TABLES|InSchema|"database-name"."schema-name"`,
+			Error:      "invalid OnSchemaObjectGrantKind: OnFuture",
+		},
+		{
+			Name:       "validation: application role invalid kind",
+			Identifier: `"application-name"."application-role"|false|false|ALL|some-kind`,
+			Error:      "invalid AccountRoleGrantKind: some-kind",
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			id, err := ParseGrantPrivilegesToApplicationRoleId(tt.Identifier)
+			if tt.Error == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Expected, id)
+			} else {
+				assert.ErrorContains(t, err, tt.Error)
+			}
+		})
+	}
+}
+
+func TestGrantPrivilegesToApplicationRoleIdString(t *testing.T) {
+	id := GrantPrivilegesToApplicationRoleId{
+		ApplicationRoleName: sdk.NewDatabaseObjectIdentifier("application-name", "application-role"),
+		Privileges:          []string{"SELECT", "INSERT"},
+		Kind:                OnSchemaObjectAccountRoleGrantKind,
+		Data: &OnSchemaObjectGrantData{
+			Kind: OnAllSchemaObjectGrantKind,
+			OnAllOrFuture: &BulkOperationGrantData{
+				ObjectNamePlural: sdk.PluralObjectTypeTables,
+				Kind:             InDatabaseBulkOperationGrantKind,
+				Database:         sdk.Pointer(sdk.NewAccountObjectIdentifier("database-name")),
+			},
+		},
+	}
+
+	assert.Equal(t, `"application-name"."application-role"|false|false|SELECT,INSERT|OnSchemaObject|OnAll|TABLES|InDatabase|"database-name"`, id.String())
+}
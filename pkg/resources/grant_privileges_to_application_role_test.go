@@ -0,0 +1,54 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsGrantedToApplicationRole(t *testing.T) {
+	applicationRole := sdk.NewDatabaseObjectIdentifier("APP", "role")
+
+	testCases := []struct {
+		Name            string
+		Grant           sdk.Grant
+		WithGrantOption bool
+		Expected        bool
+	}{
+		{
+			Name:     "matching application role",
+			Grant:    sdk.Grant{GrantedTo: sdk.ObjectTypeApplicationRole, GranteeName: sdk.NewDatabaseObjectIdentifier("APP", "role")},
+			Expected: true,
+		},
+		{
+			Name:            "matching application role with grant option",
+			Grant:           sdk.Grant{GrantedTo: sdk.ObjectTypeApplicationRole, GranteeName: sdk.NewDatabaseObjectIdentifier("APP", "role"), GrantOption: true},
+			WithGrantOption: true,
+			Expected:        true,
+		},
+		{
+			Name:  "application role with the same name in another application",
+			Grant: sdk.Grant{GrantedTo: sdk.ObjectTypeApplicationRole, GranteeName: sdk.NewDatabaseObjectIdentifier("OTHER_APP", "role")},
+		},
+		{
+			Name:  "unqualified grantee name",
+			Grant: sdk.Grant{GrantedTo: sdk.ObjectTypeApplicationRole, GranteeName: sdk.NewAccountObjectIdentifier("role")},
+		},
+		{
+			Name:  "account role",
+			Grant: sdk.Grant{GrantedTo: sdk.ObjectTypeRole, GranteeName: sdk.NewDatabaseObjectIdentifier("APP", "role")},
+		},
+		{
+			Name:            "different grant option",
+			Grant:           sdk.Grant{GrantedTo: sdk.ObjectTypeApplicationRole, GranteeName: sdk.NewDatabaseObjectIdentifier("APP", "role")},
+			WithGrantOption: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, isGrantedToApplicationRole(tt.Grant, applicationRole, tt.WithGrantOption))
+		})
+	}
+}
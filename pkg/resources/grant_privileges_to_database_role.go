@@ -71,6 +71,12 @@ var grantPrivilegesToDatabaseRoleSchema = map[string]*schema.Schema{
 		Default:     "",
 		Description: "This is a helper field and should not be set. Its main purpose is to help to achieve the functionality described by the always_apply field.",
 	},
+	"shadowed_in_schemas": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The schemas of the database in which the `on_schema_object.future` grant in the database is ignored, because they have their own schema-level future grants on the same object type that take precedence. Empty for the other grants.",
+	},
 	"on_database": {
 		Type:             schema.TypeString,
 		Optional:         true,
@@ -251,18 +257,19 @@ func getGrantPrivilegesOnDatabaseRoleBulkOperationSchema(validGrantToObjectTypes
 }
 
 func GrantPrivilegesToDatabaseRole() *schema.Resource {
-	return &schema.Resource{
+	return withResourceIdentity(grantPrivilegesToDatabaseRoleIdentity, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.GrantPrivilegesToDatabaseRole, CreateGrantPrivilegesToDatabaseRole),
 		UpdateContext: TrackingUpdateWrapper(resources.GrantPrivilegesToDatabaseRole, UpdateGrantPrivilegesToDatabaseRole),
 		DeleteContext: TrackingDeleteWrapper(resources.GrantPrivilegesToDatabaseRole, DeleteGrantPrivilegesToDatabaseRole),
 		ReadContext:   TrackingReadWrapper(resources.GrantPrivilegesToDatabaseRole, ReadGrantPrivilegesToDatabaseRole),
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.GrantPrivilegesToDatabaseRole, shadowedFutureGrantsCustomDiff),
 
 		Schema: grantPrivilegesToDatabaseRoleSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.GrantPrivilegesToDatabaseRole, ImportGrantPrivilegesToDatabaseRole),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportGrantPrivilegesToDatabaseRole(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -687,7 +694,8 @@ func ReadGrantPrivilegesToDatabaseRole(ctx context.Context, d *schema.ResourceDa
 	}
 
 	opts, grantedOn := prepareShowGrantsRequest(id)
-	if opts == nil {
+	onAll := onAllSchemaObjectsGrantData(id.Data)
+	if opts == nil && onAll == nil {
 		return nil
 	}
 
@@ -703,6 +711,10 @@ func ReadGrantPrivilegesToDatabaseRole(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
+	if onAll != nil {
+		return readGrantPrivilegesOnAllSchemaObjects(ctx, d, client, onAll, &sdk.ShowGrantsTo{DatabaseRole: id.DatabaseRoleName}, id.Privileges, id.WithGrantOption)
+	}
+
 	grants, err := client.Grants.Show(ctx, opts)
 	if err != nil {
 		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
@@ -760,7 +772,7 @@ func ReadGrantPrivilegesToDatabaseRole(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
-	return nil
+	return setShadowedInSchemas(ctx, client, d, id.Data)
 }
 
 func prepareShowGrantsRequest(id GrantPrivilegesToDatabaseRoleId) (*sdk.ShowGrantOptions, sdk.ObjectType) {
@@ -808,7 +820,10 @@ func prepareShowGrantsRequest(id GrantPrivilegesToDatabaseRoleId) (*sdk.ShowGran
 				Object: data.Object,
 			}
 		case OnAllSchemaObjectGrantKind:
-			log.Printf("[INFO] Show with on_schema_object.on_all option is skipped. No changes in privileges in Snowflake will be detected.")
+			// the grants on all the objects are read with a single SHOW GRANTS TO (see readGrantPrivilegesOnAllSchemaObjects)
+			if data.OnAllOrFuture.ObjectNamePlural.Singular().IsWithArguments() {
+				log.Printf("[INFO] Show with on_schema_object.on_all option is skipped for %s. No changes in privileges in Snowflake will be detected.", data.OnAllOrFuture.ObjectNamePlural)
+			}
 			return nil, ""
 		case OnFutureSchemaObjectGrantKind:
 			grantedOn = data.OnAllOrFuture.ObjectNamePlural.Singular()
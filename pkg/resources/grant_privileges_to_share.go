@@ -106,7 +106,7 @@ var grantPrivilegesToShareSchema = map[string]*schema.Schema{
 }
 
 func GrantPrivilegesToShare() *schema.Resource {
-	return &schema.Resource{
+	return withResourceIdentity(grantPrivilegesToShareIdentity, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.GrantPrivilegesToShare, CreateGrantPrivilegesToShare),
 		UpdateContext: TrackingUpdateWrapper(resources.GrantPrivilegesToShare, UpdateGrantPrivilegesToShare),
 		DeleteContext: TrackingDeleteWrapper(resources.GrantPrivilegesToShare, DeleteGrantPrivilegesToShare),
@@ -117,7 +117,7 @@ func GrantPrivilegesToShare() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.GrantPrivilegesToShare, ImportGrantPrivilegesToShare()),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportGrantPrivilegesToShare() func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
@@ -7,6 +7,7 @@ import (
 	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
@@ -124,22 +125,23 @@ func MaskingPolicy() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return withResourceIdentity(objectIdentity[sdk.SchemaObjectIdentifier](), &schema.Resource{
 		SchemaVersion: 2,
 
-		CreateContext: TrackingCreateWrapper(resources.MaskingPolicy, CreateMaskingPolicy),
-		ReadContext:   TrackingReadWrapper(resources.MaskingPolicy, ReadMaskingPolicy(true)),
-		UpdateContext: TrackingUpdateWrapper(resources.MaskingPolicy, UpdateMaskingPolicy),
+		CreateContext: TrackingCreateWrapper(resources.MaskingPolicy, OwnerRoleWrapper(sdk.ObjectTypeMaskingPolicy, sdk.ParseSchemaObjectIdentifier, ReadMaskingPolicy(false), CreateMaskingPolicy)),
+		ReadContext:   TrackingReadWrapper(resources.MaskingPolicy, OwnerRoleReadWrapper(ReadMaskingPolicy(true))),
+		UpdateContext: TrackingUpdateWrapper(resources.MaskingPolicy, OwnerRoleWrapper(sdk.ObjectTypeMaskingPolicy, sdk.ParseSchemaObjectIdentifier, ReadMaskingPolicy(false), UpdateMaskingPolicy)),
 		DeleteContext: TrackingDeleteWrapper(resources.MaskingPolicy, deleteFunc),
 		Description:   "Resource used to manage masking policies. For more information, check [masking policies documentation](https://docs.snowflake.com/en/sql-reference/sql/create-masking-policy).",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.MaskingPolicy, customdiff.All(
 			ComputedIfAnyAttributeChanged(maskingPolicySchema, ShowOutputAttributeName, "name", "comment"),
+			ownerRoleCustomDiff,
 			ComputedIfAnyAttributeChanged(maskingPolicySchema, DescribeOutputAttributeName, "name", "body"),
 			ComputedIfAnyAttributeChanged(maskingPolicySchema, FullyQualifiedNameAttributeName, "name"),
 		)),
 
-		Schema: maskingPolicySchema,
+		Schema: collections.MergeMaps(maskingPolicySchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.MaskingPolicy, ImportMaskingPolicy),
 		},
@@ -159,7 +161,7 @@ func MaskingPolicy() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportMaskingPolicy(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
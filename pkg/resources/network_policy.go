@@ -92,12 +92,12 @@ func NetworkPolicy() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
-		Schema: networkPolicySchema,
+	return withResourceIdentity(objectIdentity[sdk.AccountObjectIdentifier](), &schema.Resource{
+		Schema: collections.MergeMaps(networkPolicySchema, ownerRoleSchema),
 
-		CreateContext: TrackingCreateWrapper(resources.NetworkPolicy, CreateContextNetworkPolicy),
-		ReadContext:   TrackingReadWrapper(resources.NetworkPolicy, ReadContextNetworkPolicy),
-		UpdateContext: TrackingUpdateWrapper(resources.NetworkPolicy, UpdateContextNetworkPolicy),
+		CreateContext: TrackingCreateWrapper(resources.NetworkPolicy, OwnerRoleWrapper(sdk.ObjectTypeNetworkPolicy, sdk.ParseAccountObjectIdentifier, ReadContextNetworkPolicy, CreateContextNetworkPolicy)),
+		ReadContext:   TrackingReadWrapper(resources.NetworkPolicy, OwnerRoleFromGrantsReadWrapper(sdk.ObjectTypeNetworkPolicy, sdk.ParseAccountObjectIdentifier, ReadContextNetworkPolicy)),
+		UpdateContext: TrackingUpdateWrapper(resources.NetworkPolicy, OwnerRoleWrapper(sdk.ObjectTypeNetworkPolicy, sdk.ParseAccountObjectIdentifier, ReadContextNetworkPolicy, UpdateContextNetworkPolicy)),
 		DeleteContext: TrackingDeleteWrapper(resources.NetworkPolicy, deleteFunc),
 		Description:   "Resource used to control network traffic. For more information, check an [official guide](https://docs.snowflake.com/en/user-guide/network-policies) on controlling network traffic with network policies.",
 
@@ -131,7 +131,7 @@ func NetworkPolicy() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.NetworkPolicy, ImportName[sdk.AccountObjectIdentifier]),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateContextNetworkPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -164,12 +164,12 @@ var oauthIntegrationForCustomClientsSchema = map[string]*schema.Schema{
 }
 
 func OauthIntegrationForCustomClients() *schema.Resource {
-	return &schema.Resource{
-		Schema: oauthIntegrationForCustomClientsSchema,
+	return withResourceIdentity(objectIdentity[sdk.AccountObjectIdentifier](), &schema.Resource{
+		Schema: collections.MergeMaps(oauthIntegrationForCustomClientsSchema, ownerRoleSchema),
 
-		CreateContext: TrackingCreateWrapper(resources.OauthIntegrationForCustomClients, CreateContextOauthIntegrationForCustomClients),
-		ReadContext:   TrackingReadWrapper(resources.OauthIntegrationForCustomClients, ReadContextOauthIntegrationForCustomClients(true)),
-		UpdateContext: TrackingUpdateWrapper(resources.OauthIntegrationForCustomClients, UpdateContextOauthIntegrationForCustomClients),
+		CreateContext: TrackingCreateWrapper(resources.OauthIntegrationForCustomClients, OwnerRoleWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextOauthIntegrationForCustomClients(false), CreateContextOauthIntegrationForCustomClients)),
+		ReadContext:   TrackingReadWrapper(resources.OauthIntegrationForCustomClients, OwnerRoleFromGrantsReadWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextOauthIntegrationForCustomClients(true))),
+		UpdateContext: TrackingUpdateWrapper(resources.OauthIntegrationForCustomClients, OwnerRoleWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextOauthIntegrationForCustomClients(false), UpdateContextOauthIntegrationForCustomClients)),
 		DeleteContext: TrackingDeleteWrapper(resources.OauthIntegrationForCustomClients, DeleteSecurityIntegration),
 		Description:   "Resource used to manage oauth security integration for custom clients objects. For more information, check [security integrations documentation](https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-oauth-snowflake).",
 
@@ -203,7 +203,7 @@ func OauthIntegrationForCustomClients() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.OauthIntegrationForCustomClients, ImportOauthForCustomClientsIntegration),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportOauthForCustomClientsIntegration(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -121,12 +121,12 @@ var oauthIntegrationForPartnerApplicationsSchema = map[string]*schema.Schema{
 }
 
 func OauthIntegrationForPartnerApplications() *schema.Resource {
-	return &schema.Resource{
-		Schema: oauthIntegrationForPartnerApplicationsSchema,
+	return withResourceIdentity(objectIdentity[sdk.AccountObjectIdentifier](), &schema.Resource{
+		Schema: collections.MergeMaps(oauthIntegrationForPartnerApplicationsSchema, ownerRoleSchema),
 
-		CreateContext: TrackingCreateWrapper(resources.OauthIntegrationForPartnerApplications, CreateContextOauthIntegrationForPartnerApplications),
-		ReadContext:   TrackingReadWrapper(resources.OauthIntegrationForPartnerApplications, ReadContextOauthIntegrationForPartnerApplications(true)),
-		UpdateContext: TrackingUpdateWrapper(resources.OauthIntegrationForPartnerApplications, UpdateContextOauthIntegrationForPartnerApplications),
+		CreateContext: TrackingCreateWrapper(resources.OauthIntegrationForPartnerApplications, OwnerRoleWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextOauthIntegrationForPartnerApplications(false), CreateContextOauthIntegrationForPartnerApplications)),
+		ReadContext:   TrackingReadWrapper(resources.OauthIntegrationForPartnerApplications, OwnerRoleFromGrantsReadWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextOauthIntegrationForPartnerApplications(true))),
+		UpdateContext: TrackingUpdateWrapper(resources.OauthIntegrationForPartnerApplications, OwnerRoleWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextOauthIntegrationForPartnerApplications(false), UpdateContextOauthIntegrationForPartnerApplications)),
 		DeleteContext: TrackingDeleteWrapper(resources.OauthIntegrationForPartnerApplications, DeleteSecurityIntegration),
 		Description:   "Resource used to manage oauth security integration for partner applications objects. For more information, check [security integrations documentation](https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-oauth-snowflake).",
 
@@ -154,7 +154,7 @@ func OauthIntegrationForPartnerApplications() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.OauthIntegrationForPartnerApplications, ImportOauthForPartnerApplicationIntegration),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportOauthForPartnerApplicationIntegration(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -0,0 +1,152 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ownerRoleSchema is added to the resources which ownership can be transferred to another account role, so that a separate snowflake_grant_ownership resource is not needed.
+var ownerRoleSchema = map[string]*schema.Schema{
+	"owner_role": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("Specifies the account role to which the ownership of the object is transferred after it is created or when this field changes (`GRANT OWNERSHIP ... COPY CURRENT GRANTS`); the privileges already granted on the object are kept. The external changes of the owner are detected based on the owner returned by `SHOW` (or by `SHOW GRANTS ON` for the objects that do not return it in `SHOW`). Removing this field does not transfer the ownership back. Keep in mind that the role used by the provider has to be able to manage the object after the transfer (e.g. the owner role has to be granted to it); otherwise, the subsequent operations on the object fail.", resources.AccountRole),
+	},
+}
+
+// ownerRoleCustomDiff marks show_output as computed when the owner changes. It has to be used in the resources that include ownerRoleSchema and show_output.
+var ownerRoleCustomDiff = ComputedIfAnyAttributeChanged(ownerRoleSchema, ShowOutputAttributeName, "owner_role")
+
+// OwnerRoleWrapper transfers the ownership of the object to owner_role after the given create or update operation succeeds and reads the object again.
+// The resource has to include ownerRoleSchema.
+func OwnerRoleWrapper[ID sdk.ObjectIdentifier, T ~func(context.Context, *schema.ResourceData, any) diag.Diagnostics](objectType sdk.ObjectType, parseId func(string) (ID, error), read schema.ReadContextFunc, operation T) T {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		diags := operation(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		ownerRole := d.Get("owner_role").(string)
+		if !d.HasChange("owner_role") || ownerRole == "" {
+			return diags
+		}
+
+		id, err := parseId(d.Id())
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		ownerRoleId, err := sdk.ParseAccountObjectIdentifier(ownerRole)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		client := meta.(*provider.Context).Client
+		if err := client.Grants.GrantOwnership(ctx,
+			sdk.OwnershipGrantOn{
+				Object: &sdk.Object{
+					ObjectType: objectType,
+					Name:       id,
+				},
+			},
+			sdk.OwnershipGrantTo{
+				AccountRoleName: sdk.Pointer(ownerRoleId),
+			},
+			&sdk.GrantOwnershipOptions{
+				CurrentGrants: &sdk.OwnershipCurrentGrants{
+					OutboundPrivileges: sdk.Copy,
+				},
+			},
+		); err != nil {
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to transfer the ownership.",
+				Detail:   fmt.Sprintf("%s %s, owner role: %s, err: %s", objectType, id.FullyQualifiedName(), ownerRoleId.FullyQualifiedName(), err),
+			})
+		}
+
+		return append(diags, read(ctx, d, meta)...)
+	}
+}
+
+// OwnerRoleReadWrapper sets owner_role to the current owner from show_output after the given read operation, so that the external changes of the owner are detected.
+// Nothing is set when owner_role is not used in the configuration.
+func OwnerRoleReadWrapper(read schema.ReadContextFunc) schema.ReadContextFunc {
+	return ownerRoleReadWrapper(ownerFromShowOutput, read)
+}
+
+// OwnerRoleFromGrantsReadWrapper works like OwnerRoleReadWrapper for the resources without show_output.owner (e.g. databases, tables, network policies, and integrations);
+// the current owner is read from the OWNERSHIP privilege returned by SHOW GRANTS ON, only when owner_role is used in the configuration.
+func OwnerRoleFromGrantsReadWrapper[ID sdk.ObjectIdentifier](objectType sdk.ObjectType, parseId func(string) (ID, error), read schema.ReadContextFunc) schema.ReadContextFunc {
+	return ownerRoleReadWrapper(ownerFromGrants(objectType, parseId), read)
+}
+
+// ownerFunc returns the name of the role owning the object managed by the resource, or an empty string when it is not known.
+type ownerFunc func(ctx context.Context, d *schema.ResourceData, meta any) (string, error)
+
+func ownerFromShowOutput(_ context.Context, d *schema.ResourceData, _ any) (string, error) {
+	return d.Get(ShowOutputAttributeName + ".0.owner").(string), nil
+}
+
+func ownerFromGrants[ID sdk.ObjectIdentifier](objectType sdk.ObjectType, parseId func(string) (ID, error)) ownerFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) (string, error) {
+		id, err := parseId(d.Id())
+		if err != nil {
+			return "", err
+		}
+		client := meta.(*provider.Context).Client
+		grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+			On: &sdk.ShowGrantsOn{
+				Object: &sdk.Object{
+					ObjectType: objectType,
+					Name:       id,
+				},
+			},
+		})
+		if err != nil {
+			return "", err
+		}
+		for _, grant := range grants {
+			if grant.Privilege == "OWNERSHIP" && grant.GranteeName != nil {
+				return grant.GranteeName.Name(), nil
+			}
+		}
+		return "", nil
+	}
+}
+
+func ownerRoleReadWrapper(owner ownerFunc, read schema.ReadContextFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		diags := read(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		ownerRole := d.Get("owner_role").(string)
+		if ownerRole == "" {
+			return diags
+		}
+		currentOwner, err := owner(ctx, d, meta)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		if currentOwner == "" {
+			return diags
+		}
+
+		ownerRoleId, err := sdk.ParseAccountObjectIdentifier(ownerRole)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		if ownerRoleId.Name() != currentOwner {
+			if err := d.Set("owner_role", sdk.NewAccountObjectIdentifier(currentOwner).FullyQualifiedName()); err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+		}
+		return diags
+	}
+}
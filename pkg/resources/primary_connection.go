@@ -62,7 +62,7 @@ func PrimaryConnection() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return withResourceIdentity(objectIdentity[sdk.AccountObjectIdentifier](), &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.PrimaryConnection, CreateContextPrimaryConnection),
 		ReadContext:   TrackingReadWrapper(resources.PrimaryConnection, ReadContextPrimaryConnection),
 		UpdateContext: TrackingUpdateWrapper(resources.PrimaryConnection, UpdateContextPrimaryConnection),
@@ -79,7 +79,7 @@ func PrimaryConnection() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.PrimaryConnection, ImportName[sdk.AccountObjectIdentifier]),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateContextPrimaryConnection(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -0,0 +1,270 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceIdentity describes how the structured resource identity (https://developer.hashicorp.com/terraform/plugin/sdkv2/resources/identity)
+// is derived from the resource id and how the resource id is recreated from the identity during the import.
+type resourceIdentity struct {
+	schemaFunc func() map[string]*schema.Schema
+	// fromId sets the identity attributes based on the resource id.
+	fromId func(id string, identity *schema.IdentityData) error
+	// toId returns the resource id based on the identity attributes.
+	toId func(identity *schema.IdentityData) (string, error)
+}
+
+// withResourceIdentity adds the identity schema to the given resource. The identity is set after every successful
+// create, read, and update based on the resource id. When the resource is imported with the identity
+// instead of the id, the id is built from the identity before calling the resource's importer.
+func withResourceIdentity(identity resourceIdentity, r *schema.Resource) *schema.Resource {
+	r.Identity = &schema.ResourceIdentity{
+		Version:    0,
+		SchemaFunc: identity.schemaFunc,
+	}
+	r.ResourceBehavior.MutableIdentity = isIdentityMutable(r, identity.schemaFunc())
+
+	if r.CreateContext != nil {
+		r.CreateContext = identity.contextFuncWrapper(r.CreateContext)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = identity.contextFuncWrapper(r.ReadContext)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = identity.contextFuncWrapper(r.UpdateContext)
+	}
+	if r.Importer != nil && r.Importer.StateContext != nil {
+		r.Importer.StateContext = identity.importWrapper(r.Importer.StateContext)
+	}
+	return r
+}
+
+// isIdentityMutable reports whether the identity can change without recreating the resource. It happens when the object
+// can be renamed (the id changes together with the name) or when one of the identity attributes can be updated in place
+// (e.g. the privileges of a grant).
+func isIdentityMutable(r *schema.Resource, identitySchema map[string]*schema.Schema) bool {
+	attributes := append([]string{"name"}, slices.Collect(maps.Keys(identitySchema))...)
+	return slices.ContainsFunc(attributes, func(attribute string) bool {
+		s, ok := r.Schema[attribute]
+		return ok && !s.ForceNew && !s.Computed
+	})
+}
+
+func (r resourceIdentity) contextFuncWrapper(f func(context.Context, *schema.ResourceData, any) diag.Diagnostics) func(context.Context, *schema.ResourceData, any) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		diags := f(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		return append(diags, diag.FromErr(r.setIdentity(d))...)
+	}
+}
+
+func (r resourceIdentity) importWrapper(importFunc schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if d.Id() == "" {
+			identity, err := d.Identity()
+			if err != nil {
+				return nil, err
+			}
+			id, err := r.toId(identity)
+			if err != nil {
+				return nil, fmt.Errorf("could not build resource id from the identity: %w", err)
+			}
+			d.SetId(id)
+		}
+		return importFunc(ctx, d, meta)
+	}
+}
+
+func (r resourceIdentity) setIdentity(d *schema.ResourceData) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+	return r.fromId(d.Id(), identity)
+}
+
+func identityStringSchema(description string, requiredForImport bool) *schema.Schema {
+	return &schema.Schema{
+		Type:              schema.TypeString,
+		Description:       description,
+		RequiredForImport: requiredForImport,
+		OptionalForImport: !requiredForImport,
+	}
+}
+
+func identityBoolSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:              schema.TypeBool,
+		Description:       description,
+		OptionalForImport: true,
+	}
+}
+
+func identityStringListSchema(description string, requiredForImport bool) *schema.Schema {
+	return &schema.Schema{
+		Type:              schema.TypeList,
+		Elem:              &schema.Schema{Type: schema.TypeString},
+		Description:       description,
+		RequiredForImport: requiredForImport,
+		OptionalForImport: !requiredForImport,
+	}
+}
+
+func setIdentityAttributes(identity *schema.IdentityData, attributes map[string]any) error {
+	errs := make([]error, 0)
+	for k, v := range attributes {
+		errs = append(errs, identity.Set(k, v))
+	}
+	return errors.Join(errs...)
+}
+
+// requiredIdentityString returns the string attribute from the identity or an error if it's not set.
+func requiredIdentityString(identity *schema.IdentityData, key string) (string, error) {
+	v, ok := identity.GetOk(key)
+	if !ok {
+		return "", fmt.Errorf("identity attribute %s is required", key)
+	}
+	return v.(string), nil
+}
+
+// objectIdentity returns the resource identity for objects identified by one of the standard identifier types.
+func objectIdentity[T sdk.AccountObjectIdentifier | sdk.DatabaseObjectIdentifier | sdk.SchemaObjectIdentifier | sdk.AccountIdentifier]() resourceIdentity {
+	switch any(new(T)).(type) {
+	case *sdk.AccountObjectIdentifier:
+		return resourceIdentity{
+			schemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"name": identityStringSchema("Name of the object.", true),
+				}
+			},
+			fromId: func(rawId string, identity *schema.IdentityData) error {
+				id, err := sdk.ParseAccountObjectIdentifier(rawId)
+				if err != nil {
+					return err
+				}
+				return identity.Set("name", id.Name())
+			},
+			toId: func(identity *schema.IdentityData) (string, error) {
+				name, err := requiredIdentityString(identity, "name")
+				if err != nil {
+					return "", err
+				}
+				return helpers.EncodeResourceIdentifier(sdk.NewAccountObjectIdentifier(name)), nil
+			},
+		}
+	case *sdk.DatabaseObjectIdentifier:
+		return resourceIdentity{
+			schemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"database": identityStringSchema("Name of the database in which the object is located.", true),
+					"name":     identityStringSchema("Name of the object.", true),
+				}
+			},
+			fromId: func(rawId string, identity *schema.IdentityData) error {
+				id, err := sdk.ParseDatabaseObjectIdentifier(rawId)
+				if err != nil {
+					return err
+				}
+				return setIdentityAttributes(identity, map[string]any{
+					"database": id.DatabaseName(),
+					"name":     id.Name(),
+				})
+			},
+			toId: func(identity *schema.IdentityData) (string, error) {
+				database, databaseErr := requiredIdentityString(identity, "database")
+				name, nameErr := requiredIdentityString(identity, "name")
+				if err := errors.Join(databaseErr, nameErr); err != nil {
+					return "", err
+				}
+				return helpers.EncodeResourceIdentifier(sdk.NewDatabaseObjectIdentifier(database, name)), nil
+			},
+		}
+	case *sdk.SchemaObjectIdentifier:
+		return resourceIdentity{
+			schemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"database": identityStringSchema("Name of the database in which the object is located.", true),
+					"schema":   identityStringSchema("Name of the schema in which the object is located.", true),
+					"name":     identityStringSchema("Name of the object.", true),
+				}
+			},
+			fromId: func(rawId string, identity *schema.IdentityData) error {
+				id, err := sdk.ParseSchemaObjectIdentifier(rawId)
+				if err != nil {
+					return err
+				}
+				return setIdentityAttributes(identity, map[string]any{
+					"database": id.DatabaseName(),
+					"schema":   id.SchemaName(),
+					"name":     id.Name(),
+				})
+			},
+			toId: func(identity *schema.IdentityData) (string, error) {
+				database, databaseErr := requiredIdentityString(identity, "database")
+				schemaName, schemaErr := requiredIdentityString(identity, "schema")
+				name, nameErr := requiredIdentityString(identity, "name")
+				if err := errors.Join(databaseErr, schemaErr, nameErr); err != nil {
+					return "", err
+				}
+				return helpers.EncodeResourceIdentifier(sdk.NewSchemaObjectIdentifier(database, schemaName, name)), nil
+			},
+		}
+	case *sdk.AccountIdentifier:
+		return resourceIdentity{
+			schemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"organization_name": identityStringSchema("Name of the organization to which the account belongs.", true),
+					"account_name":      identityStringSchema("Name of the account.", true),
+				}
+			},
+			fromId: func(rawId string, identity *schema.IdentityData) error {
+				id, err := sdk.ParseAccountIdentifier(rawId)
+				if err != nil {
+					return err
+				}
+				return setIdentityAttributes(identity, map[string]any{
+					"organization_name": id.OrganizationName(),
+					"account_name":      id.AccountName(),
+				})
+			},
+			toId: func(identity *schema.IdentityData) (string, error) {
+				organizationName, organizationErr := requiredIdentityString(identity, "organization_name")
+				accountName, accountErr := requiredIdentityString(identity, "account_name")
+				if err := errors.Join(organizationErr, accountErr); err != nil {
+					return "", err
+				}
+				return helpers.EncodeResourceIdentifier(sdk.NewAccountIdentifier(organizationName, accountName)), nil
+			},
+		}
+	default:
+		panic(fmt.Sprintf("unsupported identifier type %T", new(T)))
+	}
+}
+
+// stringIdentity returns the resource identity for resources identified by a single plain string (e.g. parameter name).
+func stringIdentity(attributeName string, description string) resourceIdentity {
+	return resourceIdentity{
+		schemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				attributeName: identityStringSchema(description, true),
+			}
+		},
+		fromId: func(id string, identity *schema.IdentityData) error {
+			return identity.Set(attributeName, id)
+		},
+		toId: func(identity *schema.IdentityData) (string, error) {
+			return requiredIdentityString(identity, attributeName)
+		},
+	}
+}
@@ -0,0 +1,122 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceIdentity_RoundTrip(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Identity resourceIdentity
+		Id       string
+	}{
+		{Name: "account object", Identity: objectIdentity[sdk.AccountObjectIdentifier](), Id: `database-name`},
+		{Name: "database object", Identity: objectIdentity[sdk.DatabaseObjectIdentifier](), Id: `"database-name"."schema-name"`},
+		{Name: "schema object", Identity: objectIdentity[sdk.SchemaObjectIdentifier](), Id: `"database-name"."schema-name"."view-name"`},
+		{Name: "account", Identity: objectIdentity[sdk.AccountIdentifier](), Id: `"organization-name"."account-name"`},
+		{Name: "account parameter", Identity: stringIdentity("key", ""), Id: `ALLOW_ID_TOKEN`},
+		{Name: "tag association", Identity: tagAssociationIdentity, Id: `"database-name"."schema-name"."tag-name"|finance|TABLE`},
+		{Name: "grant account role to role", Identity: grantAccountRoleIdentity, Id: `"role-name"|ROLE|"parent-role-name"`},
+		{Name: "grant account role to user", Identity: grantAccountRoleIdentity, Id: `"role-name"|USER|"user-name"`},
+		{Name: "grant database role to database role", Identity: grantDatabaseRoleIdentity, Id: `"database-name"."role-name"|DATABASE ROLE|"database-name"."parent-role-name"`},
+		{Name: "grant database role to share", Identity: grantDatabaseRoleIdentity, Id: `"database-name"."role-name"|SHARE|"share-name"`},
+		{Name: "grant application role to application", Identity: grantApplicationRoleIdentity, Id: `"application-name"."role-name"|APPLICATION|"other-application-name"`},
+		{Name: "grant privileges to account role on account", Identity: grantPrivilegesToAccountRoleIdentity, Id: `"role-name"|false|false|CREATE DATABASE,CREATE USER|OnAccount`},
+		{Name: "grant privileges to account role on account object", Identity: grantPrivilegesToAccountRoleIdentity, Id: `"role-name"|true|false|USAGE|OnAccountObject|DATABASE|"database-name"`},
+		{Name: "grant all privileges to account role on schema", Identity: grantPrivilegesToAccountRoleIdentity, Id: `"role-name"|false|false|ALL|OnSchema|OnSchema|"database-name"."schema-name"`},
+		{Name: "grant privileges to account role on future schemas", Identity: grantPrivilegesToAccountRoleIdentity, Id: `"role-name"|false|false|USAGE|OnSchema|OnFutureSchemasInDatabase|"database-name"`},
+		{Name: "grant privileges to account role on schema object", Identity: grantPrivilegesToAccountRoleIdentity, Id: `"role-name"|false|false|SELECT|OnSchemaObject|OnObject|TABLE|"database-name"."schema-name"."table-name"`},
+		{Name: "grant privileges to account role on all schema objects", Identity: grantPrivilegesToAccountRoleIdentity, Id: `"role-name"|false|false|SELECT|OnSchemaObject|OnAll|TABLES|InSchema|"database-name"."schema-name"`},
+		{Name: "grant privileges to database role on database", Identity: grantPrivilegesToDatabaseRoleIdentity, Id: `"database-name"."role-name"|false|false|CREATE SCHEMA|OnDatabase|"database-name"`},
+		{Name: "grant privileges to database role on future schema objects", Identity: grantPrivilegesToDatabaseRoleIdentity, Id: `"database-name"."role-name"|false|false|SELECT|OnSchemaObject|OnFuture|TABLES|InDatabase|"database-name"`},
+		{Name: "grant privileges to share on table", Identity: grantPrivilegesToShareIdentity, Id: `"share-name"|SELECT|OnTable|"database-name"."schema-name"."table-name"`},
+		{Name: "grant ownership on object", Identity: grantOwnershipIdentity, Id: `ToAccountRole|"account-role"|COPY|OnObject|TABLE|"database-name"."schema-name"."table-name"`},
+		{Name: "grant ownership on future objects", Identity: grantOwnershipIdentity, Id: `ToDatabaseRole|"database-name"."role-name"||OnFuture|TABLES|InSchema|"database-name"."schema-name"`},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			d := schema.TestResourceDataWithIdentityRaw(t, map[string]*schema.Schema{}, tt.Identity.schemaFunc(), map[string]string{})
+			d.SetId(tt.Id)
+
+			require.NoError(t, tt.Identity.setIdentity(d))
+
+			identity, err := d.Identity()
+			require.NoError(t, err)
+			id, err := tt.Identity.toId(identity)
+			require.NoError(t, err)
+			assert.Equal(t, tt.Id, id)
+		})
+	}
+}
+
+func TestResourceIdentity_ToIdErrors(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Identity resourceIdentity
+		Raw      map[string]string
+		Error    string
+	}{
+		{Name: "missing name", Identity: objectIdentity[sdk.SchemaObjectIdentifier](), Raw: map[string]string{"database": "a", "schema": "b"}, Error: "identity attribute name is required"},
+		{Name: "missing grantee", Identity: grantAccountRoleIdentity, Raw: map[string]string{"role_name": "a"}, Error: "exactly one of parent_role_name or user_name has to be set in the identity"},
+		{Name: "missing privileges", Identity: grantPrivilegesToAccountRoleIdentity, Raw: map[string]string{"account_role_name": "a", "on_account": "true"}, Error: "either privileges or all_privileges has to be set in the identity"},
+		{Name: "missing grant target", Identity: grantPrivilegesToAccountRoleIdentity, Raw: map[string]string{"account_role_name": "a", "all_privileges": "true"}, Error: "the identity does not specify on what the privileges are granted"},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			d := schema.TestResourceDataWithIdentityRaw(t, map[string]*schema.Schema{}, tt.Identity.schemaFunc(), tt.Raw)
+
+			identity, err := d.Identity()
+			require.NoError(t, err)
+			_, err = tt.Identity.toId(identity)
+			assert.ErrorContains(t, err, tt.Error)
+		})
+	}
+}
+
+func TestResourceIdentity_AlwaysApplyIsNotPartOfIdentity(t *testing.T) {
+	d := schema.TestResourceDataWithIdentityRaw(t, map[string]*schema.Schema{}, grantPrivilegesToAccountRoleIdentity.schemaFunc(), map[string]string{})
+	d.SetId(`"role-name"|false|true|USAGE|OnAccountObject|DATABASE|"database-name"`)
+
+	require.NoError(t, grantPrivilegesToAccountRoleIdentity.setIdentity(d))
+
+	identity, err := d.Identity()
+	require.NoError(t, err)
+	id, err := grantPrivilegesToAccountRoleIdentity.toId(identity)
+	require.NoError(t, err)
+	assert.Equal(t, `"role-name"|false|false|USAGE|OnAccountObject|DATABASE|"database-name"`, id)
+}
+
+func TestResourceIdentity_MutableIdentity(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Resource *schema.Resource
+		Expected bool
+	}{
+		{Name: "renamable object", Resource: Database(), Expected: true},
+		{Name: "renamable schema object", Resource: View(), Expected: true},
+		{Name: "account", Resource: Account(), Expected: true},
+		{Name: "object that cannot be renamed", Resource: ResourceMonitor(), Expected: false},
+		{Name: "account parameter", Resource: AccountParameter(), Expected: false},
+		{Name: "grant with privileges updated in place", Resource: GrantPrivilegesToAccountRole(), Expected: true},
+		{Name: "grant to share with privileges updated in place", Resource: GrantPrivilegesToShare(), Expected: true},
+		{Name: "tag association with tag value updated in place", Resource: TagAssociation(), Expected: true},
+		{Name: "grant recreated on every change", Resource: GrantAccountRole(), Expected: false},
+		{Name: "grant ownership", Resource: GrantOwnership(), Expected: false},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, tt.Resource.ResourceBehavior.MutableIdentity)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"reflect"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
@@ -104,26 +105,27 @@ func ResourceMonitor() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.ResourceMonitor, CreateResourceMonitor),
-		ReadContext:   TrackingReadWrapper(resources.ResourceMonitor, ReadResourceMonitor(true)),
-		UpdateContext: TrackingUpdateWrapper(resources.ResourceMonitor, UpdateResourceMonitor),
+	return withResourceIdentity(objectIdentity[sdk.AccountObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.ResourceMonitor, OwnerRoleWrapper(sdk.ObjectTypeResourceMonitor, sdk.ParseAccountObjectIdentifier, ReadResourceMonitor(false), CreateResourceMonitor)),
+		ReadContext:   TrackingReadWrapper(resources.ResourceMonitor, OwnerRoleReadWrapper(ReadResourceMonitor(true))),
+		UpdateContext: TrackingUpdateWrapper(resources.ResourceMonitor, OwnerRoleWrapper(sdk.ObjectTypeResourceMonitor, sdk.ParseAccountObjectIdentifier, ReadResourceMonitor(false), UpdateResourceMonitor)),
 		DeleteContext: TrackingDeleteWrapper(resources.ResourceMonitor, deleteFunc),
 		Description:   "Resource used to manage resource monitor objects. For more information, check [resource monitor documentation](https://docs.snowflake.com/en/user-guide/resource-monitors).",
 
-		Schema: resourceMonitorSchema,
+		Schema: collections.MergeMaps(resourceMonitorSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.ResourceMonitor, ImportResourceMonitor),
 		},
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.ResourceMonitor, customdiff.All(
 			ComputedIfAnyAttributeChanged(resourceMonitorSchema, ShowOutputAttributeName, "notify_users", "credit_quota", "frequency", "start_timestamp", "end_timestamp", "notify_triggers", "suspend_trigger", "suspend_immediate_trigger"),
+			ownerRoleCustomDiff,
 			ForceNewIfAllKeysAreNotSet("notify_triggers", "notify_triggers", "suspend_trigger", "suspend_immediate_trigger"),
 			ForceNewIfAllKeysAreNotSet("suspend_trigger", "notify_triggers", "suspend_trigger", "suspend_immediate_trigger"),
 			ForceNewIfAllKeysAreNotSet("suspend_immediate_trigger", "notify_triggers", "suspend_trigger", "suspend_immediate_trigger"),
 		)),
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportResourceMonitor(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
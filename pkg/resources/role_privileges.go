@@ -0,0 +1,698 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// rolePrivilegesObjectTypes are all the object types managed by the resource. The data metric functions are not supported, because
+// SHOW GRANTS returns their arguments in a format that cannot be parsed; the privileges on them are reported as warnings during the read.
+var rolePrivilegesObjectTypes = append([]string{sdk.ObjectTypeAccount.String()}, rolePrivilegesNonAccountObjectTypes...)
+
+// rolePrivilegesNonAccountObjectTypes are the object types managed by the resource, except the account: the account objects, the schemas, and the schema objects.
+var rolePrivilegesNonAccountObjectTypes = func() []string {
+	objectTypes := make([]string, 0)
+	for _, objectType := range sdk.ValidGrantToAccountObjectTypes {
+		objectTypes = append(objectTypes, objectType.String())
+	}
+	objectTypes = append(objectTypes, sdk.ObjectTypeSchema.String())
+	for _, objectType := range sdk.ValidGrantToObjectTypesString {
+		if objectType != sdk.ObjectTypeDataMetricFunction.String() {
+			objectTypes = append(objectTypes, objectType)
+		}
+	}
+	return objectTypes
+}()
+
+var rolePrivilegesSchema = map[string]*schema.Schema{
+	"account_role_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      relatedResourceDescription("The fully qualified name of the account role which privileges are managed.", resources.AccountRole),
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf:     []string{"account_role_name", "database_role_name"},
+	},
+	"database_role_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      relatedResourceDescription("The fully qualified name of the database role which privileges are managed.", resources.DatabaseRole),
+		ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf:     []string{"account_role_name", "database_role_name"},
+	},
+	"grant": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "The complete set of the privileges granted to the role. All the other privileges granted to the role are revoked.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"privileges": {
+					Type:        schema.TypeSet,
+					Required:    true,
+					MinItems:    1,
+					Description: "The privileges to grant on the object. This field is case-sensitive; use only upper-case privileges.",
+					Elem: &schema.Schema{
+						Type:             schema.TypeString,
+						ValidateDiagFunc: isNotOwnershipGrant(),
+					},
+				},
+				"object_type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      fmt.Sprintf("The type of the object on which the privileges are granted. Use ACCOUNT to grant the global privileges. Valid values are: %s.", possibleValuesListed(rolePrivilegesObjectTypes)),
+					ValidateDiagFunc: StringInSlice(rolePrivilegesObjectTypes, false),
+				},
+				"object_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The fully qualified name of the object on which the privileges are granted. It has to be set for all the object types other than ACCOUNT. For functions and procedures, include the argument types, e.g. `\"database\".\"schema\".\"function\"(NUMBER, VARCHAR)`.",
+				},
+				"with_grant_option": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Specifies whether the grantee can grant the privileges to other roles.",
+				},
+			},
+		},
+	},
+	"unmanaged_privileges": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The privileges granted to the role in Snowflake that are not declared in the `grant` blocks (e.g. granted outside Terraform). They are revoked during the next apply; the plan shows them as removed.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+}
+
+func RolePrivileges() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.RolePrivilegesResource), TrackingCreateWrapper(resources.RolePrivileges, CreateRolePrivileges)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.RolePrivilegesResource), TrackingReadWrapper(resources.RolePrivileges, ReadRolePrivileges)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.RolePrivilegesResource), TrackingUpdateWrapper(resources.RolePrivileges, UpdateRolePrivileges)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.RolePrivilegesResource), TrackingDeleteWrapper(resources.RolePrivileges, DeleteRolePrivileges)),
+		Description:   "Resource used to manage the complete set of privileges granted to an account role or a database role. Contrary to snowflake_grant_privileges_to_account_role and snowflake_grant_privileges_to_database_role, the resource is authoritative: all the privileges granted to the role that are not declared in the configuration are revoked. The ownership, the granted roles, the future grants, and the privileges on data metric functions are not managed; the read warns about the privileges on data metric functions granted to the role.",
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.RolePrivileges, revokeUnmanagedPrivileges),
+
+		Schema: rolePrivilegesSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.RolePrivileges, ImportRolePrivileges),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+// revokeUnmanagedPrivileges plans the revocation of the unmanaged privileges found during the refresh. The plan shows
+// them as removed from unmanaged_privileges, which is the preview of the revocations run during the apply.
+func revokeUnmanagedPrivileges(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+	if unmanaged, ok := diff.Get("unmanaged_privileges").([]any); ok && len(unmanaged) > 0 {
+		return diff.SetNew("unmanaged_privileges", []string{})
+	}
+	return nil
+}
+
+// rolePrivilegesGrantee is the account role or the database role which privileges are managed.
+type rolePrivilegesGrantee struct {
+	AccountRole  *sdk.AccountObjectIdentifier
+	DatabaseRole *sdk.DatabaseObjectIdentifier
+}
+
+const (
+	rolePrivilegesAccountRoleKind  = "ACCOUNT_ROLE"
+	rolePrivilegesDatabaseRoleKind = "DATABASE_ROLE"
+)
+
+func (g rolePrivilegesGrantee) String() string {
+	if g.AccountRole != nil {
+		return helpers.EncodeResourceIdentifier(rolePrivilegesAccountRoleKind, g.AccountRole.FullyQualifiedName())
+	}
+	return helpers.EncodeResourceIdentifier(rolePrivilegesDatabaseRoleKind, g.DatabaseRole.FullyQualifiedName())
+}
+
+func parseRolePrivilegesGrantee(id string) (rolePrivilegesGrantee, error) {
+	parts := helpers.ParseResourceIdentifier(id)
+	if len(parts) != 2 {
+		return rolePrivilegesGrantee{}, fmt.Errorf(`invalid role privileges identifier %s, expected ACCOUNT_ROLE|"<role_name>" or DATABASE_ROLE|"<database_name>"."<role_name>"`, id)
+	}
+	switch parts[0] {
+	case rolePrivilegesAccountRoleKind:
+		role, err := sdk.ParseAccountObjectIdentifier(parts[1])
+		if err != nil {
+			return rolePrivilegesGrantee{}, err
+		}
+		return rolePrivilegesGrantee{AccountRole: &role}, nil
+	case rolePrivilegesDatabaseRoleKind:
+		role, err := sdk.ParseDatabaseObjectIdentifier(parts[1])
+		if err != nil {
+			return rolePrivilegesGrantee{}, err
+		}
+		return rolePrivilegesGrantee{DatabaseRole: &role}, nil
+	default:
+		return rolePrivilegesGrantee{}, fmt.Errorf("invalid role kind %s in the role privileges identifier, expected %s or %s", parts[0], rolePrivilegesAccountRoleKind, rolePrivilegesDatabaseRoleKind)
+	}
+}
+
+func rolePrivilegesGranteeFromSchema(d *schema.ResourceData) (rolePrivilegesGrantee, error) {
+	if v, ok := d.GetOk("account_role_name"); ok {
+		role, err := sdk.ParseAccountObjectIdentifier(v.(string))
+		if err != nil {
+			return rolePrivilegesGrantee{}, err
+		}
+		return rolePrivilegesGrantee{AccountRole: &role}, nil
+	}
+	role, err := sdk.ParseDatabaseObjectIdentifier(d.Get("database_role_name").(string))
+	if err != nil {
+		return rolePrivilegesGrantee{}, err
+	}
+	return rolePrivilegesGrantee{DatabaseRole: &role}, nil
+}
+
+// rolePrivilege is a single privilege on the object granted to the role.
+type rolePrivilege struct {
+	Privilege  string
+	ObjectType sdk.ObjectType
+	// ObjectName is the fully qualified name of the object; it is empty for the account.
+	ObjectName      string
+	WithGrantOption bool
+}
+
+// key identifies the privilege in Snowflake. IMPORTED PRIVILEGES are returned as USAGE by SHOW GRANTS.
+func (p rolePrivilege) key() string {
+	privilege := p.Privilege
+	if privilege == sdk.AccountObjectPrivilegeImportedPrivileges.String() {
+		privilege = sdk.AccountObjectPrivilegeUsage.String()
+	}
+	return fmt.Sprintf("%s|%s|%s|%t", privilege, p.ObjectType, p.ObjectName, p.WithGrantOption)
+}
+
+func (p rolePrivilege) target() rolePrivilegesTarget {
+	return rolePrivilegesTarget{ObjectType: p.ObjectType, ObjectName: p.ObjectName, WithGrantOption: p.WithGrantOption}
+}
+
+func (p rolePrivilege) String() string {
+	return fmt.Sprintf("%s ON %s", p.Privilege, p.target())
+}
+
+// rolePrivilegesTarget is the object on which the privileges are granted, together with the grant option.
+type rolePrivilegesTarget struct {
+	ObjectType      sdk.ObjectType
+	ObjectName      string
+	WithGrantOption bool
+}
+
+func (t rolePrivilegesTarget) String() string {
+	parts := []string{t.ObjectType.String()}
+	if t.ObjectName != "" {
+		parts = append(parts, t.ObjectName)
+	}
+	if t.WithGrantOption {
+		parts = append(parts, "WITH GRANT OPTION")
+	}
+	return strings.Join(parts, " ")
+}
+
+// normalizedRolePrivilegesObjectName returns the fully qualified name of the object, so that it can be compared with the one returned by SHOW GRANTS.
+func normalizedRolePrivilegesObjectName(objectType sdk.ObjectType, objectName string) (string, error) {
+	switch {
+	case objectType == sdk.ObjectTypeAccount:
+		if objectName != "" {
+			return "", fmt.Errorf("object_name cannot be set for the %s object type", sdk.ObjectTypeAccount)
+		}
+		return "", nil
+	case objectName == "":
+		return "", fmt.Errorf("object_name has to be set for the %s object type", objectType)
+	case slices.Contains(sdk.ValidGrantToAccountObjectTypes, objectType):
+		id, err := sdk.ParseAccountObjectIdentifier(objectName)
+		return id.FullyQualifiedName(), err
+	case objectType == sdk.ObjectTypeSchema:
+		id, err := sdk.ParseDatabaseObjectIdentifier(objectName)
+		return id.FullyQualifiedName(), err
+	default:
+		id, err := parseRolePrivilegesSchemaObjectIdentifier(objectType, objectName)
+		if err != nil {
+			return "", err
+		}
+		return id.FullyQualifiedName(), nil
+	}
+}
+
+// parseRolePrivilegesSchemaObjectIdentifier parses the name of the schema object, including the argument types of the objects with arguments.
+func parseRolePrivilegesSchemaObjectIdentifier(objectType sdk.ObjectType, objectName string) (sdk.ObjectIdentifier, error) {
+	if objectType.IsWithArguments() {
+		return sdk.ParseSchemaObjectIdentifierWithArguments(objectName)
+	}
+	return sdk.ParseSchemaObjectIdentifier(objectName)
+}
+
+// expandRolePrivileges returns the privileges declared in the grant blocks.
+func expandRolePrivileges(grants []any) ([]rolePrivilege, error) {
+	privileges := make([]rolePrivilege, 0)
+	for _, raw := range grants {
+		grant := raw.(map[string]any)
+		objectType := sdk.ObjectType(grant["object_type"].(string))
+		objectName, err := normalizedRolePrivilegesObjectName(objectType, grant["object_name"].(string))
+		if err != nil {
+			return nil, err
+		}
+		for _, privilege := range expandStringList(grant["privileges"].(*schema.Set).List()) {
+			privileges = append(privileges, rolePrivilege{
+				Privilege:       privilege,
+				ObjectType:      objectType,
+				ObjectName:      objectName,
+				WithGrantOption: grant["with_grant_option"].(bool),
+			})
+		}
+	}
+	return privileges, nil
+}
+
+// flattenRolePrivileges returns the grant blocks for the privileges, one block for each target.
+func flattenRolePrivileges(privileges []rolePrivilege) []map[string]any {
+	targets, grouped := groupRolePrivilegesByTarget(privileges)
+	grants := make([]map[string]any, len(targets))
+	for i, target := range targets {
+		grants[i] = map[string]any{
+			"privileges":        grouped[target],
+			"object_type":       target.ObjectType.String(),
+			"object_name":       target.ObjectName,
+			"with_grant_option": target.WithGrantOption,
+		}
+	}
+	return grants
+}
+
+func groupRolePrivilegesByTarget(privileges []rolePrivilege) ([]rolePrivilegesTarget, map[rolePrivilegesTarget][]string) {
+	var targets []rolePrivilegesTarget
+	grouped := make(map[rolePrivilegesTarget][]string)
+	for _, privilege := range privileges {
+		target := privilege.target()
+		if _, ok := grouped[target]; !ok {
+			targets = append(targets, target)
+		}
+		grouped[target] = append(grouped[target], privilege.Privilege)
+	}
+	return targets, grouped
+}
+
+// diffRolePrivileges returns the privileges that have to be granted and revoked to get from the actual to the desired privileges.
+// The privilege granted with a different grant option is revoked and granted again.
+func diffRolePrivileges(desired []rolePrivilege, actual []rolePrivilege) (toGrant []rolePrivilege, toRevoke []rolePrivilege) {
+	desiredKeys := make(map[string]bool)
+	for _, privilege := range desired {
+		desiredKeys[privilege.key()] = true
+	}
+	actualKeys := make(map[string]bool)
+	for _, privilege := range actual {
+		actualKeys[privilege.key()] = true
+		if !desiredKeys[privilege.key()] {
+			toRevoke = append(toRevoke, privilege)
+		}
+	}
+	for _, privilege := range desired {
+		if !actualKeys[privilege.key()] {
+			toGrant = append(toGrant, privilege)
+		}
+	}
+	return toGrant, toRevoke
+}
+
+// showRolePrivileges returns the privileges currently granted to the role. The ownership and the granted roles are skipped.
+// The privileges on the object types not supported by the resource are skipped too; they are returned separately as unsupported.
+func showRolePrivileges(ctx context.Context, client *sdk.Client, grantee rolePrivilegesGrantee) (privileges []rolePrivilege, unsupported []string, err error) {
+	opts := &sdk.ShowGrantOptions{To: &sdk.ShowGrantsTo{}}
+	if grantee.AccountRole != nil {
+		opts.To.Role = *grantee.AccountRole
+	} else {
+		opts.To.DatabaseRole = *grantee.DatabaseRole
+	}
+	grants, err := client.Grants.Show(ctx, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	privileges = make([]rolePrivilege, 0)
+	unsupported = make([]string, 0)
+	for _, grant := range grants {
+		if grant.Privilege == "OWNERSHIP" || slices.Contains([]sdk.ObjectType{sdk.ObjectTypeRole, sdk.ObjectTypeDatabaseRole}, grant.GrantedOn) {
+			continue
+		}
+		if !slices.Contains(rolePrivilegesObjectTypes, grant.GrantedOn.String()) {
+			unsupported = append(unsupported, fmt.Sprintf("%s ON %s %s", grant.Privilege, grant.GrantedOn, grant.Name.FullyQualifiedName()))
+			continue
+		}
+		privilege := rolePrivilege{
+			Privilege:       grant.Privilege,
+			ObjectType:      grant.GrantedOn,
+			WithGrantOption: grant.GrantOption,
+		}
+		if grant.GrantedOn != sdk.ObjectTypeAccount {
+			privilege.ObjectName = grant.Name.FullyQualifiedName()
+		}
+		privileges = append(privileges, privilege)
+	}
+	return privileges, unsupported, nil
+}
+
+func (g rolePrivilegesGrantee) grant(ctx context.Context, client *sdk.Client, target rolePrivilegesTarget, privileges []string) error {
+	if g.AccountRole != nil {
+		accountRolePrivileges, on, err := accountRoleGrantFor(target, privileges)
+		if err != nil {
+			return err
+		}
+		return client.Grants.GrantPrivilegesToAccountRole(ctx, accountRolePrivileges, on, *g.AccountRole, &sdk.GrantPrivilegesToAccountRoleOptions{
+			WithGrantOption: sdk.Bool(target.WithGrantOption),
+		})
+	}
+	databaseRolePrivileges, on, err := databaseRoleGrantFor(target, privileges)
+	if err != nil {
+		return err
+	}
+	return client.Grants.GrantPrivilegesToDatabaseRole(ctx, databaseRolePrivileges, on, *g.DatabaseRole, &sdk.GrantPrivilegesToDatabaseRoleOptions{
+		WithGrantOption: sdk.Bool(target.WithGrantOption),
+	})
+}
+
+func (g rolePrivilegesGrantee) revoke(ctx context.Context, client *sdk.Client, target rolePrivilegesTarget, privileges []string) error {
+	if g.AccountRole != nil {
+		accountRolePrivileges, on, err := accountRoleGrantFor(target, privileges)
+		if err != nil {
+			return err
+		}
+		return client.Grants.RevokePrivilegesFromAccountRole(ctx, accountRolePrivileges, on, *g.AccountRole, &sdk.RevokePrivilegesFromAccountRoleOptions{})
+	}
+	databaseRolePrivileges, on, err := databaseRoleGrantFor(target, privileges)
+	if err != nil {
+		return err
+	}
+	return client.Grants.RevokePrivilegesFromDatabaseRole(ctx, databaseRolePrivileges, on, *g.DatabaseRole, &sdk.RevokePrivilegesFromDatabaseRoleOptions{})
+}
+
+func accountRoleGrantFor(target rolePrivilegesTarget, privileges []string) (*sdk.AccountRoleGrantPrivileges, *sdk.AccountRoleGrantOn, error) {
+	switch {
+	case target.ObjectType == sdk.ObjectTypeAccount:
+		return getAccountRolePrivileges(false, privileges, true, false, false, false), &sdk.AccountRoleGrantOn{Account: sdk.Bool(true)}, nil
+	case slices.Contains(sdk.ValidGrantToAccountObjectTypes, target.ObjectType):
+		id, err := sdk.ParseAccountObjectIdentifier(target.ObjectName)
+		if err != nil {
+			return nil, nil, err
+		}
+		return getAccountRolePrivileges(false, privileges, false, true, false, false), &sdk.AccountRoleGrantOn{AccountObject: getGrantOnAccountObject(target.ObjectType, id)}, nil
+	case target.ObjectType == sdk.ObjectTypeSchema:
+		id, err := sdk.ParseDatabaseObjectIdentifier(target.ObjectName)
+		if err != nil {
+			return nil, nil, err
+		}
+		return getAccountRolePrivileges(false, privileges, false, false, true, false), &sdk.AccountRoleGrantOn{Schema: &sdk.GrantOnSchema{Schema: &id}}, nil
+	default:
+		id, err := parseRolePrivilegesSchemaObjectIdentifier(target.ObjectType, target.ObjectName)
+		if err != nil {
+			return nil, nil, err
+		}
+		on := &sdk.GrantOnSchemaObject{SchemaObject: &sdk.Object{ObjectType: target.ObjectType, Name: id}}
+		return getAccountRolePrivileges(false, privileges, false, false, false, true), &sdk.AccountRoleGrantOn{SchemaObject: on}, nil
+	}
+}
+
+func databaseRoleGrantFor(target rolePrivilegesTarget, privileges []string) (*sdk.DatabaseRoleGrantPrivileges, *sdk.DatabaseRoleGrantOn, error) {
+	switch {
+	case target.ObjectType == sdk.ObjectTypeDatabase:
+		id, err := sdk.ParseAccountObjectIdentifier(target.ObjectName)
+		if err != nil {
+			return nil, nil, err
+		}
+		return getDatabaseRolePrivileges(false, privileges, true, false, false), &sdk.DatabaseRoleGrantOn{Database: &id}, nil
+	case target.ObjectType == sdk.ObjectTypeAccount || slices.Contains(sdk.ValidGrantToAccountObjectTypes, target.ObjectType):
+		return nil, nil, fmt.Errorf("the privileges on %s cannot be granted to the database role", target.ObjectType)
+	case target.ObjectType == sdk.ObjectTypeSchema:
+		id, err := sdk.ParseDatabaseObjectIdentifier(target.ObjectName)
+		if err != nil {
+			return nil, nil, err
+		}
+		return getDatabaseRolePrivileges(false, privileges, false, true, false), &sdk.DatabaseRoleGrantOn{Schema: &sdk.GrantOnSchema{Schema: &id}}, nil
+	default:
+		id, err := parseRolePrivilegesSchemaObjectIdentifier(target.ObjectType, target.ObjectName)
+		if err != nil {
+			return nil, nil, err
+		}
+		on := &sdk.GrantOnSchemaObject{SchemaObject: &sdk.Object{ObjectType: target.ObjectType, Name: id}}
+		return getDatabaseRolePrivileges(false, privileges, false, false, true), &sdk.DatabaseRoleGrantOn{SchemaObject: on}, nil
+	}
+}
+
+// applyRolePrivileges revokes all the privileges granted to the role that are not declared in the configuration, and grants the missing ones.
+func applyRolePrivileges(ctx context.Context, client *sdk.Client, grantee rolePrivilegesGrantee, desired []rolePrivilege) error {
+	actual, _, err := showRolePrivileges(ctx, client, grantee)
+	if err != nil {
+		return err
+	}
+	toGrant, toRevoke := diffRolePrivileges(desired, actual)
+	if err := revokeRolePrivileges(ctx, client, grantee, toRevoke); err != nil {
+		return err
+	}
+	targets, grouped := groupRolePrivilegesByTarget(toGrant)
+	for _, target := range targets {
+		if err := grantee.grant(ctx, client, target, grouped[target]); err != nil {
+			return fmt.Errorf("granting %s on %s: %w", strings.Join(grouped[target], ", "), target, err)
+		}
+	}
+	return nil
+}
+
+func revokeRolePrivileges(ctx context.Context, client *sdk.Client, grantee rolePrivilegesGrantee, privileges []rolePrivilege) error {
+	if len(privileges) > 0 {
+		log.Printf("[INFO] Revoking privileges from %s: %v", grantee, privileges)
+	}
+	// the grant option does not matter for the revoke, so the privileges are grouped by the object only
+	for i := range privileges {
+		privileges[i].WithGrantOption = false
+	}
+	targets, grouped := groupRolePrivilegesByTarget(privileges)
+	for _, target := range targets {
+		if err := grantee.revoke(ctx, client, target, grouped[target]); err != nil {
+			return fmt.Errorf("revoking %s on %s: %w", strings.Join(grouped[target], ", "), target, err)
+		}
+	}
+	return nil
+}
+
+func ImportRolePrivileges(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+
+	grantee, err := parseRolePrivilegesGrantee(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if grantee.AccountRole != nil {
+		if err := d.Set("account_role_name", grantee.AccountRole.FullyQualifiedName()); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := d.Set("database_role_name", grantee.DatabaseRole.FullyQualifiedName()); err != nil {
+			return nil, err
+		}
+	}
+
+	// all the privileges granted to the role are imported as declared
+	privileges, _, err := showRolePrivileges(ctx, client, grantee)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("grant", flattenRolePrivileges(privileges)); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateRolePrivileges(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	grantee, err := rolePrivilegesGranteeFromSchema(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	desired, err := expandRolePrivileges(d.Get("grant").(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := applyRolePrivileges(ctx, client, grantee, desired); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when applying the privileges of the role",
+				Detail:   fmt.Sprintf("Role: %s\nError: %s", grantee, err),
+			},
+		}
+	}
+
+	d.SetId(grantee.String())
+
+	return ReadRolePrivileges(ctx, d, meta)
+}
+
+func UpdateRolePrivileges(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	grantee, err := parseRolePrivilegesGrantee(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	desired, err := expandRolePrivileges(d.Get("grant").(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := applyRolePrivileges(ctx, client, grantee, desired); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when applying the privileges of the role",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+			},
+		}
+	}
+
+	return ReadRolePrivileges(ctx, d, meta)
+}
+
+func ReadRolePrivileges(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	grantee, err := parseRolePrivilegesGrantee(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if grantee.AccountRole != nil {
+		_, err = client.Roles.ShowByIDSafely(ctx, *grantee.AccountRole)
+	} else {
+		_, err = client.DatabaseRoles.ShowByIDSafely(ctx, *grantee.DatabaseRole)
+	}
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve role. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	actual, unsupported, err := showRolePrivileges(ctx, client, grantee)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to retrieve grants",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+			},
+		}
+	}
+	actualKeys := make(map[string]bool)
+	for _, privilege := range actual {
+		actualKeys[privilege.key()] = true
+	}
+
+	grants := d.Get("grant").(*schema.Set).List()
+	declared, err := expandRolePrivileges(grants)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, unmanaged := diffRolePrivileges(declared, actual)
+	unmanagedPrivileges := make([]string, len(unmanaged))
+	for i, privilege := range unmanaged {
+		unmanagedPrivileges[i] = privilege.String()
+	}
+	slices.Sort(unmanagedPrivileges)
+
+	// the declared privileges that are no longer granted are removed from the grant blocks, so that they are granted again
+	for _, raw := range grants {
+		grant := raw.(map[string]any)
+		declared, err := expandRolePrivileges([]any{grant})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		granted := make([]string, 0)
+		for _, privilege := range declared {
+			if actualKeys[privilege.key()] {
+				granted = append(granted, privilege.Privilege)
+			}
+		}
+		grant["privileges"] = granted
+	}
+
+	if grantee.AccountRole != nil {
+		err = d.Set("account_role_name", grantee.AccountRole.FullyQualifiedName())
+	} else {
+		err = d.Set("database_role_name", grantee.DatabaseRole.FullyQualifiedName())
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("grant", grants); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("unmanaged_privileges", unmanagedPrivileges); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(unsupported) > 0 {
+		slices.Sort(unsupported)
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "The role has privileges on object types not managed by the resource",
+				Detail:   fmt.Sprintf("Id: %s\nThe following privileges are neither revoked nor detected as unmanaged:\n%s", d.Id(), strings.Join(unsupported, "\n")),
+			},
+		}
+	}
+
+	return nil
+}
+
+func DeleteRolePrivileges(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	grantee, err := parseRolePrivilegesGrantee(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the resource owns all the privileges of the role, so all of them are revoked
+	actual, _, err := showRolePrivileges(ctx, client, grantee)
+	if err != nil && !errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		return diag.FromErr(err)
+	}
+	if err := revokeRolePrivileges(ctx, client, grantee, actual); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when revoking the privileges of the role",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+			},
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRolePrivilegesGrantee(t *testing.T) {
+	accountRole := sdk.NewAccountObjectIdentifier("role")
+	databaseRole := sdk.NewDatabaseObjectIdentifier("database", "role")
+
+	testCases := []struct {
+		Name     string
+		Id       string
+		Expected rolePrivilegesGrantee
+		Error    string
+	}{
+		{
+			Name:     "account role",
+			Id:       `ACCOUNT_ROLE|"role"`,
+			Expected: rolePrivilegesGrantee{AccountRole: &accountRole},
+		},
+		{
+			Name:     "database role",
+			Id:       `DATABASE_ROLE|"database"."role"`,
+			Expected: rolePrivilegesGrantee{DatabaseRole: &databaseRole},
+		},
+		{
+			Name:  "validation: missing role kind",
+			Id:    `"role"`,
+			Error: "invalid role privileges identifier",
+		},
+		{
+			Name:  "validation: invalid role kind",
+			Id:    `APPLICATION_ROLE|"database"."role"`,
+			Error: "invalid role kind APPLICATION_ROLE",
+		},
+		{
+			Name:  "validation: invalid database role name",
+			Id:    `DATABASE_ROLE|"role"`,
+			Error: "unexpected number of parts",
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			grantee, err := parseRolePrivilegesGrantee(tt.Id)
+			if tt.Error != "" {
+				require.ErrorContains(t, err, tt.Error)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.Expected, grantee)
+			assert.Equal(t, tt.Id, grantee.String())
+		})
+	}
+}
+
+func TestExpandRolePrivileges(t *testing.T) {
+	grant := func(objectType string, objectName string, withGrantOption bool, privileges ...string) any {
+		privilegesSet := schema.NewSet(schema.HashString, nil)
+		for _, privilege := range privileges {
+			privilegesSet.Add(privilege)
+		}
+		return map[string]any{
+			"object_type":       objectType,
+			"object_name":       objectName,
+			"with_grant_option": withGrantOption,
+			"privileges":        privilegesSet,
+		}
+	}
+
+	t.Run("normalizes the object names", func(t *testing.T) {
+		privileges, err := expandRolePrivileges([]any{
+			grant("ACCOUNT", "", false, "CREATE DATABASE"),
+			grant("DATABASE", "database", false, "USAGE"),
+			grant("SCHEMA", `database."schema"`, true, "CREATE TABLE"),
+			grant("TABLE", "database.schema.table", false, "SELECT"),
+			grant("FUNCTION", `database.schema."function"(NUMBER, VARCHAR)`, false, "USAGE"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []rolePrivilege{
+			{Privilege: "CREATE DATABASE", ObjectType: sdk.ObjectTypeAccount},
+			{Privilege: "USAGE", ObjectType: sdk.ObjectTypeDatabase, ObjectName: `"database"`},
+			{Privilege: "CREATE TABLE", ObjectType: sdk.ObjectTypeSchema, ObjectName: `"database"."schema"`, WithGrantOption: true},
+			{Privilege: "SELECT", ObjectType: sdk.ObjectTypeTable, ObjectName: `"database"."schema"."table"`},
+			{Privilege: "USAGE", ObjectType: sdk.ObjectTypeFunction, ObjectName: `"database"."schema"."function"(NUMBER, VARCHAR)`},
+		}, privileges)
+	})
+
+	t.Run("validation: object name set for account", func(t *testing.T) {
+		_, err := expandRolePrivileges([]any{grant("ACCOUNT", "account", false, "CREATE DATABASE")})
+		require.ErrorContains(t, err, "object_name cannot be set for the ACCOUNT object type")
+	})
+
+	t.Run("validation: missing arguments of the procedure", func(t *testing.T) {
+		_, err := expandRolePrivileges([]any{grant("PROCEDURE", "database.schema.procedure", false, "USAGE")})
+		require.ErrorContains(t, err, "'(' not present")
+	})
+
+	t.Run("validation: missing object name", func(t *testing.T) {
+		_, err := expandRolePrivileges([]any{grant("WAREHOUSE", "", false, "USAGE")})
+		require.ErrorContains(t, err, "object_name has to be set for the WAREHOUSE object type")
+	})
+}
+
+func TestDiffRolePrivileges(t *testing.T) {
+	usage := rolePrivilege{Privilege: "USAGE", ObjectType: sdk.ObjectTypeDatabase, ObjectName: `"database"`}
+	monitor := rolePrivilege{Privilege: "MONITOR", ObjectType: sdk.ObjectTypeDatabase, ObjectName: `"database"`}
+	importedPrivileges := rolePrivilege{Privilege: "IMPORTED PRIVILEGES", ObjectType: sdk.ObjectTypeDatabase, ObjectName: `"database"`}
+	usageWithGrantOption := usage
+	usageWithGrantOption.WithGrantOption = true
+
+	testCases := []struct {
+		Name             string
+		Desired          []rolePrivilege
+		Actual           []rolePrivilege
+		ExpectedToGrant  []rolePrivilege
+		ExpectedToRevoke []rolePrivilege
+	}{
+		{
+			Name:             "no changes",
+			Desired:          []rolePrivilege{usage},
+			Actual:           []rolePrivilege{usage},
+			ExpectedToGrant:  nil,
+			ExpectedToRevoke: nil,
+		},
+		{
+			Name:             "missing and unmanaged privileges",
+			Desired:          []rolePrivilege{usage},
+			Actual:           []rolePrivilege{monitor},
+			ExpectedToGrant:  []rolePrivilege{usage},
+			ExpectedToRevoke: []rolePrivilege{monitor},
+		},
+		{
+			Name:             "different grant option",
+			Desired:          []rolePrivilege{usageWithGrantOption},
+			Actual:           []rolePrivilege{usage},
+			ExpectedToGrant:  []rolePrivilege{usageWithGrantOption},
+			ExpectedToRevoke: []rolePrivilege{usage},
+		},
+		{
+			Name:             "imported privileges returned as usage",
+			Desired:          []rolePrivilege{importedPrivileges},
+			Actual:           []rolePrivilege{usage},
+			ExpectedToGrant:  nil,
+			ExpectedToRevoke: nil,
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.Name, func(t *testing.T) {
+			toGrant, toRevoke := diffRolePrivileges(tt.Desired, tt.Actual)
+			assert.Equal(t, tt.ExpectedToGrant, toGrant)
+			assert.Equal(t, tt.ExpectedToRevoke, toRevoke)
+		})
+	}
+}
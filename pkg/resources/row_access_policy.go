@@ -7,6 +7,7 @@ import (
 	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
@@ -106,22 +107,23 @@ func RowAccessPolicy() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return withResourceIdentity(objectIdentity[sdk.SchemaObjectIdentifier](), &schema.Resource{
 		SchemaVersion: 2,
 
-		CreateContext: TrackingCreateWrapper(resources.RowAccessPolicy, CreateRowAccessPolicy),
-		ReadContext:   TrackingReadWrapper(resources.RowAccessPolicy, ReadRowAccessPolicy),
-		UpdateContext: TrackingUpdateWrapper(resources.RowAccessPolicy, UpdateRowAccessPolicy),
+		CreateContext: TrackingCreateWrapper(resources.RowAccessPolicy, OwnerRoleWrapper(sdk.ObjectTypeRowAccessPolicy, sdk.ParseSchemaObjectIdentifier, ReadRowAccessPolicy, CreateRowAccessPolicy)),
+		ReadContext:   TrackingReadWrapper(resources.RowAccessPolicy, OwnerRoleReadWrapper(ReadRowAccessPolicy)),
+		UpdateContext: TrackingUpdateWrapper(resources.RowAccessPolicy, OwnerRoleWrapper(sdk.ObjectTypeRowAccessPolicy, sdk.ParseSchemaObjectIdentifier, ReadRowAccessPolicy, UpdateRowAccessPolicy)),
 		DeleteContext: TrackingDeleteWrapper(resources.RowAccessPolicy, deleteFunc),
 		Description:   "Resource used to manage row access policy objects. For more information, check [row access policy documentation](https://docs.snowflake.com/en/sql-reference/sql/create-row-access-policy).",
 
-		Schema: rowAccessPolicySchema,
+		Schema: collections.MergeMaps(rowAccessPolicySchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.RowAccessPolicy, ImportRowAccessPolicy),
 		},
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.RowAccessPolicy, customdiff.All(
 			ComputedIfAnyAttributeChanged(rowAccessPolicySchema, ShowOutputAttributeName, "comment", "name"),
+			ownerRoleCustomDiff,
 			ComputedIfAnyAttributeChanged(rowAccessPolicySchema, DescribeOutputAttributeName, "body", "name", "signature"),
 			ComputedIfAnyAttributeChanged(rowAccessPolicySchema, FullyQualifiedNameAttributeName, "name"),
 		)),
@@ -141,7 +143,7 @@ func RowAccessPolicy() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportRowAccessPolicy(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
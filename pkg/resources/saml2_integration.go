@@ -153,14 +153,14 @@ var saml2IntegrationSchema = map[string]*schema.Schema{
 }
 
 func SAML2Integration() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.Saml2SecurityIntegration, CreateContextSAML2Integration),
-		ReadContext:   TrackingReadWrapper(resources.Saml2SecurityIntegration, ReadContextSAML2Integration(true)),
-		UpdateContext: TrackingUpdateWrapper(resources.Saml2SecurityIntegration, UpdateContextSAML2Integration),
+	return withResourceIdentity(objectIdentity[sdk.AccountObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.Saml2SecurityIntegration, OwnerRoleWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextSAML2Integration(false), CreateContextSAML2Integration)),
+		ReadContext:   TrackingReadWrapper(resources.Saml2SecurityIntegration, OwnerRoleFromGrantsReadWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextSAML2Integration(true))),
+		UpdateContext: TrackingUpdateWrapper(resources.Saml2SecurityIntegration, OwnerRoleWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextSAML2Integration(false), UpdateContextSAML2Integration)),
 		DeleteContext: TrackingDeleteWrapper(resources.Saml2SecurityIntegration, DeleteSecurityIntegration),
 		Description:   "Resource used to manage SAML2 security integration objects. For more information, check [security integrations documentation](https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-saml2).",
 
-		Schema: saml2IntegrationSchema,
+		Schema: collections.MergeMaps(saml2IntegrationSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.Saml2SecurityIntegration, ImportSaml2Integration),
 		},
@@ -178,7 +178,7 @@ func SAML2Integration() *schema.Resource {
 				"allowed_email_patterns"),
 		)),
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportSaml2Integration(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
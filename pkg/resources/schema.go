@@ -97,24 +97,25 @@ func Schema() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return withResourceIdentity(objectIdentity[sdk.DatabaseObjectIdentifier](), &schema.Resource{
 		SchemaVersion: 2,
 
-		CreateContext: TrackingCreateWrapper(resources.Schema, CreateContextSchema),
-		ReadContext:   TrackingReadWrapper(resources.Schema, ReadContextSchema(true)),
-		UpdateContext: TrackingUpdateWrapper(resources.Schema, UpdateContextSchema),
-		DeleteContext: TrackingDeleteWrapper(resources.Schema, deleteFunc),
+		CreateContext: TrackingCreateWrapper(resources.Schema, ExecutionContextWrapper(OwnerRoleWrapper(sdk.ObjectTypeSchema, sdk.ParseDatabaseObjectIdentifier, ReadContextSchema(false), CreateContextSchema))),
+		ReadContext:   TrackingReadWrapper(resources.Schema, ExecutionContextWrapper(OwnerRoleReadWrapper(ReadContextSchema(true)))),
+		UpdateContext: TrackingUpdateWrapper(resources.Schema, ExecutionContextWrapper(OwnerRoleWrapper(sdk.ObjectTypeSchema, sdk.ParseDatabaseObjectIdentifier, ReadContextSchema(false), UpdateContextSchema))),
+		DeleteContext: TrackingDeleteWrapper(resources.Schema, ExecutionContextWrapper(deleteFunc)),
 		Description:   "Resource used to manage schema objects. For more information, check [schema documentation](https://docs.snowflake.com/en/sql-reference/sql/create-schema).",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.Schema, customdiff.All(
 			ComputedIfAnyAttributeChanged(schemaSchema, ShowOutputAttributeName, "name", "comment", "with_managed_access", "is_transient"),
+			ownerRoleCustomDiff,
 			ComputedIfAnyAttributeChanged(schemaSchema, DescribeOutputAttributeName, "name"),
 			ComputedIfAnyAttributeChanged(schemaSchema, FullyQualifiedNameAttributeName, "name"),
 			ComputedIfAnyAttributeChanged(schemaParametersSchema, ParametersAttributeName, collections.Map(sdk.AsStringList(sdk.AllSchemaParameters), strings.ToLower)...),
 			schemaParametersCustomDiff,
 		)),
 
-		Schema: collections.MergeMaps(schemaSchema, schemaParametersSchema),
+		Schema: collections.MergeMaps(schemaSchema, schemaParametersSchema, executionContextSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.Schema, ImportSchema),
 		},
@@ -134,7 +135,7 @@ func Schema() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportSchema(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -90,14 +90,14 @@ var scimIntegrationSchema = map[string]*schema.Schema{
 }
 
 func SCIMIntegration() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.ScimSecurityIntegration, CreateContextSCIMIntegration),
-		ReadContext:   TrackingReadWrapper(resources.ScimSecurityIntegration, ReadContextSCIMIntegration(true)),
-		UpdateContext: TrackingUpdateWrapper(resources.ScimSecurityIntegration, UpdateContextSCIMIntegration),
+	return withResourceIdentity(objectIdentity[sdk.AccountObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.ScimSecurityIntegration, OwnerRoleWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextSCIMIntegration(false), CreateContextSCIMIntegration)),
+		ReadContext:   TrackingReadWrapper(resources.ScimSecurityIntegration, OwnerRoleFromGrantsReadWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextSCIMIntegration(true))),
+		UpdateContext: TrackingUpdateWrapper(resources.ScimSecurityIntegration, OwnerRoleWrapper(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, ReadContextSCIMIntegration(false), UpdateContextSCIMIntegration)),
 		DeleteContext: TrackingDeleteWrapper(resources.ScimSecurityIntegration, DeleteSecurityIntegration),
 		Description:   "Resource used to manage scim security integration objects. For more information, check [security integrations documentation](https://docs.snowflake.com/en/sql-reference/sql/create-security-integration-scim).",
 
-		Schema: scimIntegrationSchema,
+		Schema: collections.MergeMaps(scimIntegrationSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.ScimSecurityIntegration, ImportScimIntegration),
 		},
@@ -123,7 +123,7 @@ func SCIMIntegration() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportScimIntegration(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -60,7 +60,7 @@ func SecondaryConnection() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return withResourceIdentity(objectIdentity[sdk.AccountObjectIdentifier](), &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.SecondaryConnection, CreateContextSecondaryConnection),
 		ReadContext:   TrackingReadWrapper(resources.SecondaryConnection, ReadContextSecondaryConnection),
 		UpdateContext: TrackingUpdateWrapper(resources.SecondaryConnection, UpdateContextSecondaryConnection),
@@ -77,7 +77,7 @@ func SecondaryConnection() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.SecondaryConnection, ImportName[sdk.AccountObjectIdentifier]),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateContextSecondaryConnection(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -55,10 +55,10 @@ func SecondaryDatabase() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.SecondaryDatabase, CreateSecondaryDatabase),
-		UpdateContext: TrackingUpdateWrapper(resources.SecondaryDatabase, UpdateSecondaryDatabase),
-		ReadContext:   TrackingReadWrapper(resources.SecondaryDatabase, ReadSecondaryDatabase),
+	return withResourceIdentity(objectIdentity[sdk.AccountObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.SecondaryDatabase, OwnerRoleWrapper(sdk.ObjectTypeDatabase, sdk.ParseAccountObjectIdentifier, ReadSecondaryDatabase, CreateSecondaryDatabase)),
+		UpdateContext: TrackingUpdateWrapper(resources.SecondaryDatabase, OwnerRoleWrapper(sdk.ObjectTypeDatabase, sdk.ParseAccountObjectIdentifier, ReadSecondaryDatabase, UpdateSecondaryDatabase)),
+		ReadContext:   TrackingReadWrapper(resources.SecondaryDatabase, OwnerRoleFromGrantsReadWrapper(sdk.ObjectTypeDatabase, sdk.ParseAccountObjectIdentifier, ReadSecondaryDatabase)),
 		DeleteContext: TrackingDeleteWrapper(resources.SecondaryDatabase, deleteFunc),
 		Description:   "A secondary database creates a replica of an existing primary database (i.e. a secondary database). For more information about database replication, see [Introduction to database replication across multiple accounts](https://docs.snowflake.com/en/user-guide/db-replication-intro).",
 
@@ -66,12 +66,12 @@ func SecondaryDatabase() *schema.Resource {
 			databaseParametersCustomDiff,
 			ComputedIfAnyAttributeChanged(secondaryDatabaseSchema, FullyQualifiedNameAttributeName, "name"),
 		)),
-		Schema: collections.MergeMaps(secondaryDatabaseSchema, databaseParametersSchema),
+		Schema: collections.MergeMaps(secondaryDatabaseSchema, databaseParametersSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.SecondaryDatabase, ImportName[sdk.AccountObjectIdentifier]),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateSecondaryDatabase(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -35,25 +35,26 @@ var secretBasicAuthenticationSchema = func() map[string]*schema.Schema {
 }()
 
 func SecretWithBasicAuthentication() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.SecretWithBasicAuthentication, CreateContextSecretWithBasicAuthentication),
-		ReadContext:   TrackingReadWrapper(resources.SecretWithBasicAuthentication, ReadContextSecretWithBasicAuthentication),
-		UpdateContext: TrackingUpdateWrapper(resources.SecretWithBasicAuthentication, UpdateContextSecretWithBasicAuthentication),
+	return withResourceIdentity(objectIdentity[sdk.SchemaObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.SecretWithBasicAuthentication, OwnerRoleWrapper(sdk.ObjectTypeSecret, sdk.ParseSchemaObjectIdentifier, ReadContextSecretWithBasicAuthentication, CreateContextSecretWithBasicAuthentication)),
+		ReadContext:   TrackingReadWrapper(resources.SecretWithBasicAuthentication, OwnerRoleReadWrapper(ReadContextSecretWithBasicAuthentication)),
+		UpdateContext: TrackingUpdateWrapper(resources.SecretWithBasicAuthentication, OwnerRoleWrapper(sdk.ObjectTypeSecret, sdk.ParseSchemaObjectIdentifier, ReadContextSecretWithBasicAuthentication, UpdateContextSecretWithBasicAuthentication)),
 		DeleteContext: TrackingDeleteWrapper(resources.SecretWithBasicAuthentication, DeleteContextSecret),
 		Description:   "Resource used to manage secret objects with Basic Authentication. For more information, check [secret documentation](https://docs.snowflake.com/en/sql-reference/sql/create-secret).",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.SecretWithBasicAuthentication, customdiff.All(
 			ComputedIfAnyAttributeChanged(secretBasicAuthenticationSchema, ShowOutputAttributeName, "comment"),
+			ownerRoleCustomDiff,
 			ComputedIfAnyAttributeChanged(secretBasicAuthenticationSchema, DescribeOutputAttributeName, "username"),
 			RecreateWhenSecretTypeChangedExternally(sdk.SecretTypePassword),
 		)),
 
-		Schema: secretBasicAuthenticationSchema,
+		Schema: collections.MergeMaps(secretBasicAuthenticationSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.SecretWithBasicAuthentication, ImportSecretWithBasicAuthentication),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportSecretWithBasicAuthentication(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -29,25 +29,26 @@ var secretGenericStringSchema = func() map[string]*schema.Schema {
 }()
 
 func SecretWithGenericString() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.SecretWithGenericString, CreateContextSecretWithGenericString),
-		ReadContext:   TrackingReadWrapper(resources.SecretWithGenericString, ReadContextSecretWithGenericString),
-		UpdateContext: TrackingUpdateWrapper(resources.SecretWithGenericString, UpdateContextSecretWithGenericString),
+	return withResourceIdentity(objectIdentity[sdk.SchemaObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.SecretWithGenericString, OwnerRoleWrapper(sdk.ObjectTypeSecret, sdk.ParseSchemaObjectIdentifier, ReadContextSecretWithGenericString, CreateContextSecretWithGenericString)),
+		ReadContext:   TrackingReadWrapper(resources.SecretWithGenericString, OwnerRoleReadWrapper(ReadContextSecretWithGenericString)),
+		UpdateContext: TrackingUpdateWrapper(resources.SecretWithGenericString, OwnerRoleWrapper(sdk.ObjectTypeSecret, sdk.ParseSchemaObjectIdentifier, ReadContextSecretWithGenericString, UpdateContextSecretWithGenericString)),
 		DeleteContext: TrackingDeleteWrapper(resources.SecretWithGenericString, DeleteContextSecret),
 		Description:   "Resource used to manage secret objects with Generic String. For more information, check [secret documentation](https://docs.snowflake.com/en/sql-reference/sql/create-secret).",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.SecretWithGenericString, customdiff.All(
 			ComputedIfAnyAttributeChanged(secretGenericStringSchema, ShowOutputAttributeName, "comment"),
+			ownerRoleCustomDiff,
 			ComputedIfAnyAttributeChanged(secretGenericStringSchema, DescribeOutputAttributeName),
 			RecreateWhenSecretTypeChangedExternally(sdk.SecretTypeGenericString),
 		)),
 
-		Schema: secretGenericStringSchema,
+		Schema: collections.MergeMaps(secretGenericStringSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.SecretWithGenericString, ImportSecretWithGenericString),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportSecretWithGenericString(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
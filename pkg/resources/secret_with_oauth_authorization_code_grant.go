@@ -42,25 +42,26 @@ var secretAuthorizationCodeGrantSchema = func() map[string]*schema.Schema {
 }()
 
 func SecretWithAuthorizationCodeGrant() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.SecretWithAuthorizationCodeGrant, CreateContextSecretWithAuthorizationCodeGrant),
-		ReadContext:   TrackingReadWrapper(resources.SecretWithAuthorizationCodeGrant, ReadContextSecretWithAuthorizationCodeGrant(true)),
-		UpdateContext: TrackingUpdateWrapper(resources.SecretWithAuthorizationCodeGrant, UpdateContextSecretWithAuthorizationCodeGrant),
+	return withResourceIdentity(objectIdentity[sdk.SchemaObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.SecretWithAuthorizationCodeGrant, OwnerRoleWrapper(sdk.ObjectTypeSecret, sdk.ParseSchemaObjectIdentifier, ReadContextSecretWithAuthorizationCodeGrant(false), CreateContextSecretWithAuthorizationCodeGrant)),
+		ReadContext:   TrackingReadWrapper(resources.SecretWithAuthorizationCodeGrant, OwnerRoleReadWrapper(ReadContextSecretWithAuthorizationCodeGrant(true))),
+		UpdateContext: TrackingUpdateWrapper(resources.SecretWithAuthorizationCodeGrant, OwnerRoleWrapper(sdk.ObjectTypeSecret, sdk.ParseSchemaObjectIdentifier, ReadContextSecretWithAuthorizationCodeGrant(false), UpdateContextSecretWithAuthorizationCodeGrant)),
 		DeleteContext: TrackingDeleteWrapper(resources.SecretWithAuthorizationCodeGrant, DeleteContextSecret),
 		Description:   "Resource used to manage secret objects with OAuth Authorization Code Grant. For more information, check [secret documentation](https://docs.snowflake.com/en/sql-reference/sql/create-secret).",
 
-		Schema: secretAuthorizationCodeGrantSchema,
+		Schema: collections.MergeMaps(secretAuthorizationCodeGrantSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.SecretWithAuthorizationCodeGrant, ImportSecretWithAuthorizationCodeGrant),
 		},
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.SecretWithAuthorizationCodeGrant, customdiff.All(
 			ComputedIfAnyAttributeChanged(secretAuthorizationCodeGrantSchema, ShowOutputAttributeName, "comment"),
+			ownerRoleCustomDiff,
 			ComputedIfAnyAttributeChanged(secretAuthorizationCodeGrantSchema, DescribeOutputAttributeName, "oauth_refresh_token_expiry_time", "api_authentication"),
 			RecreateWhenSecretTypeChangedExternally(sdk.SecretTypeOAuth2AuthorizationCodeGrant),
 		)),
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportSecretWithAuthorizationCodeGrant(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
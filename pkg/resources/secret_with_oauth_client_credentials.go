@@ -36,25 +36,26 @@ var secretClientCredentialsSchema = func() map[string]*schema.Schema {
 }()
 
 func SecretWithClientCredentials() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.SecretWithClientCredentials, CreateContextSecretWithClientCredentials),
-		ReadContext:   TrackingReadWrapper(resources.SecretWithClientCredentials, ReadContextSecretWithClientCredentials),
-		UpdateContext: TrackingUpdateWrapper(resources.SecretWithClientCredentials, UpdateContextSecretWithClientCredentials),
+	return withResourceIdentity(objectIdentity[sdk.SchemaObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.SecretWithClientCredentials, OwnerRoleWrapper(sdk.ObjectTypeSecret, sdk.ParseSchemaObjectIdentifier, ReadContextSecretWithClientCredentials, CreateContextSecretWithClientCredentials)),
+		ReadContext:   TrackingReadWrapper(resources.SecretWithClientCredentials, OwnerRoleReadWrapper(ReadContextSecretWithClientCredentials)),
+		UpdateContext: TrackingUpdateWrapper(resources.SecretWithClientCredentials, OwnerRoleWrapper(sdk.ObjectTypeSecret, sdk.ParseSchemaObjectIdentifier, ReadContextSecretWithClientCredentials, UpdateContextSecretWithClientCredentials)),
 		DeleteContext: TrackingDeleteWrapper(resources.SecretWithClientCredentials, DeleteContextSecret),
 		Description:   "Resource used to manage secret objects with OAuth Client Credentials. For more information, check [secret documentation](https://docs.snowflake.com/en/sql-reference/sql/create-secret).",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.SecretWithClientCredentials, customdiff.All(
 			ComputedIfAnyAttributeChanged(secretClientCredentialsSchema, DescribeOutputAttributeName, "oauth_scopes", "api_authentication"),
 			ComputedIfAnyAttributeChanged(secretClientCredentialsSchema, ShowOutputAttributeName, "comment"),
+			ownerRoleCustomDiff,
 			RecreateWhenSecretTypeChangedExternally(sdk.SecretTypeOAuth2ClientCredentials),
 		)),
 
-		Schema: secretClientCredentialsSchema,
+		Schema: collections.MergeMaps(secretClientCredentialsSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.SecretWithClientCredentials, ImportSecretWithClientCredentials),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportSecretWithClientCredentials(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -56,10 +56,10 @@ func SharedDatabase() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.SharedDatabase, CreateSharedDatabase),
-		UpdateContext: TrackingUpdateWrapper(resources.SharedDatabase, UpdateSharedDatabase),
-		ReadContext:   TrackingReadWrapper(resources.SharedDatabase, ReadSharedDatabase),
+	return withResourceIdentity(objectIdentity[sdk.AccountObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.SharedDatabase, OwnerRoleWrapper(sdk.ObjectTypeDatabase, sdk.ParseAccountObjectIdentifier, ReadSharedDatabase, CreateSharedDatabase)),
+		UpdateContext: TrackingUpdateWrapper(resources.SharedDatabase, OwnerRoleWrapper(sdk.ObjectTypeDatabase, sdk.ParseAccountObjectIdentifier, ReadSharedDatabase, UpdateSharedDatabase)),
+		ReadContext:   TrackingReadWrapper(resources.SharedDatabase, OwnerRoleFromGrantsReadWrapper(sdk.ObjectTypeDatabase, sdk.ParseAccountObjectIdentifier, ReadSharedDatabase)),
 		DeleteContext: TrackingDeleteWrapper(resources.SharedDatabase, deleteFunc),
 		Description:   "A shared database creates a database from a share provided by another Snowflake account. For more information about shares, see [Introduction to Secure Data Sharing](https://docs.snowflake.com/en/user-guide/data-sharing-intro).",
 
@@ -67,12 +67,12 @@ func SharedDatabase() *schema.Resource {
 			ComputedIfAnyAttributeChanged(sharedDatabaseSchema, FullyQualifiedNameAttributeName, "name"),
 		)),
 
-		Schema: collections.MergeMaps(sharedDatabaseSchema, sharedDatabaseParametersSchema),
+		Schema: collections.MergeMaps(sharedDatabaseSchema, sharedDatabaseParametersSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.SharedDatabase, ImportName[sdk.AccountObjectIdentifier]),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateSharedDatabase(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
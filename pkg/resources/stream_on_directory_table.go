@@ -33,27 +33,28 @@ var streamOnDirectoryTableSchema = func() map[string]*schema.Schema {
 }()
 
 func StreamOnDirectoryTable() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.StreamOnDirectoryTable, CreateStreamOnDirectoryTable(false)),
-		ReadContext:   TrackingReadWrapper(resources.StreamOnDirectoryTable, ReadStreamOnDirectoryTable(true)),
-		UpdateContext: TrackingUpdateWrapper(resources.StreamOnDirectoryTable, UpdateStreamOnDirectoryTable),
+	return withResourceIdentity(objectIdentity[sdk.SchemaObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.StreamOnDirectoryTable, OwnerRoleWrapper(sdk.ObjectTypeStream, sdk.ParseSchemaObjectIdentifier, ReadStreamOnDirectoryTable(false), CreateStreamOnDirectoryTable(false))),
+		ReadContext:   TrackingReadWrapper(resources.StreamOnDirectoryTable, OwnerRoleReadWrapper(ReadStreamOnDirectoryTable(true))),
+		UpdateContext: TrackingUpdateWrapper(resources.StreamOnDirectoryTable, OwnerRoleWrapper(sdk.ObjectTypeStream, sdk.ParseSchemaObjectIdentifier, ReadStreamOnDirectoryTable(false), UpdateStreamOnDirectoryTable)),
 		DeleteContext: TrackingDeleteWrapper(resources.StreamOnDirectoryTable, DeleteStreamContext),
 		Description:   "Resource used to manage streams on directory tables. For more information, check [stream documentation](https://docs.snowflake.com/en/sql-reference/sql/create-stream).",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.StreamOnDirectoryTable, customdiff.All(
 			ComputedIfAnyAttributeChanged(streamOnDirectoryTableSchema, ShowOutputAttributeName, "stage", "comment"),
+			ownerRoleCustomDiff,
 			ComputedIfAnyAttributeChanged(streamOnDirectoryTableSchema, DescribeOutputAttributeName, "stage", "comment"),
 			RecreateWhenStreamIsStale(),
 			RecreateWhenStreamTypeChangedExternally(sdk.StreamSourceTypeStage),
 		)),
 
-		Schema: streamOnDirectoryTableSchema,
+		Schema: collections.MergeMaps(streamOnDirectoryTableSchema, ownerRoleSchema),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.StreamOnDirectoryTable, ImportName[sdk.SchemaObjectIdentifier]),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateStreamOnDirectoryTable(orReplace bool) schema.CreateContextFunc {
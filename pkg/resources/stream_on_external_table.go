@@ -44,27 +44,28 @@ var streamOnExternalTableSchema = func() map[string]*schema.Schema {
 }()
 
 func StreamOnExternalTable() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.StreamOnExternalTable, CreateStreamOnExternalTable(false)),
-		ReadContext:   TrackingReadWrapper(resources.StreamOnExternalTable, ReadStreamOnExternalTable(true)),
-		UpdateContext: TrackingUpdateWrapper(resources.StreamOnExternalTable, UpdateStreamOnExternalTable),
+	return withResourceIdentity(objectIdentity[sdk.SchemaObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.StreamOnExternalTable, OwnerRoleWrapper(sdk.ObjectTypeStream, sdk.ParseSchemaObjectIdentifier, ReadStreamOnExternalTable(false), CreateStreamOnExternalTable(false))),
+		ReadContext:   TrackingReadWrapper(resources.StreamOnExternalTable, OwnerRoleReadWrapper(ReadStreamOnExternalTable(true))),
+		UpdateContext: TrackingUpdateWrapper(resources.StreamOnExternalTable, OwnerRoleWrapper(sdk.ObjectTypeStream, sdk.ParseSchemaObjectIdentifier, ReadStreamOnExternalTable(false), UpdateStreamOnExternalTable)),
 		DeleteContext: TrackingDeleteWrapper(resources.StreamOnExternalTable, DeleteStreamContext),
 		Description:   "Resource used to manage streams on external tables. For more information, check [stream documentation](https://docs.snowflake.com/en/sql-reference/sql/create-stream).",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.StreamOnExternalTable, customdiff.All(
 			ComputedIfAnyAttributeChanged(streamOnExternalTableSchema, ShowOutputAttributeName, "external_table", "insert_only", "comment"),
+			ownerRoleCustomDiff,
 			ComputedIfAnyAttributeChanged(streamOnExternalTableSchema, DescribeOutputAttributeName, "external_table", "insert_only", "comment"),
 			RecreateWhenStreamIsStale(),
 			RecreateWhenStreamTypeChangedExternally(sdk.StreamSourceTypeExternalTable),
 		)),
 
-		Schema: streamOnExternalTableSchema,
+		Schema: collections.MergeMaps(streamOnExternalTableSchema, ownerRoleSchema),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.StreamOnExternalTable, ImportStreamOnExternalTable),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportStreamOnExternalTable(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -51,27 +51,28 @@ var streamOnTableSchema = func() map[string]*schema.Schema {
 }()
 
 func StreamOnTable() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.StreamOnTable, CreateStreamOnTable(false)),
-		ReadContext:   TrackingReadWrapper(resources.StreamOnTable, ReadStreamOnTable(true)),
-		UpdateContext: TrackingUpdateWrapper(resources.StreamOnTable, UpdateStreamOnTable),
+	return withResourceIdentity(objectIdentity[sdk.SchemaObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.StreamOnTable, OwnerRoleWrapper(sdk.ObjectTypeStream, sdk.ParseSchemaObjectIdentifier, ReadStreamOnTable(false), CreateStreamOnTable(false))),
+		ReadContext:   TrackingReadWrapper(resources.StreamOnTable, OwnerRoleReadWrapper(ReadStreamOnTable(true))),
+		UpdateContext: TrackingUpdateWrapper(resources.StreamOnTable, OwnerRoleWrapper(sdk.ObjectTypeStream, sdk.ParseSchemaObjectIdentifier, ReadStreamOnTable(false), UpdateStreamOnTable)),
 		DeleteContext: TrackingDeleteWrapper(resources.StreamOnTable, DeleteStreamContext),
 		Description:   "Resource used to manage streams on tables. For more information, check [stream documentation](https://docs.snowflake.com/en/sql-reference/sql/create-stream).",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.StreamOnTable, customdiff.All(
 			ComputedIfAnyAttributeChanged(streamOnTableSchema, ShowOutputAttributeName, "table", "append_only", "comment"),
+			ownerRoleCustomDiff,
 			ComputedIfAnyAttributeChanged(streamOnTableSchema, DescribeOutputAttributeName, "table", "append_only", "comment"),
 			RecreateWhenStreamIsStale(),
 			RecreateWhenStreamTypeChangedExternally(sdk.StreamSourceTypeTable),
 		)),
 
-		Schema: streamOnTableSchema,
+		Schema: collections.MergeMaps(streamOnTableSchema, ownerRoleSchema),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.StreamOnTable, ImportStreamOnTable),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportStreamOnTable(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -51,27 +51,28 @@ var StreamOnViewSchema = func() map[string]*schema.Schema {
 }()
 
 func StreamOnView() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.StreamOnView, CreateStreamOnView(false)),
-		ReadContext:   TrackingReadWrapper(resources.StreamOnView, ReadStreamOnView(true)),
-		UpdateContext: TrackingUpdateWrapper(resources.StreamOnView, UpdateStreamOnView),
+	return withResourceIdentity(objectIdentity[sdk.SchemaObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.StreamOnView, OwnerRoleWrapper(sdk.ObjectTypeStream, sdk.ParseSchemaObjectIdentifier, ReadStreamOnView(false), CreateStreamOnView(false))),
+		ReadContext:   TrackingReadWrapper(resources.StreamOnView, OwnerRoleReadWrapper(ReadStreamOnView(true))),
+		UpdateContext: TrackingUpdateWrapper(resources.StreamOnView, OwnerRoleWrapper(sdk.ObjectTypeStream, sdk.ParseSchemaObjectIdentifier, ReadStreamOnView(false), UpdateStreamOnView)),
 		DeleteContext: TrackingDeleteWrapper(resources.StreamOnView, DeleteStreamContext),
 		Description:   "Resource used to manage streams on views. For more information, check [stream documentation](https://docs.snowflake.com/en/sql-reference/sql/create-stream).",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.StreamOnView, customdiff.All(
 			ComputedIfAnyAttributeChanged(StreamOnViewSchema, ShowOutputAttributeName, "view", "append_only", "comment"),
+			ownerRoleCustomDiff,
 			ComputedIfAnyAttributeChanged(StreamOnViewSchema, DescribeOutputAttributeName, "view", "append_only", "comment"),
 			RecreateWhenStreamIsStale(),
 			RecreateWhenStreamTypeChangedExternally(sdk.StreamSourceTypeView),
 		)),
 
-		Schema: StreamOnViewSchema,
+		Schema: collections.MergeMaps(StreamOnViewSchema, ownerRoleSchema),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.StreamOnView, ImportStreamOnView),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportStreamOnView(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
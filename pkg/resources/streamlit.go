@@ -7,6 +7,7 @@ import (
 	"path"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
@@ -111,22 +112,23 @@ func Streamlit() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return withResourceIdentity(objectIdentity[sdk.SchemaObjectIdentifier](), &schema.Resource{
 		SchemaVersion: 1,
 
-		CreateContext: TrackingCreateWrapper(resources.Streamlit, CreateContextStreamlit),
-		ReadContext:   TrackingReadWrapper(resources.Streamlit, ReadContextStreamlit),
-		UpdateContext: TrackingUpdateWrapper(resources.Streamlit, UpdateContextStreamlit),
+		CreateContext: TrackingCreateWrapper(resources.Streamlit, OwnerRoleWrapper(sdk.ObjectTypeStreamlit, sdk.ParseSchemaObjectIdentifier, ReadContextStreamlit, CreateContextStreamlit)),
+		ReadContext:   TrackingReadWrapper(resources.Streamlit, OwnerRoleReadWrapper(ReadContextStreamlit)),
+		UpdateContext: TrackingUpdateWrapper(resources.Streamlit, OwnerRoleWrapper(sdk.ObjectTypeStreamlit, sdk.ParseSchemaObjectIdentifier, ReadContextStreamlit, UpdateContextStreamlit)),
 		DeleteContext: TrackingDeleteWrapper(resources.Streamlit, deleteFunc),
 		Description:   "Resource used to manage streamlits objects. For more information, check [streamlit documentation](https://docs.snowflake.com/en/sql-reference/commands-streamlit).",
 
-		Schema: streamlitSchema,
+		Schema: collections.MergeMaps(streamlitSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.Streamlit, ImportStreamlit),
 		},
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.Streamlit, customdiff.All(
 			ComputedIfAnyAttributeChanged(streamlitSchema, ShowOutputAttributeName, "name", "title", "comment", "query_warehouse"),
+			ownerRoleCustomDiff,
 			ComputedIfAnyAttributeChanged(streamlitSchema, FullyQualifiedNameAttributeName, "name"),
 			ComputedIfAnyAttributeChanged(streamlitSchema, DescribeOutputAttributeName, "title", "comment", "root_location", "main_file", "query_warehouse", "external_access_integrations"),
 		)),
@@ -140,7 +142,7 @@ func Streamlit() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportStreamlit(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
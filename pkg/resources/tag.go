@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 
 	"github.com/hashicorp/go-cty/cty"
@@ -106,21 +107,22 @@ var tagReferenceSchema = &schema.Schema{
 }
 
 func Tag() *schema.Resource {
-	return &schema.Resource{
+	return withResourceIdentity(objectIdentity[sdk.SchemaObjectIdentifier](), &schema.Resource{
 		SchemaVersion: 1,
 
-		CreateContext: TrackingCreateWrapper(resources.Tag, CreateContextTag),
-		ReadContext:   TrackingReadWrapper(resources.Tag, ReadContextTag),
-		UpdateContext: TrackingUpdateWrapper(resources.Tag, UpdateContextTag),
+		CreateContext: TrackingCreateWrapper(resources.Tag, OwnerRoleWrapper(sdk.ObjectTypeTag, sdk.ParseSchemaObjectIdentifier, ReadContextTag, CreateContextTag)),
+		ReadContext:   TrackingReadWrapper(resources.Tag, OwnerRoleReadWrapper(ReadContextTag)),
+		UpdateContext: TrackingUpdateWrapper(resources.Tag, OwnerRoleWrapper(sdk.ObjectTypeTag, sdk.ParseSchemaObjectIdentifier, ReadContextTag, UpdateContextTag)),
 		DeleteContext: TrackingDeleteWrapper(resources.Tag, DeleteContextTag),
 		Description:   "Resource used to manage tags. For more information, check [tag documentation](https://docs.snowflake.com/en/sql-reference/sql/create-tag). For assigning tags to Snowflake objects, see [tag_association resource](./tag_association).",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.Tag, customdiff.All(
 			ComputedIfAnyAttributeChanged(tagSchema, ShowOutputAttributeName, "name", "comment", "allowed_values"),
+			ownerRoleCustomDiff,
 			ComputedIfAnyAttributeChanged(tagSchema, FullyQualifiedNameAttributeName, "name"),
 		)),
 
-		Schema: tagSchema,
+		Schema: collections.MergeMaps(tagSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.Tag, ImportName[sdk.SchemaObjectIdentifier]),
 		},
@@ -134,7 +136,7 @@ func Tag() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateContextTag(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
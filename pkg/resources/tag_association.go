@@ -61,7 +61,7 @@ var tagAssociationSchema = map[string]*schema.Schema{
 
 // TagAssociation returns a pointer to the resource representing a schema.
 func TagAssociation() *schema.Resource {
-	return &schema.Resource{
+	return withResourceIdentity(tagAssociationIdentity, &schema.Resource{
 		SchemaVersion: 1,
 
 		CreateContext: TrackingCreateWrapper(resources.TagAssociation, CreateContextTagAssociation),
@@ -90,7 +90,45 @@ func TagAssociation() *schema.Resource {
 				Upgrade: v0_98_0_TagAssociationStateUpgrader,
 			},
 		},
-	}
+	})
+}
+
+var tagAssociationIdentity = resourceIdentity{
+	schemaFunc: func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"tag_id":      identityStringSchema("Fully qualified name of the tag.", true),
+			"tag_value":   identityStringSchema("Value of the tag.", true),
+			"object_type": identityStringSchema("Type of the tagged objects.", true),
+		}
+	},
+	fromId: func(id string, identity *schema.IdentityData) error {
+		idParts := helpers.ParseResourceIdentifier(id)
+		if len(idParts) != 3 {
+			return fmt.Errorf("invalid resource id: expected 3 arguments, but got %d", len(idParts))
+		}
+		return setIdentityAttributes(identity, map[string]any{
+			"tag_id":      idParts[0],
+			"tag_value":   idParts[1],
+			"object_type": idParts[2],
+		})
+	},
+	toId: func(identity *schema.IdentityData) (string, error) {
+		tagIdRaw, tagIdErr := requiredIdentityString(identity, "tag_id")
+		tagValue, tagValueErr := requiredIdentityString(identity, "tag_value")
+		objectTypeRaw, objectTypeErr := requiredIdentityString(identity, "object_type")
+		if err := errors.Join(tagIdErr, tagValueErr, objectTypeErr); err != nil {
+			return "", err
+		}
+		tagId, err := sdk.ParseSchemaObjectIdentifier(tagIdRaw)
+		if err != nil {
+			return "", err
+		}
+		objectType, err := sdk.ToObjectType(objectTypeRaw)
+		if err != nil {
+			return "", err
+		}
+		return helpers.EncodeResourceIdentifier(tagId.FullyQualifiedName(), tagValue, string(objectType)), nil
+	},
 }
 
 func ImportTagAssociation(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
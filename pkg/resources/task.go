@@ -169,20 +169,21 @@ var taskSchema = map[string]*schema.Schema{
 }
 
 func Task() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.Task, CreateTask),
-		UpdateContext: TrackingUpdateWrapper(resources.Task, UpdateTask),
-		ReadContext:   TrackingReadWrapper(resources.Task, ReadTask(true)),
+	return withResourceIdentity(objectIdentity[sdk.SchemaObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.Task, OwnerRoleWrapper(sdk.ObjectTypeTask, sdk.ParseSchemaObjectIdentifier, ReadTask(false), CreateTask)),
+		UpdateContext: TrackingUpdateWrapper(resources.Task, OwnerRoleWrapper(sdk.ObjectTypeTask, sdk.ParseSchemaObjectIdentifier, ReadTask(false), UpdateTask)),
+		ReadContext:   TrackingReadWrapper(resources.Task, OwnerRoleReadWrapper(ReadTask(true))),
 		DeleteContext: TrackingDeleteWrapper(resources.Task, DeleteTask),
 		Description:   "Resource used to manage task objects. For more information, check [task documentation](https://docs.snowflake.com/en/user-guide/tasks-intro).",
 
-		Schema: collections.MergeMaps(taskSchema, taskParametersSchema),
+		Schema: collections.MergeMaps(taskSchema, taskParametersSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.Task, ImportTask),
 		},
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.Task, customdiff.All(
 			ComputedIfAnyAttributeChanged(taskSchema, ShowOutputAttributeName, "name", "started", "warehouse", "user_task_managed_initial_warehouse_size", "schedule", "config", "allow_overlapping_execution", "error_integration", "comment", "finalize", "after", "when"),
+			ownerRoleCustomDiff,
 			ComputedIfAnyAttributeChanged(taskParametersSchema, ParametersAttributeName, collections.Map(sdk.AsStringList(sdk.AllTaskParameters), strings.ToLower)...),
 			ComputedIfAnyAttributeChanged(taskSchema, FullyQualifiedNameAttributeName, "name"),
 			taskParametersCustomDiff,
@@ -197,7 +198,7 @@ func Task() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportTask(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
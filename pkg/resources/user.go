@@ -182,22 +182,23 @@ var userSchema = map[string]*schema.Schema{
 }
 
 func User() *schema.Resource {
-	return &schema.Resource{
+	return withResourceIdentity(objectIdentity[sdk.AccountObjectIdentifier](), &schema.Resource{
 		SchemaVersion: 1,
 
-		CreateContext: TrackingCreateWrapper(resources.User, GetCreateUserFunc(sdk.UserTypePerson)),
-		UpdateContext: TrackingUpdateWrapper(resources.User, GetUpdateUserFunc(sdk.UserTypePerson)),
-		ReadContext:   TrackingReadWrapper(resources.User, GetReadUserFunc(sdk.UserTypePerson, true)),
+		CreateContext: TrackingCreateWrapper(resources.User, OwnerRoleWrapper(sdk.ObjectTypeUser, sdk.ParseAccountObjectIdentifier, GetReadUserFunc(sdk.UserTypePerson, false), GetCreateUserFunc(sdk.UserTypePerson))),
+		UpdateContext: TrackingUpdateWrapper(resources.User, OwnerRoleWrapper(sdk.ObjectTypeUser, sdk.ParseAccountObjectIdentifier, GetReadUserFunc(sdk.UserTypePerson, false), GetUpdateUserFunc(sdk.UserTypePerson))),
+		ReadContext:   TrackingReadWrapper(resources.User, OwnerRoleReadWrapper(GetReadUserFunc(sdk.UserTypePerson, true))),
 		DeleteContext: TrackingDeleteWrapper(resources.User, DeleteUser),
 		Description:   "Resource used to manage user objects. For more information, check [user documentation](https://docs.snowflake.com/en/sql-reference/commands-user-role#user-management).",
 
-		Schema: collections.MergeMaps(userSchema, userParametersSchema),
+		Schema: collections.MergeMaps(userSchema, userParametersSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.User, GetImportUserFunc(sdk.UserTypePerson)),
 		},
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.User, customdiff.All(
 			ComputedIfAnyAttributeChanged(userSchema, ShowOutputAttributeName, userExternalChangesAttributes...),
+			ownerRoleCustomDiff,
 			ComputedIfAnyAttributeChanged(userParametersSchema, ParametersAttributeName, collections.Map(sdk.AsStringList(sdk.AllUserParameters), strings.ToLower)...),
 			ComputedIfAnyAttributeChanged(userSchema, FullyQualifiedNameAttributeName, "name"),
 			userParametersCustomDiff,
@@ -213,53 +214,55 @@ func User() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ServiceUser() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.ServiceUser, GetCreateUserFunc(sdk.UserTypeService)),
-		UpdateContext: TrackingUpdateWrapper(resources.ServiceUser, GetUpdateUserFunc(sdk.UserTypeService)),
-		ReadContext:   TrackingReadWrapper(resources.ServiceUser, GetReadUserFunc(sdk.UserTypeService, true)),
+	return withResourceIdentity(objectIdentity[sdk.AccountObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.ServiceUser, OwnerRoleWrapper(sdk.ObjectTypeUser, sdk.ParseAccountObjectIdentifier, GetReadUserFunc(sdk.UserTypeService, false), GetCreateUserFunc(sdk.UserTypeService))),
+		UpdateContext: TrackingUpdateWrapper(resources.ServiceUser, OwnerRoleWrapper(sdk.ObjectTypeUser, sdk.ParseAccountObjectIdentifier, GetReadUserFunc(sdk.UserTypeService, false), GetUpdateUserFunc(sdk.UserTypeService))),
+		ReadContext:   TrackingReadWrapper(resources.ServiceUser, OwnerRoleReadWrapper(GetReadUserFunc(sdk.UserTypeService, true))),
 		DeleteContext: TrackingDeleteWrapper(resources.ServiceUser, DeleteUser),
 		Description:   "Resource used to manage service user objects. For more information, check [user documentation](https://docs.snowflake.com/en/sql-reference/commands-user-role#user-management).",
 
-		Schema: collections.MergeMaps(serviceUserSchema, userParametersSchema),
+		Schema: collections.MergeMaps(serviceUserSchema, userParametersSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.ServiceUser, GetImportUserFunc(sdk.UserTypeService)),
 		},
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.ServiceUser, customdiff.All(
 			ComputedIfAnyAttributeChanged(userSchema, ShowOutputAttributeName, serviceUserExternalChangesAttributes...),
+			ownerRoleCustomDiff,
 			ComputedIfAnyAttributeChanged(userParametersSchema, ParametersAttributeName, collections.Map(sdk.AsStringList(sdk.AllUserParameters), strings.ToLower)...),
 			ComputedIfAnyAttributeChanged(userSchema, FullyQualifiedNameAttributeName, "name"),
 			userParametersCustomDiff,
 			RecreateWhenUserTypeChangedExternally(sdk.UserTypeService),
 		)),
-	}
+	})
 }
 
 func LegacyServiceUser() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: TrackingCreateWrapper(resources.LegacyServiceUser, GetCreateUserFunc(sdk.UserTypeLegacyService)),
-		UpdateContext: TrackingUpdateWrapper(resources.LegacyServiceUser, GetUpdateUserFunc(sdk.UserTypeLegacyService)),
-		ReadContext:   TrackingReadWrapper(resources.LegacyServiceUser, GetReadUserFunc(sdk.UserTypeLegacyService, true)),
+	return withResourceIdentity(objectIdentity[sdk.AccountObjectIdentifier](), &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.LegacyServiceUser, OwnerRoleWrapper(sdk.ObjectTypeUser, sdk.ParseAccountObjectIdentifier, GetReadUserFunc(sdk.UserTypeLegacyService, false), GetCreateUserFunc(sdk.UserTypeLegacyService))),
+		UpdateContext: TrackingUpdateWrapper(resources.LegacyServiceUser, OwnerRoleWrapper(sdk.ObjectTypeUser, sdk.ParseAccountObjectIdentifier, GetReadUserFunc(sdk.UserTypeLegacyService, false), GetUpdateUserFunc(sdk.UserTypeLegacyService))),
+		ReadContext:   TrackingReadWrapper(resources.LegacyServiceUser, OwnerRoleReadWrapper(GetReadUserFunc(sdk.UserTypeLegacyService, true))),
 		DeleteContext: TrackingDeleteWrapper(resources.LegacyServiceUser, DeleteUser),
 		Description:   "Resource used to manage legacy service user objects. For more information, check [user documentation](https://docs.snowflake.com/en/sql-reference/commands-user-role#user-management).",
 
-		Schema: collections.MergeMaps(legacyServiceUserSchema, userParametersSchema),
+		Schema: collections.MergeMaps(legacyServiceUserSchema, userParametersSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.LegacyServiceUser, GetImportUserFunc(sdk.UserTypeLegacyService)),
 		},
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.LegacyServiceUser, customdiff.All(
 			ComputedIfAnyAttributeChanged(userSchema, ShowOutputAttributeName, legacyServiceUserExternalChangesAttributes...),
+			ownerRoleCustomDiff,
 			ComputedIfAnyAttributeChanged(userParametersSchema, ParametersAttributeName, collections.Map(sdk.AsStringList(sdk.AllUserParameters), strings.ToLower)...),
 			ComputedIfAnyAttributeChanged(userSchema, FullyQualifiedNameAttributeName, "name"),
 			userParametersCustomDiff,
 			RecreateWhenUserTypeChangedExternally(sdk.UserTypeLegacyService),
 		)),
-	}
+	})
 }
 
 func GetImportUserFunc(userType sdk.UserType) func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
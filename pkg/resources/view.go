@@ -287,21 +287,22 @@ func View() *schema.Resource {
 		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] { return client.Views.DropSafely },
 	)
 
-	return &schema.Resource{
+	return withResourceIdentity(objectIdentity[sdk.SchemaObjectIdentifier](), &schema.Resource{
 		SchemaVersion: 1,
 
-		CreateContext: TrackingCreateWrapper(resources.View, CreateView(false)),
-		ReadContext:   TrackingReadWrapper(resources.View, ReadView(true)),
-		UpdateContext: TrackingUpdateWrapper(resources.View, UpdateView),
-		DeleteContext: TrackingDeleteWrapper(resources.View, deleteFunc),
+		CreateContext: TrackingCreateWrapper(resources.View, ExecutionContextWrapper(OwnerRoleWrapper(sdk.ObjectTypeView, sdk.ParseSchemaObjectIdentifier, ReadView(false), CreateView(false)))),
+		ReadContext:   TrackingReadWrapper(resources.View, ExecutionContextWrapper(OwnerRoleReadWrapper(ReadView(true)))),
+		UpdateContext: TrackingUpdateWrapper(resources.View, ExecutionContextWrapper(OwnerRoleWrapper(sdk.ObjectTypeView, sdk.ParseSchemaObjectIdentifier, ReadView(false), UpdateView))),
+		DeleteContext: TrackingDeleteWrapper(resources.View, ExecutionContextWrapper(deleteFunc)),
 		Description:   "Resource used to manage view objects. For more information, check [view documentation](https://docs.snowflake.com/en/sql-reference/sql/create-view).",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.View, customdiff.All(
 			ComputedIfAnyAttributeChanged(viewSchema, ShowOutputAttributeName, "comment", "change_tracking", "is_secure", "is_temporary", "is_recursive", "statement"),
+			ownerRoleCustomDiff,
 			ComputedIfAnyAttributeChanged(viewSchema, FullyQualifiedNameAttributeName, "name"),
 		)),
 
-		Schema: viewSchema,
+		Schema: collections.MergeMaps(viewSchema, executionContextSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.View, ImportView),
 		},
@@ -315,7 +316,7 @@ func View() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportView(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
@@ -195,22 +196,23 @@ func Warehouse() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return withResourceIdentity(objectIdentity[sdk.AccountObjectIdentifier](), &schema.Resource{
 		SchemaVersion: 1,
 
-		CreateContext: TrackingCreateWrapper(resources.Warehouse, CreateWarehouse),
-		UpdateContext: TrackingUpdateWrapper(resources.Warehouse, UpdateWarehouse),
-		ReadContext:   TrackingReadWrapper(resources.Warehouse, GetReadWarehouseFunc(true)),
+		CreateContext: TrackingCreateWrapper(resources.Warehouse, OwnerRoleWrapper(sdk.ObjectTypeWarehouse, sdk.ParseAccountObjectIdentifier, GetReadWarehouseFunc(false), CreateWarehouse)),
+		UpdateContext: TrackingUpdateWrapper(resources.Warehouse, OwnerRoleWrapper(sdk.ObjectTypeWarehouse, sdk.ParseAccountObjectIdentifier, GetReadWarehouseFunc(false), UpdateWarehouse)),
+		ReadContext:   TrackingReadWrapper(resources.Warehouse, OwnerRoleReadWrapper(GetReadWarehouseFunc(true))),
 		DeleteContext: TrackingDeleteWrapper(resources.Warehouse, deleteFunc),
 		Description:   "Resource used to manage warehouse objects. For more information, check [warehouse documentation](https://docs.snowflake.com/en/sql-reference/commands-warehouse).",
 
-		Schema: warehouseSchema,
+		Schema: collections.MergeMaps(warehouseSchema, ownerRoleSchema),
 		Importer: &schema.ResourceImporter{
 			StateContext: TrackingImportWrapper(resources.Warehouse, ImportWarehouse),
 		},
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.Warehouse, customdiff.All(
 			ComputedIfAnyAttributeChanged(warehouseSchema, ShowOutputAttributeName, "name", "warehouse_type", "warehouse_size", "max_cluster_count", "min_cluster_count", "scaling_policy", "auto_suspend", "auto_resume", "resource_monitor", "comment", "enable_query_acceleration", "query_acceleration_max_scale_factor"),
+			ownerRoleCustomDiff,
 			ComputedIfAnyAttributeChanged(warehouseSchema, ParametersAttributeName, strings.ToLower(string(sdk.ObjectParameterMaxConcurrencyLevel)), strings.ToLower(string(sdk.ObjectParameterStatementQueuedTimeoutInSeconds)), strings.ToLower(string(sdk.ObjectParameterStatementTimeoutInSeconds))),
 			ComputedIfAnyAttributeChanged(warehouseSchema, FullyQualifiedNameAttributeName, "name"),
 
@@ -234,7 +236,7 @@ func Warehouse() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportWarehouse(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
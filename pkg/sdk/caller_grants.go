@@ -0,0 +1,99 @@
+package sdk
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// CallerGrants manage the caller grants used by the restricted caller's rights executables
+// (see https://docs.snowflake.com/en/developer-guide/restricted-callers-rights).
+type CallerGrants interface {
+	Grant(ctx context.Context, privileges *CallerGrantPrivileges, on *CallerGrantOn, to *CallerGrantee) error
+	Revoke(ctx context.Context, privileges *CallerGrantPrivileges, on *CallerGrantOn, from *CallerGrantee) error
+	Show(ctx context.Context, opts *ShowCallerGrantsOptions) ([]CallerGrant, error)
+}
+
+// GrantCallerOptions is based on https://docs.snowflake.com/en/sql-reference/sql/grant-caller.
+type GrantCallerOptions struct {
+	grant      bool                   `ddl:"static" sql:"GRANT"`
+	inherited  bool                   `ddl:"keyword" sql:"INHERITED"`
+	caller     bool                   `ddl:"static" sql:"CALLER"`
+	privileges *CallerGrantPrivileges `ddl:"-"`
+	on         *CallerGrantOn         `ddl:"keyword" sql:"ON"`
+	to         *CallerGrantee         `ddl:"keyword" sql:"TO"`
+}
+
+type CallerGrantPrivileges struct {
+	Privileges    []string `ddl:"-"`
+	AllPrivileges *bool    `ddl:"keyword" sql:"ALL"`
+}
+
+// CallerGrantOn is the single object (CALLER) or all the objects of the given type in the container (INHERITED CALLER).
+type CallerGrantOn struct {
+	Object *Object           `ddl:"-"`
+	All    *CallerGrantOnAll `ddl:"keyword" sql:"ALL"`
+}
+
+type CallerGrantOnAll struct {
+	PluralObjectType PluralObjectType          `ddl:"keyword"`
+	InAccount        *bool                     `ddl:"keyword" sql:"IN ACCOUNT"`
+	InDatabase       *AccountObjectIdentifier  `ddl:"identifier" sql:"IN DATABASE"`
+	InSchema         *DatabaseObjectIdentifier `ddl:"identifier" sql:"IN SCHEMA"`
+}
+
+type CallerGrantee struct {
+	AccountRole  *AccountObjectIdentifier  `ddl:"identifier" sql:"ROLE"`
+	DatabaseRole *DatabaseObjectIdentifier `ddl:"identifier" sql:"DATABASE ROLE"`
+}
+
+// RevokeCallerOptions is based on https://docs.snowflake.com/en/sql-reference/sql/revoke-caller.
+type RevokeCallerOptions struct {
+	revoke     bool                   `ddl:"static" sql:"REVOKE"`
+	inherited  bool                   `ddl:"keyword" sql:"INHERITED"`
+	caller     bool                   `ddl:"static" sql:"CALLER"`
+	privileges *CallerGrantPrivileges `ddl:"-"`
+	on         *CallerGrantOn         `ddl:"keyword" sql:"ON"`
+	from       *CallerGrantee         `ddl:"keyword" sql:"FROM"`
+}
+
+// ShowCallerGrantsOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-caller-grants.
+type ShowCallerGrantsOptions struct {
+	show         bool           `ddl:"static" sql:"SHOW"`
+	callerGrants bool           `ddl:"static" sql:"CALLER GRANTS"`
+	On           *Object        `ddl:"keyword" sql:"ON"`
+	To           *CallerGrantee `ddl:"keyword" sql:"TO"`
+}
+
+type callerGrantRow struct {
+	CreatedOn   time.Time `db:"created_on"`
+	Privilege   string    `db:"privilege"`
+	GrantedOn   string    `db:"granted_on"`
+	Name        string    `db:"name"`
+	GrantedTo   string    `db:"granted_to"`
+	GranteeName string    `db:"grantee_name"`
+	GrantedBy   string    `db:"granted_by"`
+}
+
+// CallerGrant is the caller grant; Name is the object, or the container of the objects for the inherited caller grants.
+type CallerGrant struct {
+	CreatedOn   time.Time
+	Privilege   string
+	GrantedOn   ObjectType
+	Name        string
+	GrantedTo   ObjectType
+	GranteeName string
+	GrantedBy   string
+}
+
+func (row callerGrantRow) convert() *CallerGrant {
+	return &CallerGrant{
+		CreatedOn:   row.CreatedOn,
+		Privilege:   row.Privilege,
+		GrantedOn:   ObjectType(strings.ReplaceAll(row.GrantedOn, "_", " ")),
+		Name:        row.Name,
+		GrantedTo:   ObjectType(strings.ReplaceAll(row.GrantedTo, "_", " ")),
+		GranteeName: row.GranteeName,
+		GrantedBy:   row.GrantedBy,
+	}
+}
@@ -0,0 +1,37 @@
+package sdk
+
+import "context"
+
+var _ CallerGrants = (*callerGrants)(nil)
+
+type callerGrants struct {
+	client *Client
+}
+
+func (v *callerGrants) Grant(ctx context.Context, privileges *CallerGrantPrivileges, on *CallerGrantOn, to *CallerGrantee) error {
+	opts := &GrantCallerOptions{
+		inherited:  on != nil && on.All != nil,
+		privileges: privileges,
+		on:         on,
+		to:         to,
+	}
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *callerGrants) Revoke(ctx context.Context, privileges *CallerGrantPrivileges, on *CallerGrantOn, from *CallerGrantee) error {
+	opts := &RevokeCallerOptions{
+		inherited:  on != nil && on.All != nil,
+		privileges: privileges,
+		on:         on,
+		from:       from,
+	}
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *callerGrants) Show(ctx context.Context, opts *ShowCallerGrantsOptions) ([]CallerGrant, error) {
+	rows, err := validateAndQuery[callerGrantRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[callerGrantRow, CallerGrant](rows), nil
+}
@@ -0,0 +1,140 @@
+package sdk
+
+import (
+	"testing"
+)
+
+func TestGrantCaller(t *testing.T) {
+	roleId := randomAccountObjectIdentifier()
+	databaseRoleId := randomDatabaseObjectIdentifier()
+
+	defaultOpts := func() *GrantCallerOptions {
+		return &GrantCallerOptions{
+			privileges: &CallerGrantPrivileges{Privileges: []string{"SELECT"}},
+			on:         &CallerGrantOn{Object: &Object{ObjectType: ObjectTypeTable, Name: randomSchemaObjectIdentifier()}},
+			to:         &CallerGrantee{AccountRole: &roleId},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *GrantCallerOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: privileges not set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = &CallerGrantPrivileges{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CallerGrantPrivileges", "AllPrivileges", "Privileges"))
+	})
+
+	t.Run("validation: both object and all set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.on.All = &CallerGrantOnAll{PluralObjectType: PluralObjectTypeTables, InAccount: Bool(true)}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CallerGrantOn", "Object", "All"))
+	})
+
+	t.Run("validation: container not set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.on = &CallerGrantOn{All: &CallerGrantOnAll{PluralObjectType: PluralObjectTypeTables}}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CallerGrantOnAll", "InAccount", "InDatabase", "InSchema"))
+	})
+
+	t.Run("validation: grantee not set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.to = &CallerGrantee{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CallerGrantee", "AccountRole", "DatabaseRole"))
+	})
+
+	t.Run("on object to account role", func(t *testing.T) {
+		tableId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.privileges = &CallerGrantPrivileges{Privileges: []string{"SELECT", "INSERT"}}
+		opts.on = &CallerGrantOn{Object: &Object{ObjectType: ObjectTypeTable, Name: tableId}}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT CALLER SELECT, INSERT ON TABLE %s TO ROLE %s`, tableId.FullyQualifiedName(), roleId.FullyQualifiedName())
+	})
+
+	t.Run("inherited on all in schema to database role", func(t *testing.T) {
+		schemaId := randomDatabaseObjectIdentifier()
+		opts := defaultOpts()
+		opts.inherited = true
+		opts.privileges = &CallerGrantPrivileges{AllPrivileges: Bool(true)}
+		opts.on = &CallerGrantOn{All: &CallerGrantOnAll{PluralObjectType: PluralObjectTypeTables, InSchema: &schemaId}}
+		opts.to = &CallerGrantee{DatabaseRole: &databaseRoleId}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT INHERITED CALLER ALL ON ALL TABLES IN SCHEMA %s TO DATABASE ROLE %s`, schemaId.FullyQualifiedName(), databaseRoleId.FullyQualifiedName())
+	})
+
+	t.Run("inherited on all in account", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.inherited = true
+		opts.on = &CallerGrantOn{All: &CallerGrantOnAll{PluralObjectType: PluralObjectTypeWarehouses, InAccount: Bool(true)}}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT INHERITED CALLER SELECT ON ALL WAREHOUSES IN ACCOUNT TO ROLE %s`, roleId.FullyQualifiedName())
+	})
+}
+
+func TestRevokeCaller(t *testing.T) {
+	roleId := randomAccountObjectIdentifier()
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *RevokeCallerOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: on not set", func(t *testing.T) {
+		opts := &RevokeCallerOptions{
+			privileges: &CallerGrantPrivileges{Privileges: []string{"USAGE"}},
+			from:       &CallerGrantee{AccountRole: &roleId},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("RevokeCallerOptions", "on"))
+	})
+
+	t.Run("on object", func(t *testing.T) {
+		databaseId := randomAccountObjectIdentifier()
+		opts := &RevokeCallerOptions{
+			privileges: &CallerGrantPrivileges{Privileges: []string{"USAGE"}},
+			on:         &CallerGrantOn{Object: &Object{ObjectType: ObjectTypeDatabase, Name: databaseId}},
+			from:       &CallerGrantee{AccountRole: &roleId},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `REVOKE CALLER USAGE ON DATABASE %s FROM ROLE %s`, databaseId.FullyQualifiedName(), roleId.FullyQualifiedName())
+	})
+
+	t.Run("inherited on all in database", func(t *testing.T) {
+		databaseId := randomAccountObjectIdentifier()
+		opts := &RevokeCallerOptions{
+			inherited:  true,
+			privileges: &CallerGrantPrivileges{Privileges: []string{"SELECT"}},
+			on:         &CallerGrantOn{All: &CallerGrantOnAll{PluralObjectType: PluralObjectTypeViews, InDatabase: &databaseId}},
+			from:       &CallerGrantee{AccountRole: &roleId},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `REVOKE INHERITED CALLER SELECT ON ALL VIEWS IN DATABASE %s FROM ROLE %s`, databaseId.FullyQualifiedName(), roleId.FullyQualifiedName())
+	})
+}
+
+func TestShowCallerGrants(t *testing.T) {
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowCallerGrantsOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: no target", func(t *testing.T) {
+		opts := &ShowCallerGrantsOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ShowCallerGrantsOptions", "On", "To"))
+	})
+
+	t.Run("to account role", func(t *testing.T) {
+		roleId := randomAccountObjectIdentifier()
+		opts := &ShowCallerGrantsOptions{To: &CallerGrantee{AccountRole: &roleId}}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW CALLER GRANTS TO ROLE %s`, roleId.FullyQualifiedName())
+	})
+
+	t.Run("to database role", func(t *testing.T) {
+		databaseRoleId := randomDatabaseObjectIdentifier()
+		opts := &ShowCallerGrantsOptions{To: &CallerGrantee{DatabaseRole: &databaseRoleId}}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW CALLER GRANTS TO DATABASE ROLE %s`, databaseRoleId.FullyQualifiedName())
+	})
+
+	t.Run("on object", func(t *testing.T) {
+		tableId := randomSchemaObjectIdentifier()
+		opts := &ShowCallerGrantsOptions{On: &Object{ObjectType: ObjectTypeTable, Name: tableId}}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW CALLER GRANTS ON TABLE %s`, tableId.FullyQualifiedName())
+	})
+}
@@ -0,0 +1,74 @@
+package sdk
+
+import "errors"
+
+var (
+	_ validatable = new(GrantCallerOptions)
+	_ validatable = new(RevokeCallerOptions)
+	_ validatable = new(ShowCallerGrantsOptions)
+)
+
+func (opts *GrantCallerOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	return validateCallerGrant("GrantCallerOptions", opts.privileges, opts.on, opts.to)
+}
+
+func (opts *RevokeCallerOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	return validateCallerGrant("RevokeCallerOptions", opts.privileges, opts.on, opts.from)
+}
+
+func validateCallerGrant(structName string, privileges *CallerGrantPrivileges, on *CallerGrantOn, grantee *CallerGrantee) error {
+	var errs []error
+	if !valueSet(privileges) {
+		errs = append(errs, errNotSet(structName, "privileges"))
+	} else if !exactlyOneValueSet(privileges.AllPrivileges, privileges.Privileges) {
+		errs = append(errs, errExactlyOneOf("CallerGrantPrivileges", "AllPrivileges", "Privileges"))
+	}
+	if !valueSet(on) {
+		errs = append(errs, errNotSet(structName, "on"))
+	} else {
+		if !exactlyOneValueSet(on.Object, on.All) {
+			errs = append(errs, errExactlyOneOf("CallerGrantOn", "Object", "All"))
+		}
+		if valueSet(on.All) {
+			if !valueSet(on.All.PluralObjectType) {
+				errs = append(errs, errNotSet("CallerGrantOnAll", "PluralObjectType"))
+			}
+			if !exactlyOneValueSet(on.All.InAccount, on.All.InDatabase, on.All.InSchema) {
+				errs = append(errs, errExactlyOneOf("CallerGrantOnAll", "InAccount", "InDatabase", "InSchema"))
+			}
+		}
+	}
+	if err := grantee.validate(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func (v *CallerGrantee) validate() error {
+	if v == nil || !exactlyOneValueSet(v.AccountRole, v.DatabaseRole) {
+		return errExactlyOneOf("CallerGrantee", "AccountRole", "DatabaseRole")
+	}
+	return nil
+}
+
+func (opts *ShowCallerGrantsOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !exactlyOneValueSet(opts.On, opts.To) {
+		errs = append(errs, errExactlyOneOf("ShowCallerGrantsOptions", "On", "To"))
+	}
+	if valueSet(opts.To) {
+		if err := opts.To.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
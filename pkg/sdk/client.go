@@ -5,19 +5,38 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/tracking"
 	"github.com/jmoiron/sqlx"
 	"github.com/snowflakedb/gosnowflake"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Client struct {
 	config         *gosnowflake.Config
 	db             *sqlx.DB
+	conn           *sqlx.Conn
 	sessionID      string
 	accountLocator string
 	dryRun         bool
 	traceLogs      []string
+	showCache      *showCache
+	// futureGrantsCache holds the schema-level future grants in the databases (see Grants.ShowFutureInSchemas). Unlike showCache, it is always
+	// enabled, because every future grant in a database looks up the same grants in all of its schemas during the plan and the read.
+	futureGrantsCache *showCache
+	// bypassShowCache makes ShowByID skip the cached results; the statements executed by the client still invalidate the cache.
+	bypassShowCache bool
+	retryPolicy     RetryPolicy
+	// trackingMetadataAsQueryTag makes the client send the usage tracking metadata as QUERY_TAG instead of the comment appended to the query.
+	trackingMetadataAsQueryTag bool
+	tracer                     trace.Tracer
+
+	asyncPollInterval time.Duration
+	asyncConnOpener   func(ctx context.Context) (asyncConn, error)
+	// usesCustomConnector is set when the client does not use the Snowflake driver (see NewClientWithConnector).
+	usesCustomConnector bool
 
 	// System-Defined Functions
 	ContextFunctions     ContextFunctions
@@ -33,6 +52,7 @@ type Client struct {
 	ApplicationRoles             ApplicationRoles
 	Applications                 Applications
 	AuthenticationPolicies       AuthenticationPolicies
+	CallerGrants                 CallerGrants
 	Comments                     Comments
 	Connections                  Connections
 	CortexSearchServices         CortexSearchServices
@@ -107,26 +127,26 @@ func NewDryRunClient() *Client {
 }
 
 func NewClient(cfg *gosnowflake.Config, opts ...func(*FileReaderConfig)) (*Client, error) {
-	var err error
 	if cfg == nil {
 		log.Printf("[DEBUG] Searching for default config in credentials chain...")
 		cfg = DefaultConfig(opts...)
 	}
+	return newClient(cfg, nil)
+}
 
-	dsn, err := gosnowflake.DSN(cfg)
+func newClient(cfg *gosnowflake.Config, factory ConnectorFactory) (*Client, error) {
+	db, err := openDB(cfg, factory)
 	if err != nil {
 		return nil, err
 	}
 
-	db, err := sqlx.Connect("snowflake", dsn)
-	if err != nil {
-		return nil, fmt.Errorf("open snowflake connection: %w", err)
-	}
-
 	client := &Client{
 		// snowflake does not adhere to the normal sql driver interface, so we have to use unsafe
-		db:     db.Unsafe(),
-		config: cfg,
+		db:                  db.Unsafe(),
+		config:              cfg,
+		futureGrantsCache:   newShowCache(),
+		retryPolicy:         DefaultRetryPolicy,
+		usesCustomConnector: factory != nil,
 	}
 	client.initialize()
 
@@ -159,6 +179,7 @@ func (c *Client) initialize() {
 	c.ApplicationRoles = &applicationRoles{client: c}
 	c.Applications = &applications{client: c}
 	c.AuthenticationPolicies = &authenticationPolicies{client: c}
+	c.CallerGrants = &callerGrants{client: c}
 	c.Comments = &comments{client: c}
 	c.Connections = &connections{client: c}
 	c.ContextFunctions = &contextFunctions{client: c}
@@ -220,6 +241,10 @@ func (c *Client) Ping() error {
 }
 
 func (c *Client) Close() error {
+	// the pinned connection is released when the operation ends
+	if c.conn != nil {
+		return nil
+	}
 	if c.db != nil {
 		return c.db.Close()
 	}
@@ -231,7 +256,7 @@ type accountLocatorContextKey struct{}
 var snowflakeAccountLocatorContextKey accountLocatorContextKey
 
 // Exec executes a query that does not return rows.
-func (c *Client) exec(ctx context.Context, sql string) (sql.Result, error) {
+func (c *Client) exec(ctx context.Context, sql string) (result sql.Result, err error) {
 	if c.dryRun {
 		c.traceLogs = append(c.traceLogs, sql)
 		// TODO(SNOW-926146): Decide what to do with logs during plugin framework poc
@@ -239,9 +264,27 @@ func (c *Client) exec(ctx context.Context, sql string) (sql.Result, error) {
 		return nil, nil
 	}
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	sql = appendQueryMetadata(ctx, sql)
-	result, err := c.db.ExecContext(ctx, sql)
-	return result, decodeDriverError(err)
+	ctx, sql = c.addQueryMetadata(ctx, sql)
+	ctx, endSpan := c.startSpan(ctx, sql)
+	err = c.withRetries(ctx, sql, func(ctx context.Context) error {
+		var execErr error
+		result, execErr = c.executor().ExecContext(ctx, sql)
+		return execErr
+	})
+	endSpan(err)
+	c.invalidateShowCache(sql)
+	return result, err
+}
+
+// execMultiStatement executes the statements that do not return rows in a single multi-statement call (see MULTI_STATEMENT_COUNT).
+// Snowflake runs the statements one by one and stops at the first failure; the statements run before it are not rolled back.
+func (c *Client) execMultiStatement(ctx context.Context, statements []string) error {
+	ctx, err := gosnowflake.WithMultiStatement(ctx, len(statements))
+	if err != nil {
+		return err
+	}
+	_, err = c.exec(ctx, strings.Join(statements, ";\n"))
+	return err
 }
 
 // query runs a query and returns the rows. dest is expected to be a slice of structs.
@@ -253,8 +296,15 @@ func (c *Client) query(ctx context.Context, dest interface{}, sql string) error
 		return nil
 	}
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	sql = appendQueryMetadata(ctx, sql)
-	return decodeDriverError(c.db.SelectContext(ctx, dest, sql))
+	ctx, sql = c.addQueryMetadata(ctx, sql)
+	ctx, endSpan := c.startSpan(ctx, sql)
+	err := c.withRetries(ctx, sql, func(ctx context.Context) error {
+		// the rows are appended to dest, so the rows from the failed attempt have to be removed before retrying
+		truncateSlice(dest)
+		return c.executor().SelectContext(ctx, dest, sql)
+	})
+	endSpan(err)
+	return err
 }
 
 // queryOne runs a query and returns one row. dest is expected to be a pointer to a struct.
@@ -266,18 +316,35 @@ func (c *Client) queryOne(ctx context.Context, dest interface{}, sql string) err
 		return nil
 	}
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	sql = appendQueryMetadata(ctx, sql)
-	return decodeDriverError(c.db.GetContext(ctx, dest, sql))
+	ctx, sql = c.addQueryMetadata(ctx, sql)
+	ctx, endSpan := c.startSpan(ctx, sql)
+	err := c.withRetries(ctx, sql, func(ctx context.Context) error { return c.executor().GetContext(ctx, dest, sql) })
+	endSpan(err)
+	return err
+}
+
+// SetTrackingMetadataAsQueryTag makes the client send the usage tracking metadata as QUERY_TAG of the queries instead of the comment appended to them.
+func (c *Client) SetTrackingMetadataAsQueryTag(enabled bool) {
+	c.trackingMetadataAsQueryTag = enabled
 }
 
-func appendQueryMetadata(ctx context.Context, sql string) string {
-	if metadata, ok := tracking.FromContext(ctx); ok {
-		newSql, err := tracking.AppendMetadata(sql, metadata)
+func (c *Client) addQueryMetadata(ctx context.Context, sql string) (context.Context, string) {
+	metadata, ok := tracking.FromContext(ctx)
+	if !ok {
+		return ctx, sql
+	}
+	if c.trackingMetadataAsQueryTag {
+		queryTag, err := tracking.MetadataQueryTag(metadata)
 		if err != nil {
-			log.Printf("[ERROR] failed to append metadata tracking: %v", err)
-			return sql
+			log.Printf("[ERROR] failed to set metadata tracking query tag: %v", err)
+			return ctx, sql
 		}
-		return newSql
+		return gosnowflake.WithQueryTag(ctx, queryTag), sql
+	}
+	newSql, err := tracking.AppendMetadata(sql, metadata)
+	if err != nil {
+		log.Printf("[ERROR] failed to append metadata tracking: %v", err)
+		return ctx, sql
 	}
-	return sql
+	return ctx, newSql
 }
@@ -0,0 +1,205 @@
+package sdk
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/snowflakedb/gosnowflake"
+)
+
+const (
+	defaultAsyncPollInterval = 2 * time.Second
+	// asyncCancelTimeout limits the time spent on canceling the query after the context is done.
+	asyncCancelTimeout = 30 * time.Second
+)
+
+// asyncConn submits the statements in the async mode and checks their status by the query id.
+// It is implemented with the gosnowflake connection; the interface makes it possible to test the polling without Snowflake.
+type asyncConn interface {
+	submit(ctx context.Context, sql string) (queryId string, err error)
+	// status returns true when the query finished successfully, false when it is still running, and the error when it failed.
+	status(ctx context.Context, queryId string) (bool, error)
+	cancel(ctx context.Context, queryId string) error
+	close() error
+}
+
+// openAsyncConn returns the connection used by execAsync. It can be overridden by setting asyncConnOpener (used in tests).
+func (c *Client) openAsyncConn(ctx context.Context) (asyncConn, error) {
+	if c.asyncConnOpener != nil {
+		return c.asyncConnOpener(ctx)
+	}
+	// the pinned connection is used, so the statement runs with the session overrides, and it is not closed afterward
+	if c.conn != nil {
+		return &driverAsyncConn{client: c, conn: c.conn.Conn, pinned: true}, nil
+	}
+	conn, err := c.db.Connx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &driverAsyncConn{client: c, conn: conn.Conn}, nil
+}
+
+// execAsync runs the statement that can take a long time (e.g. CREATE TABLE ... AS SELECT or CLONE) in the async mode,
+// and polls its status until it finishes. Contrary to exec, the statement is canceled in Snowflake (SYSTEM$CANCEL_QUERY)
+// when the context is done, e.g. when the resource timeout configured in the Terraform timeouts block is exceeded.
+//
+// The dry-run client and the client using a custom connector (see NewClientWithConnector; it does not
+// support the async mode of the Snowflake driver) run the statement synchronously with exec.
+func (c *Client) execAsync(ctx context.Context, sql string) (err error) {
+	if c.dryRun || c.usesCustomConnector {
+		_, err := c.exec(ctx, sql)
+		return err
+	}
+	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
+	ctx, sql = c.addQueryMetadata(ctx, sql)
+	ctx, endSpan := c.startSpan(ctx, sql)
+	defer func() { endSpan(err) }()
+	defer c.invalidateShowCache(sql)
+
+	conn, err := c.openAsyncConn(ctx)
+	if err != nil {
+		return fmt.Errorf("open connection for async query: %w", decodeDriverError(err, sql))
+	}
+	defer func() {
+		if closeErr := conn.close(); closeErr != nil {
+			log.Printf("[DEBUG] closing connection of async query failed: %v", closeErr)
+		}
+	}()
+
+	var queryId string
+	if err := c.withRetries(ctx, sql, func(ctx context.Context) error {
+		var submitErr error
+		queryId, submitErr = conn.submit(ctx, sql)
+		return submitErr
+	}); err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] async query %s submitted", queryId)
+	return c.waitForAsyncQuery(ctx, conn, queryId, sql)
+}
+
+// waitForAsyncQuery polls the status of the query until it finishes, or cancels the query when the context is done.
+func (c *Client) waitForAsyncQuery(ctx context.Context, conn asyncConn, queryId string, sql string) error {
+	pollInterval := c.asyncPollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultAsyncPollInterval
+	}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		done, err := conn.status(ctx, queryId)
+		switch {
+		case ctx.Err() != nil:
+			// checked first, because the status request fails too when the context is done
+		case err != nil:
+			return decodeDriverError(err, sql)
+		case done:
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			cancelCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), asyncCancelTimeout)
+			defer cancel()
+			if cancelErr := conn.cancel(cancelCtx, queryId); cancelErr != nil {
+				return errors.Join(fmt.Errorf("async query %s was interrupted: %w", queryId, ctx.Err()), fmt.Errorf("cancel query %s: %w", queryId, cancelErr))
+			}
+			return fmt.Errorf("async query %s was canceled: %w", queryId, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// driverAsyncConn implements asyncConn with the single gosnowflake connection taken from the pool.
+type driverAsyncConn struct {
+	client *Client
+	conn   interface {
+		Raw(f func(driverConn any) error) error
+		Close() error
+	}
+	pinned bool
+}
+
+func (a *driverAsyncConn) submit(ctx context.Context, sql string) (string, error) {
+	var queryId string
+	err := a.conn.Raw(func(driverConn any) error {
+		execer, ok := driverConn.(driver.ExecerContext)
+		if !ok {
+			return fmt.Errorf("driver connection %T does not support exec", driverConn)
+		}
+		result, err := execer.ExecContext(gosnowflake.WithAsyncMode(ctx), sql, nil)
+		if err != nil {
+			return err
+		}
+		snowflakeResult, ok := result.(gosnowflake.SnowflakeResult)
+		if !ok {
+			return fmt.Errorf("driver result %T does not have the query id", result)
+		}
+		queryId = snowflakeResult.GetQueryID()
+		return nil
+	})
+	return queryId, err
+}
+
+func (a *driverAsyncConn) status(ctx context.Context, queryId string) (bool, error) {
+	var done bool
+	err := a.conn.Raw(func(driverConn any) error {
+		snowflakeConn, ok := driverConn.(gosnowflake.SnowflakeConnection)
+		if !ok {
+			return fmt.Errorf("driver connection %T does not support query status", driverConn)
+		}
+		_, err := snowflakeConn.GetQueryStatus(ctx, queryId)
+		done, err = asyncQueryStatus(queryId, err)
+		return err
+	})
+	return done, err
+}
+
+func (a *driverAsyncConn) cancel(ctx context.Context, queryId string) error {
+	_, err := a.client.db.ExecContext(ctx, fmt.Sprintf(`SELECT SYSTEM$CANCEL_QUERY('%s')`, queryId))
+	return err
+}
+
+func (a *driverAsyncConn) close() error {
+	if a.pinned {
+		return nil
+	}
+	return a.conn.Close()
+}
+
+// asyncQueryStatus interprets the error returned by gosnowflake when checking the query status.
+// The error of the failed query is converted to the regular driver error, so it can be classified like the errors of the synchronous statements.
+func asyncQueryStatus(queryId string, err error) (bool, error) {
+	if err == nil {
+		return true, nil
+	}
+	var driverError *gosnowflake.SnowflakeError
+	if !errors.As(err, &driverError) {
+		return false, err
+	}
+	switch {
+	case driverError.Number == gosnowflake.ErrQueryIsRunning:
+		return false, nil
+	case driverError.Number == gosnowflake.ErrQueryStatus && len(driverError.MessageArgs) == 0:
+		// the status is not available yet, right after submitting the query
+		return false, nil
+	case driverError.Number == gosnowflake.ErrQueryStatus && len(driverError.MessageArgs) == 2:
+		code, convErr := strconv.Atoi(fmt.Sprint(driverError.MessageArgs[0]))
+		if convErr != nil {
+			return false, err
+		}
+		return false, &gosnowflake.SnowflakeError{
+			Number:  code,
+			Message: fmt.Sprint(driverError.MessageArgs[1]),
+			QueryID: queryId,
+		}
+	default:
+		return false, err
+	}
+}
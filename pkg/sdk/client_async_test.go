@@ -0,0 +1,195 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAsyncConn returns the scripted statuses for the consecutive status checks, and reports the query as running when the script is exhausted.
+type fakeAsyncConn struct {
+	submitErrs []error
+	statuses   []error
+	submits    int
+	checks     int
+	canceled   []string
+	closed     bool
+}
+
+var errFakeQueryRunning = &gosnowflake.SnowflakeError{Number: gosnowflake.ErrQueryIsRunning}
+
+func (f *fakeAsyncConn) submit(_ context.Context, _ string) (string, error) {
+	f.submits++
+	if len(f.submitErrs) > 0 {
+		err := f.submitErrs[0]
+		f.submitErrs = f.submitErrs[1:]
+		return "", err
+	}
+	return "query-id", nil
+}
+
+func (f *fakeAsyncConn) status(_ context.Context, queryId string) (bool, error) {
+	f.checks++
+	err := error(errFakeQueryRunning)
+	if len(f.statuses) > 0 {
+		err = f.statuses[0]
+		f.statuses = f.statuses[1:]
+	}
+	return asyncQueryStatus(queryId, err)
+}
+
+func (f *fakeAsyncConn) cancel(_ context.Context, queryId string) error {
+	f.canceled = append(f.canceled, queryId)
+	return nil
+}
+
+func (f *fakeAsyncConn) close() error {
+	f.closed = true
+	return nil
+}
+
+func fakeAsyncClient(t *testing.T, conn *fakeAsyncConn) *Client {
+	t.Helper()
+	client, _ := fakeDriverClient(t, RetryPolicy{MaxRetries: 1})
+	client.asyncPollInterval = time.Millisecond
+	client.asyncConnOpener = func(context.Context) (asyncConn, error) { return conn, nil }
+	return client
+}
+
+func Test_Client_execAsync(t *testing.T) {
+	sql := `CREATE TABLE "DB"."SCHEMA"."TABLE" CLONE "DB"."SCHEMA"."SOURCE"`
+
+	t.Run("waits until the query finishes", func(t *testing.T) {
+		conn := &fakeAsyncConn{statuses: []error{
+			&gosnowflake.SnowflakeError{Number: gosnowflake.ErrQueryStatus},
+			errFakeQueryRunning,
+			nil,
+		}}
+		client := fakeAsyncClient(t, conn)
+
+		err := client.execAsync(context.Background(), sql)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, conn.checks)
+		assert.Empty(t, conn.canceled)
+		assert.True(t, conn.closed)
+	})
+
+	t.Run("failed query error is classified", func(t *testing.T) {
+		conn := &fakeAsyncConn{statuses: []error{
+			errFakeQueryRunning,
+			&gosnowflake.SnowflakeError{Number: gosnowflake.ErrQueryStatus, MessageArgs: []any{"003001", "Insufficient privileges to operate on schema 'SCHEMA'"}},
+		}}
+		client := fakeAsyncClient(t, conn)
+
+		err := client.execAsync(context.Background(), sql)
+
+		require.ErrorIs(t, err, ErrInsufficientPrivileges)
+		var snowflakeError *SnowflakeError
+		require.ErrorAs(t, err, &snowflakeError)
+		assert.Equal(t, "query-id", snowflakeError.QueryId)
+		assert.Contains(t, err.Error(), "Insufficient privileges to operate on schema 'SCHEMA'")
+		assert.True(t, conn.closed)
+	})
+
+	t.Run("submit is retried on transient errors", func(t *testing.T) {
+		conn := &fakeAsyncConn{
+			submitErrs: []error{&gosnowflake.SnowflakeError{Number: 625, Message: "Statement has locked table"}},
+			statuses:   []error{nil},
+		}
+		client := fakeAsyncClient(t, conn)
+
+		err := client.execAsync(context.Background(), sql)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, conn.submits)
+	})
+
+	t.Run("query is canceled when the context is done", func(t *testing.T) {
+		conn := &fakeAsyncConn{}
+		client := fakeAsyncClient(t, conn)
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		err := client.execAsync(ctx, sql)
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, []string{"query-id"}, conn.canceled)
+		assert.True(t, conn.closed)
+	})
+
+	t.Run("dry run client runs the statement synchronously", func(t *testing.T) {
+		client := NewDryRunClient()
+
+		err := client.execAsync(context.Background(), sql)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{sql}, client.traceLogs)
+	})
+}
+
+func Test_Databases_Create_cloneRunsAsynchronously(t *testing.T) {
+	id := NewAccountObjectIdentifier("CLONED")
+	clone := &CreateDatabaseOptions{Clone: &Clone{SourceObject: NewAccountObjectIdentifier("SOURCE")}}
+
+	t.Run("clone is canceled when the context is done", func(t *testing.T) {
+		conn := &fakeAsyncConn{}
+		client := fakeAsyncClient(t, conn)
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		err := client.Databases.Create(ctx, id, clone)
+
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 1, conn.submits)
+		assert.Equal(t, []string{"query-id"}, conn.canceled)
+	})
+
+	t.Run("database without clone is created synchronously", func(t *testing.T) {
+		conn := &fakeAsyncConn{}
+		client := fakeAsyncClient(t, conn)
+
+		err := client.Databases.Create(context.Background(), id, nil)
+
+		require.NoError(t, err)
+		assert.Zero(t, conn.submits)
+	})
+}
+
+func Test_asyncQueryStatus(t *testing.T) {
+	otherErr := errors.New("connection reset")
+
+	testCases := []struct {
+		name         string
+		err          error
+		expectedDone bool
+		expectedErr  error
+	}{
+		{name: "finished", err: nil, expectedDone: true},
+		{name: "running", err: errFakeQueryRunning},
+		{name: "status not available yet", err: &gosnowflake.SnowflakeError{Number: gosnowflake.ErrQueryStatus}},
+		{name: "non-driver error", err: otherErr, expectedErr: otherErr},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			done, err := asyncQueryStatus("query-id", tc.err)
+
+			assert.Equal(t, tc.expectedDone, done)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+
+	t.Run("failed query", func(t *testing.T) {
+		_, err := asyncQueryStatus("query-id", &gosnowflake.SnowflakeError{Number: gosnowflake.ErrQueryStatus, MessageArgs: []any{"002003", "Object does not exist or not authorized."}})
+
+		var driverError *gosnowflake.SnowflakeError
+		require.ErrorAs(t, err, &driverError)
+		assert.Equal(t, 2003, driverError.Number)
+		assert.Equal(t, "query-id", driverError.QueryID)
+	})
+}
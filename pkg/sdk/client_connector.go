@@ -0,0 +1,49 @@
+package sdk
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// ConnectorFactory returns the connector used by the client created for the given config instead of the Snowflake driver,
+// e.g. recording the statements to a cassette or emulating Snowflake in the tests.
+type ConnectorFactory func(cfg *gosnowflake.Config) (driver.Connector, error)
+
+// NewClientWithConnector creates the client connecting with the connector returned by the factory instead of the Snowflake driver.
+// With the nil factory, it works like NewClient.
+func NewClientWithConnector(cfg *gosnowflake.Config, factory ConnectorFactory) (*Client, error) {
+	if cfg == nil {
+		cfg = DefaultConfig()
+	}
+	return newClient(cfg, factory)
+}
+
+// openDB opens the connection pool for the given config, using the connector factory if it is set.
+func openDB(cfg *gosnowflake.Config, factory ConnectorFactory) (*sqlx.DB, error) {
+	if factory == nil {
+		dsn, err := gosnowflake.DSN(cfg)
+		if err != nil {
+			return nil, err
+		}
+		db, err := sqlx.Connect("snowflake", dsn)
+		if err != nil {
+			return nil, fmt.Errorf("open snowflake connection: %w", err)
+		}
+		return db, nil
+	}
+
+	connector, err := factory(cfg)
+	if err != nil {
+		return nil, err
+	}
+	db := sqlx.NewDb(sql.OpenDB(connector), "snowflake")
+	if err := db.Ping(); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("open snowflake connection: %w", err)
+	}
+	return db, nil
+}
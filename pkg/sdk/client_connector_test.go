@@ -0,0 +1,36 @@
+package sdk
+
+import (
+	"context"
+	"database/sql/driver"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/sqlcassette"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/tracking"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewClientWithConnector_replayedSqlCassette(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.jsonl")
+	recorded := `{"connection":"ACCOUNT.USER","kind":"query","query":"SELECT CURRENT_ACCOUNT() as CURRENT_ACCOUNT","result_sets":[{"columns":["CURRENT_ACCOUNT"],"rows":[[{"type":"string","value":"AB12345"}]]}]}
+{"connection":"ACCOUNT.USER","kind":"query","query":"SELECT CURRENT_SESSION() as CURRENT_SESSION","result_sets":[{"columns":["CURRENT_SESSION"],"rows":[[{"type":"string","value":"123"}]]}]}
+{"connection":"ACCOUNT.USER","kind":"exec","query":"DROP WAREHOUSE \"WH\"","error":{"number":2003,"sql_state":"02000","message":"Object does not exist"}}
+`
+	require.NoError(t, os.WriteFile(path, []byte(recorded), 0o600))
+	cassette, err := sqlcassette.New(sqlcassette.ReplayMode, path, tracking.TrimMetadata)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, cassette.Close()) })
+
+	client, err := NewClientWithConnector(&gosnowflake.Config{Account: "account", User: "user"}, func(*gosnowflake.Config) (driver.Connector, error) {
+		return cassette.Connector("ACCOUNT.USER", nil), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "AB12345", client.GetAccountLocator())
+
+	err = client.Warehouses.Drop(context.Background(), NewAccountObjectIdentifier("WH"), nil)
+	require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+}
@@ -0,0 +1,45 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+)
+
+// dbExecutor is implemented by both *sqlx.DB and *sqlx.Conn, so the statements can be run either on the pool or on the single pinned connection.
+type dbExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	SelectContext(ctx context.Context, dest any, query string, args ...any) error
+	GetContext(ctx context.Context, dest any, query string, args ...any) error
+}
+
+func (c *Client) executor() dbExecutor {
+	if c.conn != nil {
+		return c.conn
+	}
+	return c.db
+}
+
+// derived returns the copy of the client that shares the configuration and the connection pool with the client, changed by the given function.
+func (c *Client) derived(change func(derived *Client)) *Client {
+	derived := &Client{
+		config:            c.config,
+		db:                c.db,
+		conn:              c.conn,
+		sessionID:         c.sessionID,
+		accountLocator:    c.accountLocator,
+		showCache:         c.showCache,
+		futureGrantsCache: c.futureGrantsCache,
+		bypassShowCache:   c.bypassShowCache,
+		retryPolicy:       c.retryPolicy,
+
+		trackingMetadataAsQueryTag: c.trackingMetadataAsQueryTag,
+		tracer:                     c.tracer,
+		asyncPollInterval:          c.asyncPollInterval,
+		asyncConnOpener:            c.asyncConnOpener,
+		usesCustomConnector:        c.usesCustomConnector,
+	}
+	change(derived)
+	derived.initialize()
+	return derived
+}
@@ -3,20 +3,17 @@ package sdk
 import (
 	"context"
 	"database/sql"
+
+	"github.com/snowflakedb/gosnowflake"
 )
 
 func (c *Client) ExecUnsafe(ctx context.Context, sql string) (sql.Result, error) {
 	return c.exec(ctx, sql)
 }
 
-// QueryUnsafe for now only supports single query. For more queries we will have to adjust the behavior. From the gosnowflake driver docs:
-//
-//	 (...) while using the multi-statement feature, pass a Context that specifies the number of statements in the string.
-//		When multiple queries are executed by a single call to QueryContext(), multiple result sets are returned. After you process the first result set, get the next result set (for the next SQL statement) by calling NextResultSet().
-//
-// Therefore, only single resultSet is processed.
+// QueryUnsafe only supports single query. Use QueryUnsafeMultiStatement to run multiple statements.
 func (c *Client) QueryUnsafe(ctx context.Context, sql string) ([]map[string]*any, error) {
-	rows, err := c.db.QueryContext(ctx, sql)
+	rows, err := c.executor().QueryContext(ctx, sql)
 	if err != nil {
 		return nil, err
 	}
@@ -27,9 +24,46 @@ func (c *Client) QueryUnsafe(ctx context.Context, sql string) ([]map[string]*any
 	return allRows, nil
 }
 
+// QueryUnsafeMultiStatement runs the given statements (separated by semicolons) and returns the rows of all the result sets, one for each statement.
+// statementsCount has to match the number of statements in sql (it protects against SQL injection); 0 allows any number of statements. From the gosnowflake driver docs:
+//
+//	(...) while using the multi-statement feature, pass a Context that specifies the number of statements in the string.
+//	When multiple queries are executed by a single call to QueryContext(), multiple result sets are returned. After you process the first result set, get the next result set (for the next SQL statement) by calling NextResultSet().
+func (c *Client) QueryUnsafeMultiStatement(ctx context.Context, sql string, statementsCount int) ([][]map[string]*any, error) {
+	multiStatementCtx, err := gosnowflake.WithMultiStatement(ctx, statementsCount)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := c.executor().QueryContext(multiStatementCtx, sql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	resultSets := make([][]map[string]*any, 0)
+	for {
+		resultSet, err := unsafeExecuteProcessResultSet(rows)
+		if err != nil {
+			return nil, err
+		}
+		resultSets = append(resultSets, resultSet)
+		if !rows.NextResultSet() {
+			break
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return resultSets, nil
+}
+
 func unsafeExecuteProcessRows(rows *sql.Rows) ([]map[string]*any, error) {
 	defer rows.Close()
+	return unsafeExecuteProcessResultSet(rows)
+}
 
+// unsafeExecuteProcessResultSet processes the rows of the current result set.
+func unsafeExecuteProcessResultSet(rows *sql.Rows) ([]map[string]*any, error) {
 	columnNames, err := rows.Columns()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,50 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client_QueryUnsafeMultiStatement(t *testing.T) {
+	namesOf := func(resultSet []map[string]*any) []string {
+		names := make([]string, len(resultSet))
+		for i, row := range resultSet {
+			names[i] = (*row["name"]).(string)
+		}
+		return names
+	}
+
+	t.Run("all result sets are returned", func(t *testing.T) {
+		client, fake := fakeDriverClient(t, RetryPolicy{})
+		fake.resultSets = [][]string{{"A", "B"}, {}, {"C"}}
+
+		resultSets, err := client.QueryUnsafeMultiStatement(context.Background(), "SELECT 1; SET V = 1; SELECT 2", 3)
+
+		require.NoError(t, err)
+		require.Len(t, resultSets, 3)
+		assert.Equal(t, []string{"A", "B"}, namesOf(resultSets[0]))
+		assert.Empty(t, resultSets[1])
+		assert.Equal(t, []string{"C"}, namesOf(resultSets[2]))
+	})
+
+	t.Run("single result set", func(t *testing.T) {
+		client, _ := fakeDriverClient(t, RetryPolicy{})
+
+		resultSets, err := client.QueryUnsafeMultiStatement(context.Background(), "SELECT 1", 0)
+
+		require.NoError(t, err)
+		require.Len(t, resultSets, 1)
+		assert.Equal(t, []string{"A", "B"}, namesOf(resultSets[0]))
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		client, _ := fakeDriverClient(t, RetryPolicy{}, assert.AnError)
+
+		_, err := client.QueryUnsafeMultiStatement(context.Background(), "SELECT 1; SELECT 2", 2)
+
+		require.ErrorIs(t, err, assert.AnError)
+	})
+}
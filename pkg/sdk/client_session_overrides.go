@@ -0,0 +1,141 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log"
+)
+
+// SessionOverrides change the session in which the statements of a single operation run (see Client.WithSessionOverrides).
+// The fields that are not set keep the values of the client's session.
+type SessionOverrides struct {
+	Role           *AccountObjectIdentifier
+	SecondaryRoles *SecondaryRoleOption
+	Warehouse      *AccountObjectIdentifier
+}
+
+func (o SessionOverrides) empty() bool {
+	return o.Role == nil && o.SecondaryRoles == nil && o.Warehouse == nil
+}
+
+// WithSessionOverrides runs f with the client that executes all the statements on a single connection taken from the pool,
+// on which the overrides were applied with USE ROLE, USE SECONDARY ROLES, and USE WAREHOUSE. After f returns, the session of
+// the connection is restored before returning it to the pool. When the session cannot be restored (e.g. there was no warehouse
+// in use before, or it used a list of secondary roles), the connection is closed instead.
+//
+// The client passed to f does not read from the SHOW cache, because the results depend on the role, but the statements it executes
+// still invalidate the cache shared with c. Nested calls apply the overrides on the already pinned connection.
+// The dry-run client runs f with itself.
+func (c *Client) WithSessionOverrides(ctx context.Context, overrides SessionOverrides, f func(client *Client) error) (err error) {
+	if c.dryRun || overrides.empty() {
+		return f(c)
+	}
+
+	pinned := c.derived(func(derived *Client) {
+		derived.bypassShowCache = true
+	})
+	// the connection is discarded only when it was pinned by this call
+	discard := func() error { return nil }
+	if c.conn == nil {
+		conn, err := c.db.Connx(ctx)
+		if err != nil {
+			return fmt.Errorf("get connection for session overrides: %w", decodeDriverError(err, ""))
+		}
+		pinned = c.derived(func(derived *Client) {
+			derived.conn = conn
+			derived.bypassShowCache = true
+		})
+		discard = pinned.discardPinnedConnection
+		defer func() {
+			if closeErr := conn.Close(); closeErr != nil && !errors.Is(closeErr, sql.ErrConnDone) {
+				log.Printf("[DEBUG] releasing the connection with session overrides failed: %v", closeErr)
+			}
+		}()
+	}
+
+	restore, err := pinned.applySessionOverrides(ctx, overrides)
+	if err != nil {
+		// the session can be partially changed, so the connection cannot be reused
+		return errors.Join(err, discard())
+	}
+	defer func() {
+		// the context of the operation can be already canceled, but the session still has to be restored
+		if restoreErr := restore(context.WithoutCancel(ctx)); restoreErr != nil {
+			log.Printf("[DEBUG] restoring the session failed, the connection is closed: %v", restoreErr)
+			err = errors.Join(err, discard())
+		}
+	}()
+	return f(pinned)
+}
+
+// applySessionOverrides applies the overrides to the session, and returns the function that restores the previous values.
+func (c *Client) applySessionOverrides(ctx context.Context, overrides SessionOverrides) (func(ctx context.Context) error, error) {
+	var restoreSteps []func(ctx context.Context) error
+
+	if overrides.Role != nil {
+		role, err := c.ContextFunctions.CurrentRole(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get current role: %w", err)
+		}
+		if err := c.Sessions.UseRole(ctx, *overrides.Role); err != nil {
+			return nil, fmt.Errorf("use execution role %s: %w", overrides.Role.FullyQualifiedName(), err)
+		}
+		restoreSteps = append(restoreSteps, func(ctx context.Context) error {
+			return c.Sessions.UseRole(ctx, role)
+		})
+	}
+	if overrides.SecondaryRoles != nil {
+		secondaryRoles, err := c.ContextFunctions.CurrentSecondaryRoles(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get current secondary roles: %w", err)
+		}
+		if err := c.Sessions.UseSecondaryRoles(ctx, *overrides.SecondaryRoles); err != nil {
+			return nil, fmt.Errorf("use secondary roles %s: %w", *overrides.SecondaryRoles, err)
+		}
+		restoreSteps = append(restoreSteps, func(ctx context.Context) error {
+			if secondaryRoles.Value != SecondaryRolesAll && len(secondaryRoles.Roles) > 0 {
+				return errors.New("the list of secondary roles cannot be restored")
+			}
+			return c.Sessions.UseSecondaryRoles(ctx, secondaryRoles.Value)
+		})
+	}
+	if overrides.Warehouse != nil {
+		warehouse, err := c.ContextFunctions.CurrentWarehouse(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get current warehouse: %w", err)
+		}
+		if err := c.Sessions.UseWarehouse(ctx, *overrides.Warehouse); err != nil {
+			return nil, fmt.Errorf("use execution warehouse %s: %w", overrides.Warehouse.FullyQualifiedName(), err)
+		}
+		restoreSteps = append(restoreSteps, func(ctx context.Context) error {
+			if warehouse == "" {
+				return errors.New("the session did not use any warehouse")
+			}
+			return c.Sessions.UseWarehouse(ctx, NewAccountObjectIdentifier(warehouse))
+		})
+	}
+
+	return func(ctx context.Context) error {
+		var errs []error
+		// the role is restored first, because the other values may depend on its privileges
+		for _, step := range restoreSteps {
+			errs = append(errs, step(ctx))
+		}
+		return errors.Join(errs...)
+	}, nil
+}
+
+// discardPinnedConnection makes the pool close the pinned connection instead of reusing it.
+func (c *Client) discardPinnedConnection() error {
+	if c.conn == nil {
+		return nil
+	}
+	err := c.conn.Raw(func(any) error { return driver.ErrBadConn })
+	if errors.Is(err, driver.ErrBadConn) {
+		return nil
+	}
+	return err
+}
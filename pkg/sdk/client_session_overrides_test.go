@@ -0,0 +1,104 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client_WithSessionOverrides(t *testing.T) {
+	currentRoleQuery := "SELECT CURRENT_ROLE() as CURRENT_ROLE"
+	currentWarehouseQuery := "SELECT CURRENT_WAREHOUSE() as CURRENT_WAREHOUSE"
+	createStatement := `CREATE SCHEMA "DB"."SCHEMA"`
+	role := NewAccountObjectIdentifier("EXECUTION_ROLE")
+	warehouse := NewAccountObjectIdentifier("EXECUTION_WAREHOUSE")
+
+	fakeClient := func(t *testing.T, errs ...error) (*Client, *fakeDriver) {
+		t.Helper()
+		client, fake := fakeDriverClient(t, RetryPolicy{}, errs...)
+		fake.rows = map[string]map[string]string{
+			currentRoleQuery:      {"CURRENT_ROLE": "PROVIDER_ROLE"},
+			currentWarehouseQuery: {"CURRENT_WAREHOUSE": "PROVIDER_WAREHOUSE"},
+		}
+		return client, fake
+	}
+
+	t.Run("overrides are applied and restored", func(t *testing.T) {
+		client, fake := fakeClient(t)
+
+		err := client.WithSessionOverrides(context.Background(), SessionOverrides{Role: &role, Warehouse: &warehouse}, func(client *Client) error {
+			_, err := client.exec(context.Background(), createStatement)
+			return err
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			currentRoleQuery,
+			`USE ROLE "EXECUTION_ROLE"`,
+			currentWarehouseQuery,
+			`USE WAREHOUSE "EXECUTION_WAREHOUSE"`,
+			createStatement,
+			`USE ROLE "PROVIDER_ROLE"`,
+			`USE WAREHOUSE "PROVIDER_WAREHOUSE"`,
+		}, fake.log)
+	})
+
+	t.Run("session is restored when the operation fails", func(t *testing.T) {
+		client, fake := fakeClient(t, nil, nil, errors.New("create failed"))
+
+		err := client.WithSessionOverrides(context.Background(), SessionOverrides{Role: &role}, func(client *Client) error {
+			_, err := client.exec(context.Background(), createStatement)
+			return err
+		})
+
+		require.ErrorContains(t, err, "create failed")
+		assert.Equal(t, []string{currentRoleQuery, `USE ROLE "EXECUTION_ROLE"`, createStatement, `USE ROLE "PROVIDER_ROLE"`}, fake.log)
+	})
+
+	t.Run("operation is not run when the overrides cannot be applied", func(t *testing.T) {
+		client, fake := fakeClient(t, nil, errors.New("role does not exist"))
+
+		err := client.WithSessionOverrides(context.Background(), SessionOverrides{Role: &role}, func(client *Client) error {
+			_, err := client.exec(context.Background(), createStatement)
+			return err
+		})
+
+		require.ErrorContains(t, err, "use execution role \"EXECUTION_ROLE\"")
+		assert.Equal(t, []string{currentRoleQuery, `USE ROLE "EXECUTION_ROLE"`}, fake.log)
+	})
+
+	t.Run("no overrides", func(t *testing.T) {
+		client, fake := fakeClient(t)
+
+		err := client.WithSessionOverrides(context.Background(), SessionOverrides{}, func(overridden *Client) error {
+			assert.Same(t, client, overridden)
+			_, err := overridden.exec(context.Background(), createStatement)
+			return err
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{createStatement}, fake.log)
+	})
+
+	t.Run("show cache is bypassed with overrides, but still invalidated", func(t *testing.T) {
+		client, _ := fakeClient(t)
+		client.EnableShowCache()
+		key := showCacheKey{objectType: ObjectTypeSchema, container: `"DB"`}
+		_, err := client.showCache.get(key, func() (any, error) { return []Schema{}, nil })
+		require.NoError(t, err)
+
+		err = client.WithSessionOverrides(context.Background(), SessionOverrides{Role: &role}, func(overridden *Client) error {
+			assert.Same(t, client.showCache, overridden.showCache)
+			assert.True(t, overridden.bypassShowCache)
+			_, err := overridden.exec(context.Background(), createStatement)
+			return err
+		})
+
+		require.NoError(t, err)
+		assert.False(t, client.bypassShowCache)
+		assert.NotContains(t, client.showCache.entries, key)
+	})
+}
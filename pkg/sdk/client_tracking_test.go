@@ -0,0 +1,40 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/tracking"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client_trackingMetadata(t *testing.T) {
+	sql := `DROP DATABASE "DB"`
+	metadata := tracking.NewVersionedResourceMetadata(resources.Database, tracking.DeleteOperation).
+		WithTerraformContext(tracking.TerraformContext{Workspace: "prod", ModuleAddress: "module.databases"}, `"DB"`)
+	ctx := tracking.NewContext(context.Background(), metadata)
+
+	t.Run("metadata appended as comment", func(t *testing.T) {
+		client, fake := fakeDriverClient(t, RetryPolicy{})
+
+		_, err := client.exec(ctx, sql)
+		require.NoError(t, err)
+
+		require.Len(t, fake.log, 1)
+		parsedMetadata, err := tracking.ParseMetadata(fake.log[0])
+		require.NoError(t, err)
+		assert.Equal(t, metadata, parsedMetadata)
+	})
+
+	t.Run("metadata sent as query tag", func(t *testing.T) {
+		client, fake := fakeDriverClient(t, RetryPolicy{})
+		client.SetTrackingMetadataAsQueryTag(true)
+
+		_, err := client.exec(ctx, sql)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{sql}, fake.log)
+	})
+}
@@ -48,6 +48,14 @@ func (v *databaseRoles) Show(ctx context.Context, request *ShowDatabaseRoleReque
 }
 
 func (v *databaseRoles) ShowByID(ctx context.Context, id DatabaseObjectIdentifier) (*DatabaseRole, error) {
+	return showByIdWithCache(v.client, ObjectTypeDatabaseRole, id.DatabaseId(), id.Name(),
+		func(r DatabaseRole) string { return r.Name },
+		func() ([]DatabaseRole, error) { return v.Show(ctx, NewShowDatabaseRoleRequest(id.DatabaseId())) },
+		func() (*DatabaseRole, error) { return v.showByID(ctx, id) },
+	)
+}
+
+func (v *databaseRoles) showByID(ctx context.Context, id DatabaseObjectIdentifier) (*DatabaseRole, error) {
 	request := NewShowDatabaseRoleRequest(id.DatabaseId()).WithLike(Like{Pointer(id.Name())})
 	databaseRoles, err := v.Show(ctx, request)
 	if err != nil {
@@ -242,6 +242,10 @@ func (v *databases) Create(ctx context.Context, id AccountObjectIdentifier, opts
 	if err != nil {
 		return err
 	}
+	// cloning can take a long time for large databases, so it is canceled in Snowflake when the context is done
+	if opts.Clone != nil {
+		return v.client.execAsync(ctx, sql)
+	}
 	_, err = v.client.exec(ctx, sql)
 	return err
 }
@@ -14,7 +14,7 @@ type dynamicTables struct {
 
 func (v *dynamicTables) Create(ctx context.Context, request *CreateDynamicTableRequest) error {
 	opts := request.toOpts()
-	return validateAndExec(v.client, ctx, opts)
+	return validateAndExecAsync(v.client, ctx, opts)
 }
 
 func (v *dynamicTables) Alter(ctx context.Context, request *AlterDynamicTableRequest) error {
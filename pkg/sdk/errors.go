@@ -23,6 +23,12 @@ var (
 	ErrObjectNotExistOrAuthorized               = NewError("object does not exist or not authorized")
 	ErrAccountIsEmpty                           = NewError("account is empty")
 	ErrGrantPartiallyExecuted                   = NewError("grant partially executed")
+	ErrObjectAlreadyExists                      = NewError("object already exists")
+	ErrInsufficientPrivileges                   = NewError("insufficient privileges")
+	ErrTransientLock                            = NewError("object is locked by another statement")
+	ErrStatementTimeout                         = NewError("statement timeout")
+	ErrWarehouseUnavailable                     = NewError("no active warehouse, or the warehouse cannot be resumed")
+	ErrServiceUnavailable                       = NewError("service is temporarily unavailable")
 
 	// snowflake-sdk errors.
 	ErrInvalidObjectIdentifier = NewError("invalid object identifier")
@@ -80,23 +86,15 @@ func errInvalidValue(structName string, fieldName string, invalidValue string) e
 	return newError(fmt.Sprintf("invalid value %s of struct %s field: %s", invalidValue, structName, fieldName), 2)
 }
 
-func decodeDriverError(err error) error {
+// decodeDriverError returns SnowflakeError for the recognized driver errors (see newSnowflakeError), or the unchanged error otherwise.
+func decodeDriverError(err error, sql string) error {
 	if err == nil {
 		return nil
 	}
 	log.Printf("[DEBUG] err: %v", err)
-	m := map[string]error{
-		"Object does not exist, or operation cannot be performed": ErrDoesNotExistOrOperationCannotBePerformed,
-		"does not exist or not authorized":                        ErrObjectNotExistOrAuthorized,
-		"account is empty":                                        ErrAccountIsEmpty,
-		"Grant partially executed":                                ErrGrantPartiallyExecuted,
+	if snowflakeError := newSnowflakeError(err, sql); snowflakeError != nil {
+		return snowflakeError
 	}
-	for k, v := range m {
-		if strings.Contains(err.Error(), k) {
-			return v
-		}
-	}
-
 	return err
 }
 
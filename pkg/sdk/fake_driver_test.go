@@ -0,0 +1,135 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// fakeDriver returns the scripted errors for the consecutive statements, and succeeds when the script is exhausted.
+// It also records the executed statements. The queries return the row from rows for the exact query text,
+// or resultSets (or a single result set with names A and B when not set).
+type fakeDriver struct {
+	errs       []error
+	statements int
+	log        []string
+	resultSets [][]string
+	rows       map[string]map[string]string
+}
+
+func (d *fakeDriver) nextError(query string) error {
+	d.statements++
+	d.log = append(d.log, query)
+	if len(d.errs) == 0 {
+		return nil
+	}
+	err := d.errs[0]
+	d.errs = d.errs[1:]
+	return err
+}
+
+func (d *fakeDriver) Connect(context.Context) (driver.Conn, error) { return &fakeConn{driver: d}, nil }
+func (d *fakeDriver) Driver() driver.Driver                        { return nil }
+
+type fakeConn struct {
+	driver *fakeDriver
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	if err := c.driver.nextError(query); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	if err := c.driver.nextError(query); err != nil {
+		return nil, err
+	}
+	if row, ok := c.driver.rows[query]; ok {
+		return newFakeSingleRow(row), nil
+	}
+	resultSets := c.driver.resultSets
+	if resultSets == nil {
+		resultSets = [][]string{{"A", "B"}}
+	}
+	return &fakeRows{resultSets: resultSets}, nil
+}
+
+// fakeRows returns result sets with a single column: name.
+type fakeRows struct {
+	resultSets [][]string
+	row        int
+}
+
+func (r *fakeRows) Columns() []string { return []string{"name"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.row >= len(r.resultSets[0]) {
+		return io.EOF
+	}
+	dest[0] = r.resultSets[0][r.row]
+	r.row++
+	return nil
+}
+
+func (r *fakeRows) HasNextResultSet() bool { return len(r.resultSets) > 1 }
+
+func (r *fakeRows) NextResultSet() error {
+	if !r.HasNextResultSet() {
+		return io.EOF
+	}
+	r.resultSets = r.resultSets[1:]
+	r.row = 0
+	return nil
+}
+
+// fakeSingleRow returns a single row with the given columns.
+type fakeSingleRow struct {
+	columns []string
+	values  []string
+	done    bool
+}
+
+func newFakeSingleRow(row map[string]string) *fakeSingleRow {
+	r := &fakeSingleRow{}
+	for column, value := range row {
+		r.columns = append(r.columns, column)
+		r.values = append(r.values, value)
+	}
+	return r
+}
+
+func (r *fakeSingleRow) Columns() []string { return r.columns }
+func (r *fakeSingleRow) Close() error      { return nil }
+
+func (r *fakeSingleRow) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	for i, value := range r.values {
+		dest[i] = value
+	}
+	r.done = true
+	return nil
+}
+
+func fakeDriverClient(t *testing.T, policy RetryPolicy, errs ...error) (*Client, *fakeDriver) {
+	t.Helper()
+	fake := &fakeDriver{errs: errs}
+	db := sqlx.NewDb(sql.OpenDB(fake), "snowflake").Unsafe()
+	t.Cleanup(func() { _ = db.Close() })
+	client := &Client{db: db, retryPolicy: policy}
+	client.initialize()
+	return client, fake
+}
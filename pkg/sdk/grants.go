@@ -14,11 +14,24 @@ type Grants interface {
 	RevokePrivilegesFromAccountRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role AccountObjectIdentifier, opts *RevokePrivilegesFromAccountRoleOptions) error
 	GrantPrivilegesToDatabaseRole(ctx context.Context, privileges *DatabaseRoleGrantPrivileges, on *DatabaseRoleGrantOn, role DatabaseObjectIdentifier, opts *GrantPrivilegesToDatabaseRoleOptions) error
 	RevokePrivilegesFromDatabaseRole(ctx context.Context, privileges *DatabaseRoleGrantPrivileges, on *DatabaseRoleGrantOn, role DatabaseObjectIdentifier, opts *RevokePrivilegesFromDatabaseRoleOptions) error
+	GrantPrivilegesToApplicationRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role DatabaseObjectIdentifier, opts *GrantPrivilegesToApplicationRoleOptions) error
+	RevokePrivilegesFromApplicationRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role DatabaseObjectIdentifier, opts *RevokePrivilegesFromApplicationRoleOptions) error
 	GrantPrivilegeToShare(ctx context.Context, privileges []ObjectPrivilege, on *ShareGrantOn, to AccountObjectIdentifier) error
 	RevokePrivilegeFromShare(ctx context.Context, privileges []ObjectPrivilege, on *ShareGrantOn, from AccountObjectIdentifier) error
 	GrantOwnership(ctx context.Context, on OwnershipGrantOn, to OwnershipGrantTo, opts *GrantOwnershipOptions) error
+	// GrantPrivilegesToAccountRoleInBatches runs the grants in multi-statement calls of at most batchSize statements, to limit the round-trips.
+	GrantPrivilegesToAccountRoleInBatches(ctx context.Context, grants []AccountRolePrivilegesGrant, batchSize int) error
+	// RevokePrivilegesFromAccountRoleInBatches runs the revokes in multi-statement calls of at most batchSize statements; WithGrantOption is ignored.
+	RevokePrivilegesFromAccountRoleInBatches(ctx context.Context, grants []AccountRolePrivilegesGrant, batchSize int) error
 
 	Show(ctx context.Context, opts *ShowGrantOptions) ([]Grant, error)
+	// ShowOnAll returns the grants to the given grantee on every existing object matched by the bulk grant (ON ALL <object_type_plural> IN ...).
+	// It runs two queries regardless of the number of objects: SHOW <object_type_plural> IN ... and SHOW GRANTS TO the grantee.
+	ShowOnAll(ctx context.Context, in *GrantOnSchemaObjectIn, to *ShowGrantsTo) ([]ObjectGrants, error)
+	// ShowFutureInSchemas returns the future grants defined in every schema of the database (except INFORMATION_SCHEMA).
+	// It runs SHOW SCHEMAS IN DATABASE and SHOW FUTURE GRANTS IN SCHEMA for every schema. The result is cached by the client until a statement
+	// referencing the database or any of its schemas is executed, so the grants in the same database are read once per Terraform operation.
+	ShowFutureInSchemas(ctx context.Context, database AccountObjectIdentifier) ([]SchemaFutureGrants, error)
 }
 
 // GrantPrivilegesToAccountRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/grant-privilege#syntax.
@@ -75,6 +88,15 @@ type GrantOnSchemaObjectIn struct {
 	InSchema         *DatabaseObjectIdentifier `ddl:"identifier" sql:"IN SCHEMA"`
 }
 
+// AccountRolePrivilegesGrant is a single grant of the privileges to the account role executed in a batch.
+// The bulk operations on pipes (ON ALL PIPES) are not supported in batches, because they are run for every pipe separately.
+type AccountRolePrivilegesGrant struct {
+	Privileges      *AccountRoleGrantPrivileges
+	On              *AccountRoleGrantOn
+	AccountRole     AccountObjectIdentifier
+	WithGrantOption *bool
+}
+
 // RevokePrivilegesFromAccountRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/revoke-privilege#syntax.
 type RevokePrivilegesFromAccountRoleOptions struct {
 	revoke         bool                        `ddl:"static" sql:"REVOKE"`
@@ -119,6 +141,27 @@ type RevokePrivilegesFromDatabaseRoleOptions struct {
 	Cascade        *bool                        `ddl:"keyword" sql:"CASCADE"`
 }
 
+// GrantPrivilegesToApplicationRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/grant-privilege#syntax.
+// Application roles accept the same privileges and securables as account roles, apart from future grants.
+type GrantPrivilegesToApplicationRoleOptions struct {
+	grant           bool                        `ddl:"static" sql:"GRANT"`
+	privileges      *AccountRoleGrantPrivileges `ddl:"-"`
+	on              *AccountRoleGrantOn         `ddl:"keyword" sql:"ON"`
+	applicationRole DatabaseObjectIdentifier    `ddl:"identifier" sql:"TO APPLICATION ROLE"`
+	WithGrantOption *bool                       `ddl:"keyword" sql:"WITH GRANT OPTION"`
+}
+
+// RevokePrivilegesFromApplicationRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/revoke-privilege#syntax.
+type RevokePrivilegesFromApplicationRoleOptions struct {
+	revoke          bool                        `ddl:"static" sql:"REVOKE"`
+	GrantOptionFor  *bool                       `ddl:"keyword" sql:"GRANT OPTION FOR"`
+	privileges      *AccountRoleGrantPrivileges `ddl:"-"`
+	on              *AccountRoleGrantOn         `ddl:"keyword" sql:"ON"`
+	applicationRole DatabaseObjectIdentifier    `ddl:"identifier" sql:"FROM APPLICATION ROLE"`
+	Restrict        *bool                       `ddl:"keyword" sql:"RESTRICT"`
+	Cascade         *bool                       `ddl:"keyword" sql:"CASCADE"`
+}
+
 // grantPrivilegeToShareOptions is based on https://docs.snowflake.com/en/sql-reference/sql/grant-privilege-share.
 type grantPrivilegeToShareOptions struct {
 	grant      bool                    `ddl:"static" sql:"GRANT"`
@@ -196,6 +239,31 @@ type ShowGrantsOf struct {
 	Share           AccountObjectIdentifier  `ddl:"identifier" sql:"SHARE"`
 }
 
+// ObjectGrants are the grants on a single object matched by the bulk grant.
+type ObjectGrants struct {
+	Object SchemaObjectIdentifier
+	Grants []Grant
+}
+
+// SchemaFutureGrants are the future grants defined in a single schema.
+type SchemaFutureGrants struct {
+	Schema DatabaseObjectIdentifier
+	Grants []Grant
+}
+
+// showSchemaObjectsInOptions lists the objects matched by the bulk grant, e.g. SHOW TABLES IN SCHEMA.
+type showSchemaObjectsInOptions struct {
+	show             bool             `ddl:"static" sql:"SHOW"`
+	PluralObjectType PluralObjectType `ddl:"keyword"`
+	In               *In              `ddl:"keyword" sql:"IN"`
+}
+
+type schemaObjectRow struct {
+	DatabaseName string `db:"database_name"`
+	SchemaName   string `db:"schema_name"`
+	Name         string `db:"name"`
+}
+
 type grantRow struct {
 	CreatedOn   time.Time `db:"created_on"`
 	Privilege   string    `db:"privilege"`
@@ -207,6 +275,8 @@ type grantRow struct {
 	GranteeName string    `db:"grantee_name"`
 	GrantOption bool      `db:"grant_option"`
 	GrantedBy   string    `db:"granted_by"`
+	// Role is returned instead of name by SHOW GRANTS TO USER and SHOW GRANTS OF <role>.
+	Role string `db:"role"`
 }
 
 type Grant struct {
@@ -253,17 +323,22 @@ func (row grantRow) convert() *Grant {
 		grantOn = ObjectTypeModel
 	}
 
+	rawName := row.Name
+	if rawName == "" && row.Role != "" {
+		rawName = row.Role
+	}
+
 	var name ObjectIdentifier
 	var err error
 	// TODO(SNOW-1569535): use a mapper from object type to parsing function
 	if ObjectType(row.GrantedOn).IsWithArguments() {
-		name, err = ParseSchemaObjectIdentifierWithArgumentsAndReturnType(row.Name)
+		name, err = ParseSchemaObjectIdentifierWithArgumentsAndReturnType(rawName)
 	} else {
-		name, err = ParseObjectIdentifierString(row.Name)
+		name, err = ParseObjectIdentifierString(rawName)
 	}
 	if err != nil {
-		log.Printf("[DEBUG] Failed to parse identifier [%s], err = \"%s\"; falling back to fully qualified name conversion", row.Name, err)
-		name = NewObjectIdentifierFromFullyQualifiedName(row.Name)
+		log.Printf("[DEBUG] Failed to parse identifier [%s], err = \"%s\"; falling back to fully qualified name conversion", rawName, err)
+		name = NewObjectIdentifierFromFullyQualifiedName(rawName)
 	}
 
 	return &Grant{
@@ -25,34 +25,10 @@ func (v *grants) GrantPrivilegesToAccountRole(ctx context.Context, privileges *A
 	opts.on = on
 	opts.accountRole = role
 
-	// Snowflake doesn't allow bulk operations on Pipes. Because of that, when SDK user
-	// issues "grant x on all pipes" operation, we'll go and grant specified privileges
-	// to every Pipe one by one.
-	if on != nil &&
-		on.SchemaObject != nil &&
-		on.SchemaObject.All != nil &&
-		on.SchemaObject.All.PluralObjectType == PluralObjectTypePipes {
-		return v.runOnAllPipes(
-			ctx,
-			on.SchemaObject.All.InDatabase,
-			on.SchemaObject.All.InSchema,
-			func(pipe Pipe) error {
-				return v.client.Grants.GrantPrivilegesToAccountRole(
-					ctx,
-					privileges,
-					&AccountRoleGrantOn{
-						SchemaObject: &GrantOnSchemaObject{
-							SchemaObject: &Object{
-								ObjectType: ObjectTypePipe,
-								Name:       pipe.ID(),
-							},
-						},
-					},
-					role,
-					opts,
-				)
-			},
-		)
+	if isOnAllPipes(on) {
+		return v.runOnAllPipesOf(ctx, on, func(pipeOn *AccountRoleGrantOn) error {
+			return v.client.Grants.GrantPrivilegesToAccountRole(ctx, privileges, pipeOn, role, opts)
+		})
 	}
 
 	return validateAndExec(v.client, ctx, opts)
@@ -66,39 +42,53 @@ func (v *grants) RevokePrivilegesFromAccountRole(ctx context.Context, privileges
 	opts.on = on
 	opts.accountRole = role
 
-	// Snowflake doesn't allow bulk operations on Pipes. Because of that, when SDK user
-	// issues "revoke x on all pipes" operation, we'll go and revoke specified privileges
-	// from every Pipe one by one.
-	if on != nil &&
-		on.SchemaObject != nil &&
-		on.SchemaObject.All != nil &&
-		on.SchemaObject.All.PluralObjectType == PluralObjectTypePipes {
-		return v.runOnAllPipes(
-			ctx,
-			on.SchemaObject.All.InDatabase,
-			on.SchemaObject.All.InSchema,
-			func(pipe Pipe) error {
-				return v.client.Grants.RevokePrivilegesFromAccountRole(
-					ctx,
-					privileges,
-					&AccountRoleGrantOn{
-						SchemaObject: &GrantOnSchemaObject{
-							SchemaObject: &Object{
-								ObjectType: ObjectTypePipe,
-								Name:       pipe.ID(),
-							},
-						},
-					},
-					role,
-					opts,
-				)
-			},
-		)
+	if isOnAllPipes(on) {
+		return v.runOnAllPipesOf(ctx, on, func(pipeOn *AccountRoleGrantOn) error {
+			return v.client.Grants.RevokePrivilegesFromAccountRole(ctx, privileges, pipeOn, role, opts)
+		})
 	}
 
 	return validateAndExec(v.client, ctx, opts)
 }
 
+func (v *grants) GrantPrivilegesToAccountRoleInBatches(ctx context.Context, grants []AccountRolePrivilegesGrant, batchSize int) error {
+	opts := make([]*GrantPrivilegesToAccountRoleOptions, len(grants))
+	for i, grant := range grants {
+		if err := validateAccountRolePrivilegesGrantInBatch(grant); err != nil {
+			return err
+		}
+		opts[i] = &GrantPrivilegesToAccountRoleOptions{
+			privileges:      grant.Privileges,
+			on:              grant.On,
+			accountRole:     grant.AccountRole,
+			WithGrantOption: grant.WithGrantOption,
+		}
+	}
+	return validateAndExecInBatches(v.client, ctx, opts, batchSize)
+}
+
+func (v *grants) RevokePrivilegesFromAccountRoleInBatches(ctx context.Context, grants []AccountRolePrivilegesGrant, batchSize int) error {
+	opts := make([]*RevokePrivilegesFromAccountRoleOptions, len(grants))
+	for i, grant := range grants {
+		if err := validateAccountRolePrivilegesGrantInBatch(grant); err != nil {
+			return err
+		}
+		opts[i] = &RevokePrivilegesFromAccountRoleOptions{
+			privileges:  grant.Privileges,
+			on:          grant.On,
+			accountRole: grant.AccountRole,
+		}
+	}
+	return validateAndExecInBatches(v.client, ctx, opts, batchSize)
+}
+
+func validateAccountRolePrivilegesGrantInBatch(grant AccountRolePrivilegesGrant) error {
+	if isOnAllPipes(grant.On) {
+		return fmt.Errorf("the privileges on all pipes cannot be granted to role %s in a batch", grant.AccountRole.FullyQualifiedName())
+	}
+	return nil
+}
+
 func (v *grants) GrantPrivilegesToDatabaseRole(ctx context.Context, privileges *DatabaseRoleGrantPrivileges, on *DatabaseRoleGrantOn, role DatabaseObjectIdentifier, opts *GrantPrivilegesToDatabaseRoleOptions) error {
 	if opts == nil {
 		opts = &GrantPrivilegesToDatabaseRoleOptions{}
@@ -181,6 +171,40 @@ func (v *grants) RevokePrivilegesFromDatabaseRole(ctx context.Context, privilege
 	return validateAndExec(v.client, ctx, opts)
 }
 
+func (v *grants) GrantPrivilegesToApplicationRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role DatabaseObjectIdentifier, opts *GrantPrivilegesToApplicationRoleOptions) error {
+	if opts == nil {
+		opts = &GrantPrivilegesToApplicationRoleOptions{}
+	}
+	opts.privileges = privileges
+	opts.on = on
+	opts.applicationRole = role
+
+	if isOnAllPipes(on) {
+		return v.runOnAllPipesOf(ctx, on, func(pipeOn *AccountRoleGrantOn) error {
+			return v.client.Grants.GrantPrivilegesToApplicationRole(ctx, privileges, pipeOn, role, opts)
+		})
+	}
+
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *grants) RevokePrivilegesFromApplicationRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role DatabaseObjectIdentifier, opts *RevokePrivilegesFromApplicationRoleOptions) error {
+	if opts == nil {
+		opts = &RevokePrivilegesFromApplicationRoleOptions{}
+	}
+	opts.privileges = privileges
+	opts.on = on
+	opts.applicationRole = role
+
+	if isOnAllPipes(on) {
+		return v.runOnAllPipesOf(ctx, on, func(pipeOn *AccountRoleGrantOn) error {
+			return v.client.Grants.RevokePrivilegesFromApplicationRole(ctx, privileges, pipeOn, role, opts)
+		})
+	}
+
+	return validateAndExec(v.client, ctx, opts)
+}
+
 func (v *grants) GrantPrivilegeToShare(ctx context.Context, privileges []ObjectPrivilege, on *ShareGrantOn, to AccountObjectIdentifier) error {
 	opts := &grantPrivilegeToShareOptions{
 		privileges: privileges,
@@ -263,6 +287,12 @@ func (v *grants) Show(ctx context.Context, opts *ShowGrantOptions) ([]Grant, err
 				granteeName = granteeName[strings.IndexRune(granteeName, '.')+1:]
 			}
 			resultList[i].GranteeName = NewAccountObjectIdentifier(granteeName)
+			// application role grantee names are qualified with the application name, e.g. APP."role"
+			if grant.GrantedTo == ObjectTypeApplicationRole {
+				if id, err := ParseDatabaseObjectIdentifier(granteeName); err == nil {
+					resultList[i].GranteeName = id
+				}
+			}
 		} else if !slices.Contains([]ObjectType{ObjectTypeRole, ObjectTypeShare}, grant.GrantedTo) {
 			id, err := ParseDatabaseObjectIdentifier(granteeNameRaw)
 			if err != nil {
@@ -276,6 +306,79 @@ func (v *grants) Show(ctx context.Context, opts *ShowGrantOptions) ([]Grant, err
 	return resultList, nil
 }
 
+func (v *grants) ShowOnAll(ctx context.Context, in *GrantOnSchemaObjectIn, to *ShowGrantsTo) ([]ObjectGrants, error) {
+	if in == nil || to == nil {
+		return nil, ErrNilOptions
+	}
+	opts := &showSchemaObjectsInOptions{PluralObjectType: in.PluralObjectType, In: &In{}}
+	switch {
+	case in.InDatabase != nil:
+		opts.In.Database = *in.InDatabase
+	case in.InSchema != nil:
+		opts.In.Schema = *in.InSchema
+	}
+	objects, err := validateAndQuery[schemaObjectRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// the grants are read with a single SHOW GRANTS TO instead of SHOW GRANTS ON every object, as the containers can hold thousands of objects
+	grants, err := v.Show(ctx, &ShowGrantOptions{To: to})
+	if err != nil {
+		return nil, err
+	}
+	objectType := in.PluralObjectType.Singular()
+	grantsOnObjects := make(map[string][]Grant)
+	for _, grant := range grants {
+		if grant.GrantedOn == objectType && grant.Name != nil {
+			grantsOnObjects[grant.Name.FullyQualifiedName()] = append(grantsOnObjects[grant.Name.FullyQualifiedName()], grant)
+		}
+	}
+
+	result := make([]ObjectGrants, 0, len(objects))
+	for _, object := range objects {
+		// the bulk grants in the database do not apply to the objects in INFORMATION_SCHEMA
+		if object.SchemaName == "INFORMATION_SCHEMA" {
+			continue
+		}
+		id := NewSchemaObjectIdentifier(object.DatabaseName, object.SchemaName, object.Name)
+		result = append(result, ObjectGrants{Object: id, Grants: grantsOnObjects[id.FullyQualifiedName()]})
+	}
+	return result, nil
+}
+
+func (v *grants) ShowFutureInSchemas(ctx context.Context, database AccountObjectIdentifier) ([]SchemaFutureGrants, error) {
+	return futureGrantsInSchemasWithCache(v.client, database, func() ([]SchemaFutureGrants, error) {
+		schemas, err := v.client.Schemas.Show(ctx, &ShowSchemaOptions{
+			In: &SchemaIn{
+				Database: Bool(true),
+				Name:     database,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		result := make([]SchemaFutureGrants, 0, len(schemas))
+		for _, schema := range schemas {
+			if schema.Name == "INFORMATION_SCHEMA" {
+				continue
+			}
+			schemaId := NewDatabaseObjectIdentifier(database.Name(), schema.Name)
+			grants, err := v.Show(ctx, &ShowGrantOptions{
+				Future: Bool(true),
+				In: &ShowGrantsIn{
+					Schema: &schemaId,
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, SchemaFutureGrants{Schema: schemaId, Grants: grants})
+		}
+		return result, nil
+	})
+}
+
 // grantOwnershipOnPipe execution sequence
 //  1. Get the current role.
 //  2. Show grants on the pipe.
@@ -475,6 +578,36 @@ func (v *grants) grantTemporarily(ctx context.Context, privileges *AccountRoleGr
 		)
 }
 
+// isOnAllPipes reports whether the account role grant target is "all pipes". Snowflake doesn't allow bulk operations on Pipes.
+// Because of that, when SDK user issues "grant/revoke x on all pipes" operation, we'll go and grant/revoke specified privileges
+// to/from every Pipe one by one (see runOnAllPipesOf).
+func isOnAllPipes(on *AccountRoleGrantOn) bool {
+	return on != nil &&
+		on.SchemaObject != nil &&
+		on.SchemaObject.All != nil &&
+		on.SchemaObject.All.PluralObjectType == PluralObjectTypePipes
+}
+
+// runOnAllPipesOf runs the command with the grant target of every pipe matched by the "all pipes" account role grant target.
+// It's shared by the account role and the application role grants.
+func (v *grants) runOnAllPipesOf(ctx context.Context, on *AccountRoleGrantOn, command func(*AccountRoleGrantOn) error) error {
+	return v.runOnAllPipes(
+		ctx,
+		on.SchemaObject.All.InDatabase,
+		on.SchemaObject.All.InSchema,
+		func(pipe Pipe) error {
+			return command(&AccountRoleGrantOn{
+				SchemaObject: &GrantOnSchemaObject{
+					SchemaObject: &Object{
+						ObjectType: ObjectTypePipe,
+						Name:       pipe.ID(),
+					},
+				},
+			})
+		},
+	)
+}
+
 func (v *grants) runOnAllPipes(ctx context.Context, inDatabase *AccountObjectIdentifier, inSchema *DatabaseObjectIdentifier, command func(Pipe) error) error {
 	var in *In
 	switch {
@@ -1,7 +1,12 @@
 package sdk
 
 import (
+	"context"
+	"fmt"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGrantPrivilegesToAccountRole(t *testing.T) {
@@ -721,6 +726,169 @@ func TestGrants_RevokePrivilegesFromDatabaseRoleRole(t *testing.T) {
 	})
 }
 
+func TestGrants_GrantPrivilegesToApplicationRole(t *testing.T) {
+	dbId := randomAccountObjectIdentifier()
+	applicationRoleId := randomDatabaseObjectIdentifier()
+	schemaId := randomDatabaseObjectIdentifierInDatabase(dbId)
+	tableId := randomSchemaObjectIdentifierInSchema(schemaId)
+
+	defaultOpts := func() *GrantPrivilegesToApplicationRoleOptions {
+		return &GrantPrivilegesToApplicationRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{
+				SchemaObjectPrivileges: []SchemaObjectPrivilege{SchemaObjectPrivilegeSelect, SchemaObjectPrivilegeInsert},
+			},
+			on: &AccountRoleGrantOn{
+				SchemaObject: &GrantOnSchemaObject{
+					SchemaObject: &Object{
+						ObjectType: ObjectTypeTable,
+						Name:       tableId,
+					},
+				},
+			},
+			applicationRole: applicationRoleId,
+		}
+	}
+
+	t.Run("validation: nil privileges set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("GrantPrivilegesToApplicationRoleOptions", "privileges"))
+	})
+
+	t.Run("validation: nil on set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.on = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("GrantPrivilegesToApplicationRoleOptions", "on"))
+	})
+
+	t.Run("validation: invalid application role identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.applicationRole = emptyDatabaseObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: future schemas", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = &AccountRoleGrantPrivileges{
+			SchemaPrivileges: []SchemaPrivilege{SchemaPrivilegeUsage},
+		}
+		opts.on = &AccountRoleGrantOn{
+			Schema: &GrantOnSchema{
+				FutureSchemasInDatabase: Pointer(dbId),
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, fmt.Errorf("future grants are not supported for application roles"))
+	})
+
+	t.Run("validation: future schema objects", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.on.SchemaObject = &GrantOnSchemaObject{
+			Future: &GrantOnSchemaObjectIn{
+				PluralObjectType: PluralObjectTypeTables,
+				InSchema:         Pointer(schemaId),
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, fmt.Errorf("future grants are not supported for application roles"))
+	})
+
+	t.Run("on account", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = &AccountRoleGrantPrivileges{
+			GlobalPrivileges: []GlobalPrivilege{GlobalPrivilegeMonitorUsage},
+		}
+		opts.on = &AccountRoleGrantOn{
+			Account: Bool(true),
+		}
+		opts.WithGrantOption = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `GRANT MONITOR USAGE ON ACCOUNT TO APPLICATION ROLE %s WITH GRANT OPTION`, applicationRoleId.FullyQualifiedName())
+	})
+
+	t.Run("on account object", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = &AccountRoleGrantPrivileges{
+			AllPrivileges: Bool(true),
+		}
+		opts.on = &AccountRoleGrantOn{
+			AccountObject: &GrantOnAccountObject{
+				Database: Pointer(dbId),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT ALL PRIVILEGES ON DATABASE %s TO APPLICATION ROLE %s`, dbId.FullyQualifiedName(), applicationRoleId.FullyQualifiedName())
+	})
+
+	t.Run("on all schemas in database", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = &AccountRoleGrantPrivileges{
+			SchemaPrivileges: []SchemaPrivilege{SchemaPrivilegeUsage},
+		}
+		opts.on = &AccountRoleGrantOn{
+			Schema: &GrantOnSchema{
+				AllSchemasInDatabase: Pointer(dbId),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT USAGE ON ALL SCHEMAS IN DATABASE %s TO APPLICATION ROLE %s`, dbId.FullyQualifiedName(), applicationRoleId.FullyQualifiedName())
+	})
+
+	t.Run("on schema object", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `GRANT SELECT, INSERT ON TABLE %s TO APPLICATION ROLE %s`, tableId.FullyQualifiedName(), applicationRoleId.FullyQualifiedName())
+	})
+
+	t.Run("on all schema objects in schema", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.on.SchemaObject = &GrantOnSchemaObject{
+			All: &GrantOnSchemaObjectIn{
+				PluralObjectType: PluralObjectTypeTables,
+				InSchema:         Pointer(schemaId),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT SELECT, INSERT ON ALL TABLES IN SCHEMA %s TO APPLICATION ROLE %s`, schemaId.FullyQualifiedName(), applicationRoleId.FullyQualifiedName())
+	})
+}
+
+func TestGrants_RevokePrivilegesFromApplicationRole(t *testing.T) {
+	applicationRoleId := randomDatabaseObjectIdentifier()
+	schemaId := randomDatabaseObjectIdentifier()
+	tableId := randomSchemaObjectIdentifierInSchema(schemaId)
+
+	defaultOpts := func() *RevokePrivilegesFromApplicationRoleOptions {
+		return &RevokePrivilegesFromApplicationRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{
+				SchemaObjectPrivileges: []SchemaObjectPrivilege{SchemaObjectPrivilegeSelect},
+			},
+			on: &AccountRoleGrantOn{
+				SchemaObject: &GrantOnSchemaObject{
+					SchemaObject: &Object{
+						ObjectType: ObjectTypeTable,
+						Name:       tableId,
+					},
+				},
+			},
+			applicationRole: applicationRoleId,
+		}
+	}
+
+	t.Run("validation: nil privileges set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("RevokePrivilegesFromApplicationRoleOptions", "privileges"))
+	})
+
+	t.Run("validation: restrict and cascade", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Restrict = Bool(true)
+		opts.Cascade = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("RevokePrivilegesFromApplicationRoleOptions", "Restrict", "Cascade"))
+	})
+
+	t.Run("on schema object", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.GrantOptionFor = Bool(true)
+		opts.Cascade = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `REVOKE GRANT OPTION FOR SELECT ON TABLE %s FROM APPLICATION ROLE %s CASCADE`, tableId.FullyQualifiedName(), applicationRoleId.FullyQualifiedName())
+	})
+}
+
 func TestGrantPrivilegeToShare(t *testing.T) {
 	id := randomAccountObjectIdentifier()
 	t.Run("on database", func(t *testing.T) {
@@ -1092,3 +1260,106 @@ func TestGrantShow(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, "SHOW GRANTS OF SHARE %s", shareID.FullyQualifiedName())
 	})
 }
+
+func TestShowSchemaObjectsIn(t *testing.T) {
+	t.Run("in database", func(t *testing.T) {
+		databaseId := randomAccountObjectIdentifier()
+		opts := &showSchemaObjectsInOptions{
+			PluralObjectType: PluralObjectTypeTables,
+			In:               &In{Database: databaseId},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW TABLES IN DATABASE %s", databaseId.FullyQualifiedName())
+	})
+
+	t.Run("in schema", func(t *testing.T) {
+		schemaId := randomDatabaseObjectIdentifier()
+		opts := &showSchemaObjectsInOptions{
+			PluralObjectType: PluralObjectTypeMaterializedViews,
+			In:               &In{Schema: schemaId},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW MATERIALIZED VIEWS IN SCHEMA %s", schemaId.FullyQualifiedName())
+	})
+
+	t.Run("validation: objects with arguments", func(t *testing.T) {
+		opts := &showSchemaObjectsInOptions{
+			PluralObjectType: PluralObjectTypeFunctions,
+			In:               &In{Database: randomAccountObjectIdentifier()},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, fmt.Errorf("objects of type FUNCTIONS are not supported"))
+	})
+
+	t.Run("validation: in not set", func(t *testing.T) {
+		opts := &showSchemaObjectsInOptions{
+			PluralObjectType: PluralObjectTypeTables,
+			In:               &In{},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("showSchemaObjectsInOptions.In", "Database", "Schema"))
+	})
+}
+
+func TestGrants_GrantPrivilegesToAccountRoleInBatches(t *testing.T) {
+	role := randomAccountObjectIdentifier()
+	warehouse := randomAccountObjectIdentifier()
+	schema := randomDatabaseObjectIdentifier()
+	grants := []AccountRolePrivilegesGrant{
+		{
+			Privileges:  &AccountRoleGrantPrivileges{GlobalPrivileges: []GlobalPrivilege{GlobalPrivilegeCreateDatabase}},
+			On:          &AccountRoleGrantOn{Account: Bool(true)},
+			AccountRole: role,
+		},
+		{
+			Privileges:      &AccountRoleGrantPrivileges{AccountObjectPrivileges: []AccountObjectPrivilege{AccountObjectPrivilegeUsage}},
+			On:              &AccountRoleGrantOn{AccountObject: &GrantOnAccountObject{Warehouse: Pointer(warehouse)}},
+			AccountRole:     role,
+			WithGrantOption: Bool(true),
+		},
+		{
+			Privileges:  &AccountRoleGrantPrivileges{SchemaPrivileges: []SchemaPrivilege{SchemaPrivilegeUsage}},
+			On:          &AccountRoleGrantOn{Schema: &GrantOnSchema{Schema: Pointer(schema)}},
+			AccountRole: role,
+		},
+	}
+
+	t.Run("grants in batches", func(t *testing.T) {
+		client := NewDryRunClient()
+
+		err := client.Grants.GrantPrivilegesToAccountRoleInBatches(context.Background(), grants, 2)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{
+			fmt.Sprintf("GRANT CREATE DATABASE ON ACCOUNT TO ROLE %s;\nGRANT USAGE ON WAREHOUSE %s TO ROLE %s WITH GRANT OPTION", role.FullyQualifiedName(), warehouse.FullyQualifiedName(), role.FullyQualifiedName()),
+			fmt.Sprintf("GRANT USAGE ON SCHEMA %s TO ROLE %s", schema.FullyQualifiedName(), role.FullyQualifiedName()),
+		}, client.TraceLogs())
+	})
+
+	t.Run("revokes in batches", func(t *testing.T) {
+		client := NewDryRunClient()
+
+		err := client.Grants.RevokePrivilegesFromAccountRoleInBatches(context.Background(), grants, 3)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{
+			fmt.Sprintf("REVOKE CREATE DATABASE ON ACCOUNT FROM ROLE %[1]s;\nREVOKE USAGE ON WAREHOUSE %[2]s FROM ROLE %[1]s;\nREVOKE USAGE ON SCHEMA %[3]s FROM ROLE %[1]s", role.FullyQualifiedName(), warehouse.FullyQualifiedName(), schema.FullyQualifiedName()),
+		}, client.TraceLogs())
+	})
+
+	t.Run("validation: invalid grant prevents the whole batch", func(t *testing.T) {
+		client := NewDryRunClient()
+		invalid := append(grants, AccountRolePrivilegesGrant{
+			Privileges:  &AccountRoleGrantPrivileges{SchemaObjectPrivileges: []SchemaObjectPrivilege{SchemaObjectPrivilegeMonitor}},
+			On:          &AccountRoleGrantOn{SchemaObject: &GrantOnSchemaObject{All: &GrantOnSchemaObjectIn{PluralObjectType: PluralObjectTypePipes, InSchema: Pointer(schema)}}},
+			AccountRole: role,
+		})
+
+		err := client.Grants.GrantPrivilegesToAccountRoleInBatches(context.Background(), invalid, 2)
+		require.ErrorContains(t, err, "the privileges on all pipes cannot be granted")
+		assert.Empty(t, client.TraceLogs())
+	})
+
+	t.Run("validation: batch size", func(t *testing.T) {
+		client := NewDryRunClient()
+
+		err := client.Grants.GrantPrivilegesToAccountRoleInBatches(context.Background(), grants, 0)
+		require.ErrorContains(t, err, "batch size has to be positive")
+	})
+}
@@ -15,6 +15,7 @@ var (
 	_ validatable = new(revokePrivilegeFromShareOptions)
 	_ validatable = new(GrantOwnershipOptions)
 	_ validatable = new(ShowGrantOptions)
+	_ validatable = new(showSchemaObjectsInOptions)
 )
 
 // based on https://docs.snowflake.com/en/sql-reference/sql/grant-ownership#required-parameters
@@ -107,6 +108,19 @@ var validGrantToObjectTypes = []ObjectType{
 	ObjectTypeDataset,   // added because of https://github.com/Snowflake-Labs/terraform-provider-snowflake/issues/2807
 }
 
+// ValidGrantToAccountObjectTypes are the account object types on which the privileges can be granted (see GrantOnAccountObject).
+var ValidGrantToAccountObjectTypes = []ObjectType{
+	ObjectTypeUser,
+	ObjectTypeResourceMonitor,
+	ObjectTypeWarehouse,
+	ObjectTypeComputePool,
+	ObjectTypeDatabase,
+	ObjectTypeIntegration,
+	ObjectTypeFailoverGroup,
+	ObjectTypeReplicationGroup,
+	ObjectTypeExternalVolume,
+}
+
 // based on https://docs.snowflake.com/en/sql-reference/sql/grant-privilege#restrictions-and-limitations
 var invalidGrantToFutureObjectTypes = []ObjectType{
 	ObjectTypeComputePool,
@@ -129,6 +143,7 @@ var (
 	ValidGrantOwnershipObjectTypesString       = make([]string, len(validGrantOwnershipObjectTypes))
 	ValidGrantOwnershipPluralObjectTypesString = make([]string, len(validGrantOwnershipObjectTypes))
 	ValidGrantToObjectTypesString              = make([]string, len(validGrantToObjectTypes))
+	ValidGrantToAccountObjectTypesString       = make([]string, len(ValidGrantToAccountObjectTypes))
 	ValidGrantToPluralObjectTypesString        = make([]string, len(validGrantToObjectTypes))
 	ValidGrantToFuturePluralObjectTypesString  = make([]string, 0)
 )
@@ -138,6 +153,9 @@ func init() {
 		ValidGrantOwnershipObjectTypesString[i] = objectType.String()
 		ValidGrantOwnershipPluralObjectTypesString[i] = objectType.Plural().String()
 	}
+	for i, objectType := range ValidGrantToAccountObjectTypes {
+		ValidGrantToAccountObjectTypesString[i] = objectType.String()
+	}
 	for i, objectType := range validGrantToObjectTypes {
 		ValidGrantToObjectTypesString[i] = objectType.String()
 		ValidGrantToPluralObjectTypesString[i] = objectType.Plural().String()
@@ -342,6 +360,74 @@ func (opts *RevokePrivilegesFromDatabaseRoleOptions) validate() error {
 	return errors.Join(errs...)
 }
 
+func (opts *GrantPrivilegesToApplicationRoleOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !valueSet(opts.privileges) {
+		errs = append(errs, errNotSet("GrantPrivilegesToApplicationRoleOptions", "privileges"))
+	} else {
+		if err := opts.privileges.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !valueSet(opts.on) {
+		errs = append(errs, errNotSet("GrantPrivilegesToApplicationRoleOptions", "on"))
+	} else {
+		if err := validateApplicationRoleGrantOn(opts.on); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !ValidObjectIdentifier(opts.applicationRole) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *RevokePrivilegesFromApplicationRoleOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !valueSet(opts.privileges) {
+		errs = append(errs, errNotSet("RevokePrivilegesFromApplicationRoleOptions", "privileges"))
+	} else {
+		if err := opts.privileges.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !valueSet(opts.on) {
+		errs = append(errs, errNotSet("RevokePrivilegesFromApplicationRoleOptions", "on"))
+	} else {
+		if err := validateApplicationRoleGrantOn(opts.on); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !ValidObjectIdentifier(opts.applicationRole) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.Restrict, opts.Cascade) {
+		errs = append(errs, errOneOf("RevokePrivilegesFromApplicationRoleOptions", "Restrict", "Cascade"))
+	}
+	return errors.Join(errs...)
+}
+
+// validateApplicationRoleGrantOn rejects future grants, which Snowflake does not allow for application roles.
+func validateApplicationRoleGrantOn(on *AccountRoleGrantOn) error {
+	var errs []error
+	if err := on.validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if on.Schema != nil && valueSet(on.Schema.FutureSchemasInDatabase) {
+		errs = append(errs, fmt.Errorf("future grants are not supported for application roles"))
+	}
+	if on.SchemaObject != nil && valueSet(on.SchemaObject.Future) {
+		errs = append(errs, fmt.Errorf("future grants are not supported for application roles"))
+	}
+	return errors.Join(errs...)
+}
+
 func (opts *grantPrivilegeToShareOptions) validate() error {
 	if opts == nil {
 		return errors.Join(ErrNilOptions)
@@ -457,3 +543,21 @@ func (opts *ShowGrantOptions) validate() error {
 	}
 	return nil
 }
+
+func (opts *showSchemaObjectsInOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !valueSet(opts.PluralObjectType) {
+		errs = append(errs, errNotSet("showSchemaObjectsInOptions", "PluralObjectType"))
+	}
+	// the objects with arguments cannot be identified by the name returned from SHOW
+	if opts.PluralObjectType.Singular().IsWithArguments() {
+		errs = append(errs, fmt.Errorf("objects of type %s are not supported", opts.PluralObjectType))
+	}
+	if !valueSet(opts.In) || !exactlyOneValueSet(opts.In.Database, opts.In.Schema) {
+		errs = append(errs, errExactlyOneOf("showSchemaObjectsInOptions.In", "Database", "Schema"))
+	}
+	return errors.Join(errs...)
+}
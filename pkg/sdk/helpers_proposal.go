@@ -2,6 +2,7 @@ package sdk
 
 import (
 	"context"
+	"fmt"
 )
 
 // validatable is our sdk interface for anything that can be validated (e.g. CreateXxxOptions).
@@ -23,6 +24,43 @@ func validateAndExec(client *Client, ctx context.Context, opts validatable) erro
 	return err
 }
 
+// validateAndExecAsync is validateAndExec for the statements that can take a long time; see Client.execAsync.
+func validateAndExecAsync(client *Client, ctx context.Context, opts validatable) error {
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	return client.execAsync(ctx, sql)
+}
+
+// validateAndExecInBatches validates all the statements first and then executes them in multi-statement calls of at most batchSize statements.
+func validateAndExecInBatches[T validatable](client *Client, ctx context.Context, opts []T, batchSize int) error {
+	if batchSize < 1 {
+		return fmt.Errorf("batch size has to be positive, got %d", batchSize)
+	}
+	statements := make([]string, len(opts))
+	for i, o := range opts {
+		if err := o.validate(); err != nil {
+			return err
+		}
+		sql, err := structToSQL(o)
+		if err != nil {
+			return err
+		}
+		statements[i] = sql
+	}
+	for start := 0; start < len(statements); start += batchSize {
+		batch := statements[start:min(start+batchSize, len(statements))]
+		if err := client.execMultiStatement(ctx, batch); err != nil {
+			return fmt.Errorf("executing statements %d-%d of %d: %w", start+1, start+len(batch), len(statements), err)
+		}
+	}
+	return nil
+}
+
 // validateAndQuery is just a proposal how we can remove some of the boilerplate.
 func validateAndQuery[T any](client *Client, ctx context.Context, opts validatable) ([]T, error) {
 	if err := opts.validate(); err != nil {
@@ -0,0 +1,94 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math/rand/v2"
+	"reflect"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// transientErrors are the errors after which the statement can succeed when it is run again.
+var transientErrors = []error{
+	ErrTransientLock,
+	ErrGrantPartiallyExecuted,
+	ErrServiceUnavailable,
+}
+
+// RetryPolicy controls how the client retries the statements that failed with one of the transient errors.
+// The retries are delayed with exponential backoff with jitter.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the initial attempt; zero disables the retries.
+	MaxRetries     int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries:     3,
+	InitialBackoff: time.Second,
+	MaxBackoff:     10 * time.Second,
+}
+
+// SetRetryPolicy changes the retry policy of the client (DefaultRetryPolicy is used by default).
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+	c.retryPolicy = policy
+}
+
+func isTransientError(err error) bool {
+	for _, transientErr := range transientErrors {
+		if errors.Is(err, transientErr) {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay before the given retry (starting from 0): the exponential backoff capped by MaxBackoff,
+// of which the upper half is randomized to spread the retries of the statements failed at the same time.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 0; i < retry && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	delay = min(delay, p.MaxBackoff)
+	if delay <= 0 {
+		return 0
+	}
+	half := delay / 2
+	return half + rand.N(delay-half+1)
+}
+
+// withRetries runs the given statement and decodes its error. The statement is rerun on transient errors, according to the client's retry policy.
+// Every attempt is run with its own context, in which the driver reports the query id to the current span (see withQueryIdRecorder).
+func (c *Client) withRetries(ctx context.Context, sql string, run func(ctx context.Context) error) error {
+	for retry := 0; ; retry++ {
+		attemptCtx, recordQueryId := withQueryIdRecorder(ctx)
+		err := decodeDriverError(run(attemptCtx), sql)
+		recordQueryId()
+		if err == nil || !isTransientError(err) || retry >= c.retryPolicy.MaxRetries {
+			if retry > 0 {
+				trace.SpanFromContext(ctx).SetAttributes(attribute.Int(spanAttributeRetries, retry))
+			}
+			return err
+		}
+		delay := c.retryPolicy.backoff(retry)
+		log.Printf("[DEBUG] transient error, retrying in %v (retry %d of %d): %v", delay, retry+1, c.retryPolicy.MaxRetries, err)
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(delay):
+		}
+	}
+}
+
+// truncateSlice sets the length of the slice pointed by dest to zero.
+func truncateSlice(dest any) {
+	if v := reflect.ValueOf(dest); v.Kind() == reflect.Pointer && v.Elem().Kind() == reflect.Slice {
+		v.Elem().SetLen(0)
+	}
+}
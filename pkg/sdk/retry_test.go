@@ -0,0 +1,109 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Client_retries(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+	lockErr := &gosnowflake.SnowflakeError{Number: 625, SQLState: "57014", Message: "Statement has locked table"}
+	tooManyRequestsErr := &gosnowflake.SnowflakeError{Number: gosnowflake.ErrFailedToPostQuery, SQLState: gosnowflake.SQLStateConnectionFailure, Message: "failed to POST. HTTP: %v, URL: %v", MessageArgs: []any{http.StatusTooManyRequests, "url"}}
+	partialGrantErr := errors.New("Grant partially executed: privileges [REFERENCE_USAGE] not granted.")
+	insufficientPrivilegesErr := &gosnowflake.SnowflakeError{Number: 3001, SQLState: "42501", Message: "Insufficient privileges"}
+
+	type row struct {
+		Name string `db:"name"`
+	}
+
+	t.Run("exec succeeds after transient errors", func(t *testing.T) {
+		client, fake := fakeDriverClient(t, policy, lockErr, tooManyRequestsErr, partialGrantErr)
+
+		_, err := client.exec(context.Background(), `ALTER TABLE "DB"."SCHEMA"."TABLE" ADD COLUMN "C" INT`)
+
+		require.NoError(t, err)
+		assert.Equal(t, 4, fake.statements)
+	})
+
+	t.Run("query succeeds after transient errors without duplicated rows", func(t *testing.T) {
+		client, fake := fakeDriverClient(t, policy, lockErr)
+
+		var rows []row
+		err := client.query(context.Background(), &rows, `SHOW TABLES`)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, fake.statements)
+		assert.Equal(t, []row{{Name: "A"}, {Name: "B"}}, rows)
+	})
+
+	t.Run("query one succeeds after transient errors", func(t *testing.T) {
+		client, fake := fakeDriverClient(t, policy, tooManyRequestsErr)
+
+		var result row
+		err := client.queryOne(context.Background(), &result, `SELECT 'A' AS name`)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, fake.statements)
+		assert.Equal(t, "A", result.Name)
+	})
+
+	t.Run("non-transient error is not retried", func(t *testing.T) {
+		client, fake := fakeDriverClient(t, policy, insufficientPrivilegesErr)
+
+		_, err := client.exec(context.Background(), `DROP TABLE "DB"."SCHEMA"."TABLE"`)
+
+		require.ErrorIs(t, err, ErrInsufficientPrivileges)
+		assert.Equal(t, 1, fake.statements)
+	})
+
+	t.Run("error is returned after the retries are exhausted", func(t *testing.T) {
+		client, fake := fakeDriverClient(t, policy, lockErr, lockErr, lockErr, lockErr, lockErr)
+
+		_, err := client.exec(context.Background(), `ALTER TABLE "DB"."SCHEMA"."TABLE" ADD COLUMN "C" INT`)
+
+		require.ErrorIs(t, err, ErrTransientLock)
+		assert.Equal(t, 4, fake.statements)
+	})
+
+	t.Run("retries disabled", func(t *testing.T) {
+		client, fake := fakeDriverClient(t, RetryPolicy{}, lockErr)
+
+		_, err := client.exec(context.Background(), `ALTER TABLE "DB"."SCHEMA"."TABLE" ADD COLUMN "C" INT`)
+
+		require.ErrorIs(t, err, ErrTransientLock)
+		assert.Equal(t, 1, fake.statements)
+	})
+
+	t.Run("retries stop when the context is canceled", func(t *testing.T) {
+		client, fake := fakeDriverClient(t, RetryPolicy{MaxRetries: 3, InitialBackoff: time.Hour, MaxBackoff: time.Hour}, lockErr)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := client.exec(ctx, `ALTER TABLE "DB"."SCHEMA"."TABLE" ADD COLUMN "C" INT`)
+
+		require.ErrorIs(t, err, ErrTransientLock)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 1, fake.statements)
+	})
+}
+
+func Test_RetryPolicy_backoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for retry, expectedMax := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		for range 20 {
+			delay := policy.backoff(retry)
+			assert.GreaterOrEqual(t, delay, expectedMax/2)
+			assert.LessOrEqual(t, delay, expectedMax)
+		}
+	}
+
+	assert.Equal(t, time.Duration(0), RetryPolicy{}.backoff(3))
+}
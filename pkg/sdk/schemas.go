@@ -562,6 +562,16 @@ func (v *schemas) Show(ctx context.Context, opts *ShowSchemaOptions) ([]Schema,
 }
 
 func (v *schemas) ShowByID(ctx context.Context, id DatabaseObjectIdentifier) (*Schema, error) {
+	return showByIdWithCache(v.client, ObjectTypeSchema, id.DatabaseId(), id.Name(),
+		func(s Schema) string { return s.Name },
+		func() ([]Schema, error) {
+			return v.Show(ctx, &ShowSchemaOptions{In: &SchemaIn{Database: Bool(true), Name: id.DatabaseId()}})
+		},
+		func() (*Schema, error) { return v.showByID(ctx, id) },
+	)
+}
+
+func (v *schemas) showByID(ctx context.Context, id DatabaseObjectIdentifier) (*Schema, error) {
 	schemas, err := v.client.Schemas.Show(ctx, &ShowSchemaOptions{
 		In: &SchemaIn{
 			Database: Bool(true),
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 )
 
 var (
@@ -132,6 +133,23 @@ const (
 	SecondaryRolesNone SecondaryRoleOption = "NONE"
 )
 
+var AllSecondaryRoleOptions = []SecondaryRoleOption{
+	SecondaryRolesAll,
+	SecondaryRolesNone,
+}
+
+func ToSecondaryRoleOption(s string) (SecondaryRoleOption, error) {
+	s = strings.ToUpper(s)
+	switch s {
+	case string(SecondaryRolesAll):
+		return SecondaryRolesAll, nil
+	case string(SecondaryRolesNone):
+		return SecondaryRolesNone, nil
+	default:
+		return "", fmt.Errorf("invalid SecondaryRoleOption: %s", s)
+	}
+}
+
 func (v *sessions) UseSecondaryRoles(ctx context.Context, opt SecondaryRoleOption) error {
 	sql := fmt.Sprintf(`USE SECONDARY ROLES %s`, opt)
 	_, err := v.client.exec(ctx, sql)
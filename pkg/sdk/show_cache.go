@@ -0,0 +1,220 @@
+package sdk
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+// showCache holds the results of SHOW X IN DATABASE/SCHEMA queries. It lets ShowByID serve the lookups of many objects
+// from the same container with a single query. The entries are invalidated by every exec that references the container.
+type showCache struct {
+	mu      sync.Mutex
+	entries map[showCacheKey]*showCacheEntry
+}
+
+type showCacheKey struct {
+	objectType ObjectType
+	container  string
+}
+
+type showCacheEntry struct {
+	ready   chan struct{}
+	objects any
+	err     error
+}
+
+func newShowCache() *showCache {
+	return &showCache{entries: make(map[showCacheKey]*showCacheEntry)}
+}
+
+// EnableShowCache turns on caching of SHOW results for the lifetime of the client. After it is enabled, ShowByID of the supported
+// object types (schemas, database roles, tables, views, streams, and tasks) lists all the objects of the given type in the container once,
+// and serves the subsequent lookups in the same container from the cached result.
+func (c *Client) EnableShowCache() {
+	c.showCache = newShowCache()
+}
+
+// get returns the cached objects for the given key, or loads them. Concurrent calls for the same key wait for a single load.
+// Errors are not cached.
+func (sc *showCache) get(key showCacheKey, load func() (any, error)) (any, error) {
+	sc.mu.Lock()
+	if entry, ok := sc.entries[key]; ok {
+		sc.mu.Unlock()
+		<-entry.ready
+		if entry.err == nil {
+			return entry.objects, nil
+		}
+		return sc.get(key, load)
+	}
+	entry := &showCacheEntry{ready: make(chan struct{})}
+	sc.entries[key] = entry
+	sc.mu.Unlock()
+
+	entry.objects, entry.err = load()
+	if entry.err != nil {
+		sc.mu.Lock()
+		if sc.entries[key] == entry {
+			delete(sc.entries, key)
+		}
+		sc.mu.Unlock()
+	}
+	close(entry.ready)
+	return entry.objects, entry.err
+}
+
+// invalidate removes all the entries for containers referenced in the given statement, together with the containers nested in them
+// (e.g. DROP DATABASE "DB" removes the entries for "DB" and "DB"."SCHEMA"), and the containers of the referenced objects
+// (e.g. ALTER TABLE "DB"."SCHEMA"."TABLE" removes the entries for "DB" and "DB"."SCHEMA").
+func (sc *showCache) invalidate(sql string) {
+	referenced := referencedIdentifiers(sql)
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	for key := range sc.entries {
+		container := referencedIdentifiers(key.container)
+		if len(container) == 0 {
+			continue
+		}
+		if slices.ContainsFunc(referenced, func(parts []string) bool {
+			return hasPrefixParts(container[0], parts) || hasPrefixParts(parts, container[0])
+		}) {
+			delete(sc.entries, key)
+		}
+	}
+}
+
+func hasPrefixParts(parts []string, prefix []string) bool {
+	return len(prefix) <= len(parts) && slices.Equal(parts[:len(prefix)], prefix)
+}
+
+// referencedIdentifiers returns the identifiers referenced in the statement as the lists of their parts (e.g. [DB SCHEMA TABLE] for "DB".schema."TABLE").
+// The unquoted parts are uppercased in the same way as Snowflake resolves them. The string literals are skipped. Keywords are returned
+// as single-part identifiers too; they only cause more entries to be invalidated.
+func referencedIdentifiers(sql string) [][]string {
+	var identifiers [][]string
+	var current []string
+	afterDot := false
+	addPart := func(part string) {
+		if afterDot && len(current) > 0 {
+			current = append(current, part)
+		} else {
+			if len(current) > 0 {
+				identifiers = append(identifiers, current)
+			}
+			current = []string{part}
+		}
+		afterDot = false
+	}
+	for i := 0; i < len(sql); {
+		switch c := sql[i]; {
+		case c == '"':
+			var part strings.Builder
+			for i++; i < len(sql); i++ {
+				if sql[i] == '"' {
+					if i+1 < len(sql) && sql[i+1] == '"' {
+						part.WriteByte('"')
+						i++
+						continue
+					}
+					break
+				}
+				part.WriteByte(sql[i])
+			}
+			i++
+			addPart(part.String())
+		case c == '\'':
+			for i++; i < len(sql); i++ {
+				if sql[i] == '\\' {
+					i++
+					continue
+				}
+				if sql[i] == '\'' {
+					if i+1 < len(sql) && sql[i+1] == '\'' {
+						i++
+						continue
+					}
+					break
+				}
+			}
+			i++
+			afterDot = false
+		case isUnquotedIdentifierStart(c):
+			start := i
+			for i < len(sql) && (isUnquotedIdentifierStart(sql[i]) || (sql[i] >= '0' && sql[i] <= '9') || sql[i] == '$') {
+				i++
+			}
+			addPart(strings.ToUpper(sql[start:i]))
+		case c == '.':
+			afterDot = true
+			i++
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		default:
+			afterDot = false
+			i++
+		}
+	}
+	if len(current) > 0 {
+		identifiers = append(identifiers, current)
+	}
+	return identifiers
+}
+
+func isUnquotedIdentifierStart(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '_' || c >= 0x80
+}
+
+// showByIdWithCache returns the object with the given name from the cached list of all objects in the container.
+// When the cache is disabled or bypassed, or the object is not in the cached list, it falls back to the regular showById.
+func showByIdWithCache[T any](
+	client *Client,
+	objectType ObjectType,
+	container ObjectIdentifier,
+	name string,
+	nameOf func(T) string,
+	showAll func() ([]T, error),
+	showById func() (*T, error),
+) (*T, error) {
+	if client.showCache == nil || client.bypassShowCache {
+		return showById()
+	}
+	key := showCacheKey{objectType: objectType, container: container.FullyQualifiedName()}
+	objects, err := client.showCache.get(key, func() (any, error) {
+		return showAll()
+	})
+	if err != nil {
+		return nil, err
+	}
+	if object, err := collections.FindFirst(objects.([]T), func(o T) bool { return nameOf(o) == name }); err == nil {
+		return object, nil
+	}
+	// SHOW without LIKE returns at most 10K rows, so the missing object is looked up directly to confirm it does not exist.
+	return showById()
+}
+
+// futureGrantsInSchemasWithCache returns the schema-level future grants in the database from the cache, or loads them.
+// When the cache is missing or bypassed, the grants are always loaded.
+func futureGrantsInSchemasWithCache(client *Client, database AccountObjectIdentifier, load func() ([]SchemaFutureGrants, error)) ([]SchemaFutureGrants, error) {
+	if client.futureGrantsCache == nil || client.bypassShowCache {
+		return load()
+	}
+	key := showCacheKey{objectType: ObjectTypeSchema, container: database.FullyQualifiedName()}
+	grants, err := client.futureGrantsCache.get(key, func() (any, error) {
+		return load()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return grants.([]SchemaFutureGrants), nil
+}
+
+func (c *Client) invalidateShowCache(sql string) {
+	if c.showCache != nil {
+		c.showCache.invalidate(sql)
+	}
+	if c.futureGrantsCache != nil {
+		c.futureGrantsCache.invalidate(sql)
+	}
+}
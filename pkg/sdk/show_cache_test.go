@@ -0,0 +1,224 @@
+package sdk
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_showByIdWithCache(t *testing.T) {
+	databaseId := NewAccountObjectIdentifier("DB")
+	otherDatabaseId := NewAccountObjectIdentifier("DB2")
+
+	type showCounter struct {
+		showAll  int
+		showById int
+	}
+
+	showByIdWithCounter := func(client *Client, counter *showCounter, containerId AccountObjectIdentifier, name string, names ...string) (*DatabaseRole, error) {
+		return showByIdWithCache(client, ObjectTypeDatabaseRole, containerId, name,
+			func(r DatabaseRole) string { return r.Name },
+			func() ([]DatabaseRole, error) {
+				counter.showAll++
+				roles := make([]DatabaseRole, len(names))
+				for i, n := range names {
+					roles[i] = DatabaseRole{Name: n, DatabaseName: containerId.Name()}
+				}
+				return roles, nil
+			},
+			func() (*DatabaseRole, error) {
+				counter.showById++
+				return &DatabaseRole{Name: name, DatabaseName: containerId.Name()}, nil
+			},
+		)
+	}
+
+	t.Run("cache disabled", func(t *testing.T) {
+		client := &Client{}
+		counter := &showCounter{}
+
+		for _, name := range []string{"A", "B"} {
+			role, err := showByIdWithCounter(client, counter, databaseId, name, "A", "B")
+			require.NoError(t, err)
+			assert.Equal(t, name, role.Name)
+		}
+
+		assert.Equal(t, showCounter{showAll: 0, showById: 2}, *counter)
+	})
+
+	t.Run("lookups in the same container are served from the cache", func(t *testing.T) {
+		client := &Client{}
+		client.EnableShowCache()
+		counter := &showCounter{}
+
+		for _, name := range []string{"A", "B", "A"} {
+			role, err := showByIdWithCounter(client, counter, databaseId, name, "A", "B")
+			require.NoError(t, err)
+			assert.Equal(t, name, role.Name)
+		}
+		_, err := showByIdWithCounter(client, counter, otherDatabaseId, "A", "A")
+		require.NoError(t, err)
+
+		assert.Equal(t, showCounter{showAll: 2, showById: 0}, *counter)
+	})
+
+	t.Run("object missing in the cache is looked up directly", func(t *testing.T) {
+		client := &Client{}
+		client.EnableShowCache()
+		counter := &showCounter{}
+
+		role, err := showByIdWithCounter(client, counter, databaseId, "C", "A", "B")
+		require.NoError(t, err)
+		assert.Equal(t, "C", role.Name)
+
+		assert.Equal(t, showCounter{showAll: 1, showById: 1}, *counter)
+	})
+
+	t.Run("statement referencing the container invalidates the cache", func(t *testing.T) {
+		client := &Client{}
+		client.EnableShowCache()
+		counter := &showCounter{}
+
+		_, err := showByIdWithCounter(client, counter, databaseId, "A", "A")
+		require.NoError(t, err)
+		_, err = showByIdWithCounter(client, counter, otherDatabaseId, "A", "A")
+		require.NoError(t, err)
+
+		client.invalidateShowCache(`CREATE DATABASE ROLE "DB"."B"`)
+
+		_, err = showByIdWithCounter(client, counter, databaseId, "B", "A", "B")
+		require.NoError(t, err)
+		_, err = showByIdWithCounter(client, counter, otherDatabaseId, "A", "A")
+		require.NoError(t, err)
+
+		assert.Equal(t, showCounter{showAll: 3, showById: 0}, *counter)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		client := &Client{}
+		client.EnableShowCache()
+		calls := 0
+		showAll := func() ([]DatabaseRole, error) {
+			calls++
+			return nil, errors.New("show error")
+		}
+		showById := func() (*DatabaseRole, error) { return nil, errors.New("show by id error") }
+
+		for range 2 {
+			_, err := showByIdWithCache(client, ObjectTypeDatabaseRole, databaseId, "A", func(r DatabaseRole) string { return r.Name }, showAll, showById)
+			require.ErrorContains(t, err, "show error")
+		}
+
+		assert.Equal(t, 2, calls)
+	})
+}
+
+func Test_showCache_invalidate(t *testing.T) {
+	databaseKey := showCacheKey{objectType: ObjectTypeSchema, container: `"DB"`}
+	schemaKey := showCacheKey{objectType: ObjectTypeTable, container: `"DB"."SC"`}
+	otherSchemaKey := showCacheKey{objectType: ObjectTypeTable, container: `"DB2"."SC"`}
+	lowercaseSchemaKey := showCacheKey{objectType: ObjectTypeTable, container: `"db"."sc"`}
+	keys := []showCacheKey{databaseKey, schemaKey, otherSchemaKey, lowercaseSchemaKey}
+
+	testCases := []struct {
+		sql         string
+		invalidated []showCacheKey
+	}{
+		{sql: `DROP DATABASE "DB"`, invalidated: []showCacheKey{databaseKey, schemaKey}},
+		{sql: `ALTER DATABASE "DB" RENAME TO "DB3"`, invalidated: []showCacheKey{databaseKey, schemaKey}},
+		{sql: `CREATE OR REPLACE DATABASE "DB"`, invalidated: []showCacheKey{databaseKey, schemaKey}},
+		{sql: `drop database db`, invalidated: []showCacheKey{databaseKey, schemaKey}},
+		{sql: `DROP SCHEMA "DB"."SC"`, invalidated: []showCacheKey{databaseKey, schemaKey}},
+		{sql: `CREATE TABLE db.sc.t (id INT)`, invalidated: []showCacheKey{databaseKey, schemaKey}},
+		{sql: `ALTER TABLE "db"."sc"."t" RENAME TO "db"."sc"."u"`, invalidated: []showCacheKey{lowercaseSchemaKey}},
+		{sql: `CREATE TABLE "DB2"."SC"."T" COMMENT = 'copy of "DB"'`, invalidated: []showCacheKey{otherSchemaKey}},
+		{sql: `CREATE DATABASE "DB4"`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.sql, func(t *testing.T) {
+			cache := newShowCache()
+			for _, key := range keys {
+				_, err := cache.get(key, func() (any, error) { return []Table{}, nil })
+				require.NoError(t, err)
+			}
+
+			cache.invalidate(tc.sql)
+
+			for _, key := range keys {
+				if slices.Contains(tc.invalidated, key) {
+					assert.NotContains(t, cache.entries, key)
+				} else {
+					assert.Contains(t, cache.entries, key)
+				}
+			}
+		})
+	}
+}
+
+func Test_referencedIdentifiers(t *testing.T) {
+	assert.Equal(t, [][]string{{"ALTER"}, {"TABLE"}, {"DB", "SC", "T"}, {"SET"}, {"COMMENT"}}, referencedIdentifiers(`ALTER TABLE "DB".sc."T" SET COMMENT = 'a "DB".''x'''`))
+	assert.Equal(t, [][]string{{"DROP"}, {"DATABASE"}, {`a"b`}}, referencedIdentifiers(`DROP DATABASE "a""b";`))
+	assert.Equal(t, [][]string{{"DB"}}, referencedIdentifiers(`"DB"`))
+}
+
+func Test_futureGrantsInSchemasWithCache(t *testing.T) {
+	databaseId := NewAccountObjectIdentifier("DB")
+	otherDatabaseId := NewAccountObjectIdentifier("DB2")
+
+	loadWithCounter := func(client *Client, counter *int, databaseId AccountObjectIdentifier) ([]SchemaFutureGrants, error) {
+		return futureGrantsInSchemasWithCache(client, databaseId, func() ([]SchemaFutureGrants, error) {
+			*counter++
+			return []SchemaFutureGrants{{Schema: NewDatabaseObjectIdentifier(databaseId.Name(), "SC")}}, nil
+		})
+	}
+
+	t.Run("grants in the same database are loaded once", func(t *testing.T) {
+		client := &Client{futureGrantsCache: newShowCache()}
+		counter := 0
+
+		for range 2 {
+			grants, err := loadWithCounter(client, &counter, databaseId)
+			require.NoError(t, err)
+			assert.Equal(t, []SchemaFutureGrants{{Schema: NewDatabaseObjectIdentifier("DB", "SC")}}, grants)
+		}
+		_, err := loadWithCounter(client, &counter, otherDatabaseId)
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, counter)
+	})
+
+	t.Run("grant in the schema of the database invalidates the cache", func(t *testing.T) {
+		client := &Client{futureGrantsCache: newShowCache()}
+		counter := 0
+
+		_, err := loadWithCounter(client, &counter, databaseId)
+		require.NoError(t, err)
+		_, err = loadWithCounter(client, &counter, otherDatabaseId)
+		require.NoError(t, err)
+
+		client.invalidateShowCache(`GRANT SELECT ON FUTURE TABLES IN SCHEMA "DB"."SC" TO ROLE "R"`)
+
+		_, err = loadWithCounter(client, &counter, databaseId)
+		require.NoError(t, err)
+		_, err = loadWithCounter(client, &counter, otherDatabaseId)
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, counter)
+	})
+
+	t.Run("cache missing or bypassed", func(t *testing.T) {
+		for _, client := range []*Client{{}, {futureGrantsCache: newShowCache(), bypassShowCache: true}} {
+			counter := 0
+
+			for range 2 {
+				_, err := loadWithCounter(client, &counter, databaseId)
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, 2, counter)
+		}
+	})
+}
@@ -0,0 +1,174 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// Snowflake error codes, see https://docs.snowflake.com/en/developer-guide/sql-api/errors.
+const (
+	snowflakeErrorCodeObjectAlreadyExists                      = 2002
+	snowflakeErrorCodeObjectNotExistOrAuthorized               = 2003
+	snowflakeErrorCodeDoesNotExistOrOperationCannotBePerformed = 2043
+	snowflakeErrorCodeInsufficientPrivileges                   = 3001
+	snowflakeErrorCodeNoActiveWarehouse                        = 606
+	snowflakeErrorCodeLockWaitersLimitExceeded                 = 625
+	snowflakeErrorCodeStatementTimeout                         = 630
+)
+
+// SQL states, see https://en.wikipedia.org/wiki/SQLSTATE.
+const (
+	sqlStateInsufficientPrivileges = "42501"
+	sqlStateDuplicateObject        = "42710"
+	sqlStateCannotConnectNow       = "57P03"
+)
+
+var snowflakeErrorsByCode = map[int]error{
+	snowflakeErrorCodeObjectAlreadyExists:                      ErrObjectAlreadyExists,
+	snowflakeErrorCodeObjectNotExistOrAuthorized:               ErrObjectNotExistOrAuthorized,
+	snowflakeErrorCodeDoesNotExistOrOperationCannotBePerformed: ErrDoesNotExistOrOperationCannotBePerformed,
+	snowflakeErrorCodeInsufficientPrivileges:                   ErrInsufficientPrivileges,
+	snowflakeErrorCodeNoActiveWarehouse:                        ErrWarehouseUnavailable,
+	snowflakeErrorCodeLockWaitersLimitExceeded:                 ErrTransientLock,
+	snowflakeErrorCodeStatementTimeout:                         ErrStatementTimeout,
+	gosnowflake.ErrCodeEmptyAccountCode:                        ErrAccountIsEmpty,
+	gosnowflake.ErrCodeServiceUnavailable:                      ErrServiceUnavailable,
+}
+
+// serviceUnavailableHttpStatuses are the HTTP statuses returned by Snowflake when the request should be retried later.
+// The driver retries them on its own, and returns ErrFailedToPostQuery with the status when it gives up.
+var serviceUnavailableHttpStatuses = []int{http.StatusTooManyRequests, http.StatusServiceUnavailable}
+
+var snowflakeErrorsBySqlState = map[string]error{
+	sqlStateInsufficientPrivileges: ErrInsufficientPrivileges,
+	sqlStateDuplicateObject:        ErrObjectAlreadyExists,
+	sqlStateCannotConnectNow:       ErrWarehouseUnavailable,
+}
+
+// snowflakeErrorsByMessage is used for the errors that do not have a dedicated error code.
+var snowflakeErrorsByMessage = map[string]error{
+	"Object does not exist, or operation cannot be performed": ErrDoesNotExistOrOperationCannotBePerformed,
+	"does not exist or not authorized":                        ErrObjectNotExistOrAuthorized,
+	"account is empty":                                        ErrAccountIsEmpty,
+	"Grant partially executed":                                ErrGrantPartiallyExecuted,
+}
+
+// SnowflakeError is the error returned by Snowflake, classified by its error code and SQL state.
+// It matches the classification error (e.g. ErrInsufficientPrivileges) with errors.Is, and unwraps to the original driver error.
+type SnowflakeError struct {
+	Number   int
+	SqlState string
+	QueryId  string
+	// RequiredPrivilege describes the privilege needed to run the failed statement. It is set only for ErrInsufficientPrivileges.
+	RequiredPrivilege string
+
+	kind        error
+	driverError error
+}
+
+func (e *SnowflakeError) Error() string {
+	message := e.driverError.Error()
+	if e.kind != nil {
+		message = fmt.Sprintf("%s: %s", e.kind.Error(), message)
+	}
+	if e.RequiredPrivilege != "" {
+		message = fmt.Sprintf("%s; the statement requires %s", message, e.RequiredPrivilege)
+	}
+	return message
+}
+
+func (e *SnowflakeError) Is(target error) bool {
+	return e.kind != nil && e.kind == target
+}
+
+func (e *SnowflakeError) Unwrap() error {
+	return e.driverError
+}
+
+// newSnowflakeError classifies the given driver error. It returns nil for errors that are not recognized.
+func newSnowflakeError(err error, sql string) *SnowflakeError {
+	snowflakeError := &SnowflakeError{driverError: err}
+
+	var driverError *gosnowflake.SnowflakeError
+	if errors.As(err, &driverError) {
+		snowflakeError.Number = driverError.Number
+		snowflakeError.SqlState = driverError.SQLState
+		snowflakeError.QueryId = driverError.QueryID
+		if isServiceUnavailableError(driverError) {
+			snowflakeError.kind = ErrServiceUnavailable
+		} else if kind, ok := snowflakeErrorsByCode[driverError.Number]; ok {
+			snowflakeError.kind = kind
+		} else if kind, ok := snowflakeErrorsBySqlState[driverError.SQLState]; ok {
+			snowflakeError.kind = kind
+		}
+	}
+	if snowflakeError.kind == nil {
+		for message, kind := range snowflakeErrorsByMessage {
+			if strings.Contains(err.Error(), message) {
+				snowflakeError.kind = kind
+				break
+			}
+		}
+	}
+	if snowflakeError.kind == nil {
+		return nil
+	}
+	if snowflakeError.kind == ErrInsufficientPrivileges {
+		snowflakeError.RequiredPrivilege = requiredPrivilege(sql)
+	}
+	return snowflakeError
+}
+
+func isServiceUnavailableError(driverError *gosnowflake.SnowflakeError) bool {
+	if driverError.Number != gosnowflake.ErrFailedToPostQuery || len(driverError.MessageArgs) == 0 {
+		return false
+	}
+	status, ok := driverError.MessageArgs[0].(int)
+	return ok && slices.Contains(serviceUnavailableHttpStatuses, status)
+}
+
+var (
+	createStatementRegexp    = regexp.MustCompile(`(?i)^\s*CREATE\s+(?:OR\s+REPLACE\s+)?(?:(?:TRANSIENT|TEMPORARY|TEMP|VOLATILE|SECURE|RECURSIVE|LOCAL|GLOBAL)\s+)*([A-Z ]+?)\s+(?:IF\s+NOT\s+EXISTS\s+)?((?:"(?:[^"]|"")*"\.?)+)`)
+	ownershipStatementRegexp = regexp.MustCompile(`(?i)^\s*(?:ALTER|DROP|UNDROP|COMMENT\s+(?:IF\s+EXISTS\s+)?ON)\s+([A-Z ]+?)\s+(?:IF\s+EXISTS\s+)?"`)
+	grantStatementRegexp     = regexp.MustCompile(`(?i)^\s*(?:GRANT|REVOKE)\s`)
+	readStatementRegexp      = regexp.MustCompile(`(?i)^\s*(?:SHOW|DESCRIBE|DESC|SELECT)\s`)
+	useStatementRegexp       = regexp.MustCompile(`(?i)^\s*USE\s+([A-Z ]+?)\s+"`)
+	identifierPartRegexp     = regexp.MustCompile(`"(?:[^"]|"")*"`)
+)
+
+// requiredPrivilege returns the description of the privilege needed to run the given statement, or an empty string if it is not known.
+func requiredPrivilege(sql string) string {
+	if matches := createStatementRegexp.FindStringSubmatch(sql); matches != nil {
+		objectType := strings.ToUpper(matches[1])
+		if strings.HasSuffix(objectType, "INTEGRATION") {
+			objectType = "INTEGRATION"
+		}
+		switch len(identifierPartRegexp.FindAllString(matches[2], -1)) {
+		case 2:
+			return fmt.Sprintf("the CREATE %s and USAGE privileges on the parent database", objectType)
+		case 3:
+			return fmt.Sprintf("the CREATE %s privilege on the parent schema and the USAGE privilege on the parent database and schema", objectType)
+		default:
+			return fmt.Sprintf("the CREATE %s privilege on the account", objectType)
+		}
+	}
+	if matches := ownershipStatementRegexp.FindStringSubmatch(sql); matches != nil {
+		return fmt.Sprintf("the OWNERSHIP privilege on the %s", strings.ToLower(matches[1]))
+	}
+	if grantStatementRegexp.MatchString(sql) {
+		return "the MANAGE GRANTS privilege on the account or the OWNERSHIP privilege on the object"
+	}
+	if matches := useStatementRegexp.FindStringSubmatch(sql); matches != nil {
+		return fmt.Sprintf("the USAGE privilege on the %s", strings.ToLower(matches[1]))
+	}
+	if readStatementRegexp.MatchString(sql) {
+		return "any privilege on the object (e.g. USAGE or MONITOR) and the USAGE privilege on its parent database and schema"
+	}
+	return ""
+}
@@ -0,0 +1,168 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_decodeDriverError(t *testing.T) {
+	testCases := []struct {
+		name          string
+		err           error
+		sql           string
+		expectedKind  error
+		expectedInMsg string
+	}{
+		{
+			name:         "object does not exist or not authorized by code",
+			err:          &gosnowflake.SnowflakeError{Number: 2003, SQLState: "02000", Message: "SQL compilation error:\nDatabase 'DB' does not exist or not authorized."},
+			expectedKind: ErrObjectNotExistOrAuthorized,
+		},
+		{
+			name:         "object does not exist or operation cannot be performed by code",
+			err:          &gosnowflake.SnowflakeError{Number: 2043, SQLState: "02000", Message: "SQL compilation error:\nObject does not exist, or operation cannot be performed."},
+			expectedKind: ErrDoesNotExistOrOperationCannotBePerformed,
+		},
+		{
+			name:         "object already exists by code",
+			err:          &gosnowflake.SnowflakeError{Number: 2002, SQLState: "42710", Message: "SQL compilation error:\nObject 'DB' already exists."},
+			expectedKind: ErrObjectAlreadyExists,
+		},
+		{
+			name:         "object already exists by sql state",
+			err:          &gosnowflake.SnowflakeError{Number: 1, SQLState: "42710", Message: "already exists"},
+			expectedKind: ErrObjectAlreadyExists,
+		},
+		{
+			name:          "insufficient privileges by code",
+			err:           &gosnowflake.SnowflakeError{Number: 3001, SQLState: "42501", Message: "SQL access control error:\nInsufficient privileges to operate on schema 'SCHEMA'"},
+			sql:           `CREATE SCHEMA "DB"."SCHEMA"`,
+			expectedKind:  ErrInsufficientPrivileges,
+			expectedInMsg: "Insufficient privileges to operate on schema 'SCHEMA'; the statement requires the CREATE SCHEMA and USAGE privileges on the parent database",
+		},
+		{
+			name:         "insufficient privileges by sql state",
+			err:          &gosnowflake.SnowflakeError{Number: 1, SQLState: "42501", Message: "Insufficient privileges"},
+			expectedKind: ErrInsufficientPrivileges,
+		},
+		{
+			name:         "transient lock",
+			err:          &gosnowflake.SnowflakeError{Number: 625, SQLState: "57014", Message: "Statement has locked table 'T' in transaction and this lock has not yet been released."},
+			expectedKind: ErrTransientLock,
+		},
+		{
+			name:         "statement timeout",
+			err:          &gosnowflake.SnowflakeError{Number: 630, SQLState: "57014", Message: "Statement reached its statement or warehouse timeout of 10 second(s) and was canceled."},
+			expectedKind: ErrStatementTimeout,
+		},
+		{
+			name:         "no active warehouse",
+			err:          &gosnowflake.SnowflakeError{Number: 606, SQLState: "57P03", Message: "No active warehouse selected in the current session."},
+			expectedKind: ErrWarehouseUnavailable,
+		},
+		{
+			name:         "service unavailable",
+			err:          &gosnowflake.SnowflakeError{Number: gosnowflake.ErrFailedToPostQuery, SQLState: gosnowflake.SQLStateConnectionFailure, Message: "failed to POST. HTTP: %v, URL: %v", MessageArgs: []any{http.StatusServiceUnavailable, "url"}},
+			expectedKind: ErrServiceUnavailable,
+		},
+		{
+			name:         "empty account",
+			err:          &gosnowflake.SnowflakeError{Number: gosnowflake.ErrCodeEmptyAccountCode, Message: "account is empty"},
+			expectedKind: ErrAccountIsEmpty,
+		},
+		{
+			name:         "grant partially executed by message",
+			err:          errors.New("Grant partially executed: privileges [REFERENCE_USAGE] not granted."),
+			expectedKind: ErrGrantPartiallyExecuted,
+		},
+		{
+			name:         "wrapped driver error",
+			err:          fmt.Errorf("wrapped: %w", &gosnowflake.SnowflakeError{Number: 2003, SQLState: "02000", Message: "does not exist"}),
+			expectedKind: ErrObjectNotExistOrAuthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := decodeDriverError(tc.err, tc.sql)
+
+			require.ErrorIs(t, err, tc.expectedKind)
+			require.ErrorIs(t, err, tc.err)
+			assert.ErrorContains(t, err, tc.err.Error())
+			if tc.expectedInMsg != "" {
+				assert.ErrorContains(t, err, tc.expectedInMsg)
+			}
+			var snowflakeError *SnowflakeError
+			require.True(t, errors.As(err, &snowflakeError))
+		})
+	}
+
+	t.Run("classification is exclusive", func(t *testing.T) {
+		err := decodeDriverError(&gosnowflake.SnowflakeError{Number: 2002, SQLState: "42710", Message: "already exists"}, "")
+
+		assert.NotErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+		assert.NotErrorIs(t, err, ErrInsufficientPrivileges)
+	})
+
+	t.Run("details of the driver error are available", func(t *testing.T) {
+		err := decodeDriverError(&gosnowflake.SnowflakeError{Number: 3001, SQLState: "42501", QueryID: "query-id", Message: "Insufficient privileges"}, `DROP TABLE "DB"."SCHEMA"."TABLE"`)
+
+		var snowflakeError *SnowflakeError
+		require.True(t, errors.As(err, &snowflakeError))
+		assert.Equal(t, 3001, snowflakeError.Number)
+		assert.Equal(t, "42501", snowflakeError.SqlState)
+		assert.Equal(t, "query-id", snowflakeError.QueryId)
+		assert.Equal(t, "the OWNERSHIP privilege on the table", snowflakeError.RequiredPrivilege)
+	})
+
+	t.Run("failed request with a non-retryable status is not classified", func(t *testing.T) {
+		driverErr := &gosnowflake.SnowflakeError{Number: gosnowflake.ErrFailedToPostQuery, Message: "failed to POST. HTTP: %v, URL: %v", MessageArgs: []any{http.StatusBadRequest, "url"}}
+
+		assert.Same(t, driverErr, decodeDriverError(driverErr, ""))
+	})
+
+	t.Run("unrecognized error is returned unchanged", func(t *testing.T) {
+		driverErr := &gosnowflake.SnowflakeError{Number: 1003, SQLState: "42000", Message: "syntax error"}
+
+		err := decodeDriverError(driverErr, "")
+
+		assert.Same(t, driverErr, err)
+	})
+
+	t.Run("nil error", func(t *testing.T) {
+		assert.NoError(t, decodeDriverError(nil, ""))
+	})
+}
+
+func Test_requiredPrivilege(t *testing.T) {
+	testCases := []struct {
+		sql      string
+		expected string
+	}{
+		{sql: `CREATE DATABASE "DB"`, expected: "the CREATE DATABASE privilege on the account"},
+		{sql: `CREATE OR REPLACE TRANSIENT DATABASE IF NOT EXISTS "DB" COMMENT = 'a'`, expected: "the CREATE DATABASE privilege on the account"},
+		{sql: `CREATE API INTEGRATION "INT" API_PROVIDER = aws_api_gateway`, expected: "the CREATE INTEGRATION privilege on the account"},
+		{sql: `CREATE DATABASE ROLE "DB"."ROLE"`, expected: "the CREATE DATABASE ROLE and USAGE privileges on the parent database"},
+		{sql: `CREATE OR REPLACE SECURE VIEW "DB"."SCHEMA"."VIEW" AS SELECT 1`, expected: "the CREATE VIEW privilege on the parent schema and the USAGE privilege on the parent database and schema"},
+		{sql: `CREATE MATERIALIZED VIEW "DB"."SC""HEMA"."VIEW" AS SELECT 1`, expected: "the CREATE MATERIALIZED VIEW privilege on the parent schema and the USAGE privilege on the parent database and schema"},
+		{sql: `ALTER WAREHOUSE "WH" SET COMMENT = 'a'`, expected: "the OWNERSHIP privilege on the warehouse"},
+		{sql: `DROP DATABASE ROLE IF EXISTS "DB"."ROLE"`, expected: "the OWNERSHIP privilege on the database role"},
+		{sql: `COMMENT IF EXISTS ON COLUMN "DB"."SCHEMA"."TABLE"."COLUMN" IS 'a'`, expected: "the OWNERSHIP privilege on the column"},
+		{sql: `GRANT USAGE ON DATABASE "DB" TO ROLE "ROLE"`, expected: "the MANAGE GRANTS privilege on the account or the OWNERSHIP privilege on the object"},
+		{sql: `USE WAREHOUSE "WH"`, expected: "the USAGE privilege on the warehouse"},
+		{sql: `SHOW SCHEMAS LIKE 'SCHEMA' IN DATABASE "DB"`, expected: "any privilege on the object (e.g. USAGE or MONITOR) and the USAGE privilege on its parent database and schema"},
+		{sql: `EXECUTE TASK "DB"."SCHEMA"."TASK"`, expected: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.sql, func(t *testing.T) {
+			assert.Equal(t, tc.expected, requiredPrivilege(tc.sql))
+		})
+	}
+}
@@ -63,6 +63,16 @@ func (v *streams) Show(ctx context.Context, request *ShowStreamRequest) ([]Strea
 }
 
 func (v *streams) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Stream, error) {
+	return showByIdWithCache(v.client, ObjectTypeStream, id.SchemaId(), id.Name(),
+		func(r Stream) string { return r.Name },
+		func() ([]Stream, error) {
+			return v.Show(ctx, NewShowStreamRequest().WithIn(ExtendedIn{In: In{Schema: id.SchemaId()}}))
+		},
+		func() (*Stream, error) { return v.showByID(ctx, id) },
+	)
+}
+
+func (v *streams) showByID(ctx context.Context, id SchemaObjectIdentifier) (*Stream, error) {
 	request := NewShowStreamRequest().
 		WithIn(ExtendedIn{In: In{Schema: id.SchemaId()}}).
 		WithLike(Like{Pattern: String(id.Name())})
@@ -37,7 +37,7 @@ func (v *tables) Create(ctx context.Context, request *CreateTableRequest) error
 
 func (v *tables) CreateAsSelect(ctx context.Context, request *CreateTableAsSelectRequest) error {
 	opts := request.toOpts()
-	return validateAndExec(v.client, ctx, opts)
+	return validateAndExecAsync(v.client, ctx, opts)
 }
 
 func (v *tables) CreateUsingTemplate(ctx context.Context, request *CreateTableUsingTemplateRequest) error {
@@ -52,7 +52,7 @@ func (v *tables) CreateLike(ctx context.Context, request *CreateTableLikeRequest
 
 func (v *tables) CreateClone(ctx context.Context, request *CreateTableCloneRequest) error {
 	opts := request.toOpts()
-	return validateAndExec(v.client, ctx, opts)
+	return validateAndExecAsync(v.client, ctx, opts)
 }
 
 func (v *tables) Alter(ctx context.Context, request *AlterTableRequest) error {
@@ -82,6 +82,14 @@ func (v *tables) Show(ctx context.Context, request *ShowTableRequest) ([]Table,
 }
 
 func (v *tables) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Table, error) {
+	return showByIdWithCache(v.client, ObjectTypeTable, id.SchemaId(), id.Name(),
+		func(r Table) string { return r.Name },
+		func() ([]Table, error) { return v.Show(ctx, NewShowTableRequest().WithIn(&In{Schema: id.SchemaId()})) },
+		func() (*Table, error) { return v.showByID(ctx, id) },
+	)
+}
+
+func (v *tables) showByID(ctx context.Context, id SchemaObjectIdentifier) (*Table, error) {
 	request := NewShowTableRequest().WithIn(&In{Schema: id.SchemaId()}).WithLikePattern(id.Name())
 	returnedTables, err := v.Show(ctx, request)
 	if err != nil {
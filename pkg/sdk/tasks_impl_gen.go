@@ -58,6 +58,16 @@ func (v *tasks) Show(ctx context.Context, request *ShowTaskRequest) ([]Task, err
 }
 
 func (v *tasks) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Task, error) {
+	return showByIdWithCache(v.client, ObjectTypeTask, id.SchemaId(), id.Name(),
+		func(r Task) string { return r.Name },
+		func() ([]Task, error) {
+			return v.Show(ctx, NewShowTaskRequest().WithIn(ExtendedIn{In: In{Schema: id.SchemaId()}}))
+		},
+		func() (*Task, error) { return v.showByID(ctx, id) },
+	)
+}
+
+func (v *tasks) showByID(ctx context.Context, id SchemaObjectIdentifier) (*Task, error) {
 	request := NewShowTaskRequest().
 		WithIn(ExtendedIn{In: In{Schema: id.SchemaId()}}).
 		WithLike(Like{Pattern: String(id.Name())})
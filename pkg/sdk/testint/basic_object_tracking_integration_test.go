@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/snowflakedb/gosnowflake"
 	"github.com/stretchr/testify/require"
@@ -106,7 +107,7 @@ func TestInt_AppName(t *testing.T) {
 	version := "v0.99.0"
 	config := sdk.DefaultConfig(sdk.WithUseLegacyTomlFormat(true))
 	config.Application = fmt.Sprintf("terraform-provider-snowflake:%s", version)
-	client, err := sdk.NewClient(config)
+	client, err := helpers.NewClient(config)
 	require.NoError(t, err)
 
 	_, err = client.QueryUnsafe(context.Background(), "SELECT 1")
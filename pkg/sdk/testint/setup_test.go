@@ -48,13 +48,21 @@ func execute(m *testing.M) int {
 	setup()
 	exitVal := m.Run()
 	cleanup()
+	closeSqlCassette()
 	return exitVal
 }
 
 func setup() {
 	log.Println("[DEBUG] Running integration tests setup")
 
-	err := itc.initialize()
+	closeCassette, err := helpers.UseSqlCassetteFromEnv()
+	if err != nil {
+		log.Printf("[DEBUG] Setting up the sql cassette failed with: `%s`", err)
+		os.Exit(1)
+	}
+	itc.closeSqlCassette = closeCassette
+
+	err = itc.initialize()
 	if err != nil {
 		log.Printf("[DEBUG] Integration test context initialisation failed with: `%s`", err)
 		cleanup()
@@ -84,11 +92,22 @@ func cleanup() {
 	}
 }
 
+func closeSqlCassette() {
+	if itc.closeSqlCassette == nil {
+		return
+	}
+	if err := itc.closeSqlCassette(); err != nil {
+		log.Printf("[DEBUG] Closing the sql cassette failed with: `%s`", err)
+	}
+}
+
 type integrationTestContext struct {
 	config *gosnowflake.Config
 	client *sdk.Client
 	ctx    context.Context
 
+	closeSqlCassette func() error
+
 	database         *sdk.Database
 	databaseCleanup  func()
 	schema           *sdk.Schema
@@ -129,7 +148,7 @@ func (itc *integrationTestContext) initialize() error {
 	}
 	itc.config = defaultConfig
 
-	c, err := sdk.NewClient(defaultConfig)
+	c, err := helpers.NewClient(defaultConfig)
 	if err != nil {
 		return err
 	}
@@ -181,7 +200,7 @@ func (itc *integrationTestContext) initialize() error {
 			log.Println("[WARN] default and secondary configs are set to the same account; it may cause problems in tests requiring multiple accounts")
 		}
 
-		secondaryClient, err := sdk.NewClient(config)
+		secondaryClient, err := helpers.NewClient(config)
 		if err != nil {
 			return err
 		}
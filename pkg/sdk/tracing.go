@@ -0,0 +1,135 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/tracking"
+	"github.com/snowflakedb/gosnowflake"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+
+// Span attributes; the db.* ones follow the OpenTelemetry semantic conventions for database spans.
+const (
+	spanAttributeDbSystem    = "db.system.name"
+	spanAttributeDbOperation = "db.operation.name"
+	spanAttributeObjectType  = "snowflake.object_type"
+	spanAttributeQueryId     = "snowflake.query_id"
+	spanAttributeErrorClass  = "snowflake.error_class"
+	spanAttributeErrorCode   = "snowflake.error_code"
+	spanAttributeRetries     = "snowflake.retries"
+	spanAttributeResource    = "terraform.resource"
+	spanAttributeDatasource  = "terraform.datasource"
+	spanAttributeOperation   = "terraform.operation"
+	spanAttributeResourceId  = "terraform.resource_id"
+
+	unclassifiedErrorClass = "unclassified"
+)
+
+// SetTracerProvider makes the client create an OpenTelemetry span for every statement it runs. The tracing is disabled by default.
+func (c *Client) SetTracerProvider(tracerProvider trace.TracerProvider) {
+	c.tracer = tracerProvider.Tracer(tracerName, trace.WithInstrumentationVersion(tracking.ProviderVersion))
+}
+
+// startSpan starts the span of the given statement. The span does not contain the statement text, because it can contain secrets
+// (e.g. CREATE USER ... PASSWORD = ...), only the statement kind and the object type (e.g. CREATE and TABLE).
+// The returned function ends the span, recording the error (if any).
+func (c *Client) startSpan(ctx context.Context, sql string) (context.Context, func(err error)) {
+	if c.tracer == nil {
+		return ctx, func(error) {}
+	}
+	kind, objectType := statementKindAndObjectType(sql)
+	attributes := []attribute.KeyValue{
+		attribute.String(spanAttributeDbSystem, "snowflake"),
+		attribute.String(spanAttributeDbOperation, kind),
+		attribute.String(spanAttributeObjectType, objectType),
+	}
+	if metadata, ok := tracking.FromContext(ctx); ok {
+		attributes = append(attributes,
+			attribute.String(spanAttributeResource, metadata.Resource),
+			attribute.String(spanAttributeDatasource, metadata.Datasource),
+			attribute.String(spanAttributeOperation, string(metadata.Operation)),
+			attribute.String(spanAttributeResourceId, metadata.ResourceId),
+		)
+	}
+	spanName := strings.TrimSpace(kind + " " + objectType)
+	if spanName == "" {
+		spanName = "statement"
+	}
+	ctx, span := c.tracer.Start(ctx, spanName, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
+	return ctx, func(err error) {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, errorClass(err))
+			span.SetAttributes(attribute.String(spanAttributeErrorClass, errorClass(err)))
+			var snowflakeError *SnowflakeError
+			if errors.As(err, &snowflakeError) && snowflakeError.Number != 0 {
+				span.SetAttributes(attribute.Int(spanAttributeErrorCode, snowflakeError.Number))
+			}
+		}
+		span.End()
+	}
+}
+
+// withQueryIdRecorder returns the context in which the driver reports the id of the query it runs, and the function
+// that adds the reported query id to the current span. It does nothing when the span is not recorded.
+// The returned context can be used only for a single query, because the driver closes the channel after reporting the query id.
+func withQueryIdRecorder(ctx context.Context) (context.Context, func()) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return ctx, func() {}
+	}
+	queryIds := make(chan string, 1)
+	return gosnowflake.WithQueryIDChan(ctx, queryIds), func() {
+		select {
+		case queryId, ok := <-queryIds:
+			if ok && queryId != "" {
+				span.SetAttributes(attribute.String(spanAttributeQueryId, queryId))
+			}
+		default:
+		}
+	}
+}
+
+func errorClass(err error) string {
+	var snowflakeError *SnowflakeError
+	if errors.As(err, &snowflakeError) && snowflakeError.kind != nil {
+		return snowflakeError.kind.Error()
+	}
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return context.DeadlineExceeded.Error()
+	case errors.Is(err, context.Canceled):
+		return context.Canceled.Error()
+	}
+	return unclassifiedErrorClass
+}
+
+var (
+	statementKindRegexp     = regexp.MustCompile(`(?i)^\s*([A-Z]+)`)
+	showStatementRegexp     = regexp.MustCompile(`(?i)^\s*SHOW\s+(?:TERSE\s+)?([A-Z ]+?)(?:\s+(?:LIKE|IN|STARTS|LIMIT|FROM|ON|TO|OF)\b|\s*;|\s*--|\s*$)`)
+	describeStatementRegexp = regexp.MustCompile(`(?i)^\s*DESC(?:RIBE)?\s+([A-Z ]+?)\s+"`)
+)
+
+// statementKindAndObjectType returns the kind of the statement (e.g. CREATE) and the type of the object it operates on (e.g. TABLE), if it is known.
+func statementKindAndObjectType(sql string) (string, string) {
+	matches := statementKindRegexp.FindStringSubmatch(sql)
+	if matches == nil {
+		return "", ""
+	}
+	kind := strings.ToUpper(matches[1])
+	var objectType string
+	for _, objectTypeRegexp := range []*regexp.Regexp{createStatementRegexp, ownershipStatementRegexp, showStatementRegexp, describeStatementRegexp, useStatementRegexp} {
+		if matches := objectTypeRegexp.FindStringSubmatch(sql); matches != nil {
+			objectType = strings.ToUpper(matches[1])
+			break
+		}
+	}
+	return kind, objectType
+}
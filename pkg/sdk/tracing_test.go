@@ -0,0 +1,114 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/tracking"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func tracedFakeDriverClient(t *testing.T, policy RetryPolicy, errs ...error) (*Client, *tracetest.SpanRecorder) {
+	t.Helper()
+	client, _ := fakeDriverClient(t, policy, errs...)
+	recorder := tracetest.NewSpanRecorder()
+	client.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	return client, recorder
+}
+
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attributes := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		attributes[kv.Key] = kv.Value
+	}
+	return attributes
+}
+
+func Test_Client_tracing(t *testing.T) {
+	t.Run("span of the successful statement", func(t *testing.T) {
+		client, recorder := tracedFakeDriverClient(t, RetryPolicy{})
+		ctx := tracking.NewContext(context.Background(), tracking.NewVersionedResourceMetadata(resources.Table, tracking.ReadOperation).
+			WithTerraformContext(tracking.TerraformContext{}, `"DB"."SCHEMA"."TABLE"`))
+
+		var rows []struct {
+			Name string `db:"name"`
+		}
+		err := client.query(ctx, &rows, `SHOW TABLES LIKE 'TABLE' IN SCHEMA "DB"."SCHEMA"`)
+		require.NoError(t, err)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "SHOW TABLES", spans[0].Name())
+		assert.Equal(t, codes.Unset, spans[0].Status().Code)
+		attributes := spanAttributes(spans[0])
+		assert.Equal(t, "snowflake", attributes[spanAttributeDbSystem].AsString())
+		assert.Equal(t, "SHOW", attributes[spanAttributeDbOperation].AsString())
+		assert.Equal(t, "TABLES", attributes[spanAttributeObjectType].AsString())
+		assert.Equal(t, "snowflake_table", attributes[spanAttributeResource].AsString())
+		assert.Equal(t, "read", attributes[spanAttributeOperation].AsString())
+		assert.Equal(t, `"DB"."SCHEMA"."TABLE"`, attributes[spanAttributeResourceId].AsString())
+	})
+
+	t.Run("span of the failed statement", func(t *testing.T) {
+		lockErr := &gosnowflake.SnowflakeError{Number: 625, Message: "Statement has locked table"}
+		insufficientPrivilegesErr := &gosnowflake.SnowflakeError{Number: 3001, SQLState: "42501", Message: "Insufficient privileges"}
+		client, recorder := tracedFakeDriverClient(t, RetryPolicy{MaxRetries: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}, lockErr, insufficientPrivilegesErr)
+
+		_, err := client.exec(context.Background(), `DROP TABLE "DB"."SCHEMA"."TABLE"`)
+		require.ErrorIs(t, err, ErrInsufficientPrivileges)
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "DROP TABLE", spans[0].Name())
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+		attributes := spanAttributes(spans[0])
+		assert.Equal(t, ErrInsufficientPrivileges.Error(), attributes[spanAttributeErrorClass].AsString())
+		assert.Equal(t, int64(3001), attributes[spanAttributeErrorCode].AsInt64())
+		assert.Equal(t, int64(1), attributes[spanAttributeRetries].AsInt64())
+	})
+
+	t.Run("no spans without tracer provider", func(t *testing.T) {
+		client, _ := fakeDriverClient(t, RetryPolicy{})
+
+		_, err := client.exec(context.Background(), `DROP TABLE "DB"."SCHEMA"."TABLE"`)
+
+		require.NoError(t, err)
+		assert.Nil(t, client.tracer)
+	})
+}
+
+func Test_statementKindAndObjectType(t *testing.T) {
+	testCases := []struct {
+		sql                string
+		expectedKind       string
+		expectedObjectType string
+	}{
+		{sql: `CREATE OR REPLACE TRANSIENT TABLE "DB"."SCHEMA"."TABLE" ("C" INT)`, expectedKind: "CREATE", expectedObjectType: "TABLE"},
+		{sql: `CREATE API INTEGRATION "INTEGRATION" API_PROVIDER = aws_api_gateway`, expectedKind: "CREATE", expectedObjectType: "API INTEGRATION"},
+		{sql: `ALTER WAREHOUSE IF EXISTS "WH" SET WAREHOUSE_SIZE = 'SMALL'`, expectedKind: "ALTER", expectedObjectType: "WAREHOUSE"},
+		{sql: `DROP DATABASE ROLE "DB"."ROLE"`, expectedKind: "DROP", expectedObjectType: "DATABASE ROLE"},
+		{sql: `SHOW TERSE SCHEMAS IN DATABASE "DB"`, expectedKind: "SHOW", expectedObjectType: "SCHEMAS"},
+		{sql: `SHOW WAREHOUSES --terraform_provider_usage_tracking {}`, expectedKind: "SHOW", expectedObjectType: "WAREHOUSES"},
+		{sql: `SHOW GRANTS ON DATABASE "DB"`, expectedKind: "SHOW", expectedObjectType: "GRANTS"},
+		{sql: `DESCRIBE USER "USER"`, expectedKind: "DESCRIBE", expectedObjectType: "USER"},
+		{sql: `GRANT USAGE ON DATABASE "DB" TO ROLE "ROLE"`, expectedKind: "GRANT"},
+		{sql: `SELECT CURRENT_ROLE()`, expectedKind: "SELECT"},
+		{sql: ``},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.sql, func(t *testing.T) {
+			kind, objectType := statementKindAndObjectType(tc.sql)
+
+			assert.Equal(t, tc.expectedKind, kind)
+			assert.Equal(t, tc.expectedObjectType, objectType)
+		})
+	}
+}
@@ -44,6 +44,16 @@ func (v *views) Show(ctx context.Context, request *ShowViewRequest) ([]View, err
 }
 
 func (v *views) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*View, error) {
+	return showByIdWithCache(v.client, ObjectTypeView, id.SchemaId(), id.Name(),
+		func(r View) string { return r.Name },
+		func() ([]View, error) {
+			return v.Show(ctx, NewShowViewRequest().WithIn(ExtendedIn{In: In{Schema: id.SchemaId()}}))
+		},
+		func() (*View, error) { return v.showByID(ctx, id) },
+	)
+}
+
+func (v *views) showByID(ctx context.Context, id SchemaObjectIdentifier) (*View, error) {
 	request := NewShowViewRequest().
 		WithIn(ExtendedIn{In: In{Schema: id.SchemaId()}}).
 		WithLike(Like{Pattern: String(id.Name())})